@@ -20,9 +20,11 @@ import (
 )
 
 type App struct {
+	Config     *config.Config
 	HTTPServer *http.Server
 	Database   *DBWrapper
 	Redis      *redis.Client
+	Jobs       *JobRunner
 }
 
 type DBWrapper struct {
@@ -81,6 +83,7 @@ func Bootstrap(ctx context.Context) (*App, error) {
 	}
 
 	application := &App{
+		Config:     cfg,
 		HTTPServer: server,
 		Database:   &DBWrapper{DB: db},
 		Redis:      redisClient,
@@ -88,15 +91,58 @@ func Bootstrap(ctx context.Context) (*App, error) {
 
 	// 2. Init Handlers
 	rHandler := application.initRestaurantRouter(db)
+	oHandler := application.initOrderRouter(db)
+	dzHandler := application.initDeliveryZoneRouter(db)
+	aHandler := application.initAddressRouter(db)
+	iHandler := application.initInventoryRouter(db)
+	rcHandler := application.initRecipeRouter(db)
+	poHandler := application.initPurchaseOrderRouter(db)
+	saHandler := application.initStockAlertRouter(db)
+	stHandler := application.initStocktakeRouter(db)
+	wHandler := application.initWasteRouter(db)
+	trHandler := application.initTransferRouter(db)
+	spHandler := application.initSupplierRouter(db)
+	vHandler := application.initValuationRouter(db)
+	mHandler := application.initMenuRouter(db)
+	scHandler := application.initScanRouter(db)
+	rpHandler := application.initReportRouter(db)
+	rvHandler := application.initReviewRouter(db)
+	lyHandler := application.initLoyaltyRouter(db)
+	wlHandler := application.initWalletRouter(db)
+	tbHandler := application.initTableRouter(db)
 
 	// 3. Register everything dynamically
 	routerManager := router.NewRouter(r)
 	routerManager.RegisterModules(v1,
 		// uHandler,
-		// oHandler,
+		oHandler,
 		rHandler,
+		dzHandler,
+		aHandler,
+		iHandler,
+		rcHandler,
+		poHandler,
+		saHandler,
+		stHandler,
+		wHandler,
+		trHandler,
+		spHandler,
+		vHandler,
+		mHandler,
+		scHandler,
+		rpHandler,
+		rvHandler,
+		lyHandler,
+		wlHandler,
 	// Adding a new module (e.g. PaymentHandler) is now just one line here!
 	)
+	// Dine-in is off until its token secret is configured
+	if tbHandler != nil {
+		routerManager.RegisterModules(v1, tbHandler)
+	}
+
+	// 4. Start background jobs
+	application.Jobs = application.startJobs(db)
 
 	return application, nil
 }
@@ -3,9 +3,41 @@
 package app
 
 import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/address"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/notification"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/infrastructure/geocoder"
+	infraNotification "github.com/james-wukong/orders-api/internal/infrastructure/notification"
+	infraPayment "github.com/james-wukong/orders-api/internal/infrastructure/payment"
 	infraPostgres "github.com/james-wukong/orders-api/internal/infrastructure/postgres"
 	"github.com/james-wukong/orders-api/internal/interfaces/http/handlers"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	addressUC "github.com/james-wukong/orders-api/internal/usecase/address"
+	deliveryUC "github.com/james-wukong/orders-api/internal/usecase/delivery"
+	inventoryUC "github.com/james-wukong/orders-api/internal/usecase/inventory"
+	loyaltyUC "github.com/james-wukong/orders-api/internal/usecase/loyalty"
+	menuUC "github.com/james-wukong/orders-api/internal/usecase/menu"
+	orderUC "github.com/james-wukong/orders-api/internal/usecase/order"
+	purchasingUC "github.com/james-wukong/orders-api/internal/usecase/purchasing"
+	recipeUC "github.com/james-wukong/orders-api/internal/usecase/recipe"
+	reportUC "github.com/james-wukong/orders-api/internal/usecase/report"
 	restaurantUC "github.com/james-wukong/orders-api/internal/usecase/restaurant"
+	reviewUC "github.com/james-wukong/orders-api/internal/usecase/review"
+	scanUC "github.com/james-wukong/orders-api/internal/usecase/scan"
+	stocktakeUC "github.com/james-wukong/orders-api/internal/usecase/stocktake"
+	supplierUC "github.com/james-wukong/orders-api/internal/usecase/supplier"
+	tableUC "github.com/james-wukong/orders-api/internal/usecase/table"
+	transferUC "github.com/james-wukong/orders-api/internal/usecase/transfer"
+	walletUC "github.com/james-wukong/orders-api/internal/usecase/wallet"
 	"gorm.io/gorm"
 )
 
@@ -21,3 +53,504 @@ func (a *App) initRestaurantRouter(db *gorm.DB) *handlers.RestaurantHandler {
 
 	return handlers.NewRestaurantHandler(createUC)
 }
+
+func (a *App) initOrderRouter(db *gorm.DB) *handlers.OrderHandler {
+	// 1. Repository Layer
+	orderRepo := infraPostgres.NewOrderRepository(db)
+	slotRepo := infraPostgres.NewOrderSlotRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	// 2. UseCase Layer
+	estimator := a.newEstimator(orderRepo)
+	ledger := a.newLoyaltyLedger(db)
+	wallets := a.newWalletLedger(db)
+	payments := orderUC.NewPayments(wallets, a.newPaymentProvider())
+	pricer := a.newPricer(db, estimator, ledger, wallets)
+	quoteUC := orderUC.NewQuoteOrderUseCase(pricer)
+	placeUC := orderUC.NewPlaceOrderUseCase(orderRepo, slotRepo, transactor, pricer, ledger, payments)
+	getUC := orderUC.NewGetOrderUseCase(orderRepo)
+	receiptUC := orderUC.NewGetReceiptUseCase(orderRepo, restaurantRepo)
+	stock := a.newStockDeduction(db)
+	cancelUC := orderUC.NewCancelOrderUseCase(orderRepo, slotRepo, transactor, ledger, payments, stock)
+	refundUC := orderUC.NewRefundOrderUseCase(orderRepo, transactor, ledger, payments)
+	slotsUC := orderUC.NewListPreorderSlotsUseCase(restaurantRepo, slotRepo)
+	statusUC := orderUC.NewUpdateOrderStatusUseCase(orderRepo, transactor, estimator, stock, ledger)
+	accuracyUC := orderUC.NewGetETAAccuracyUseCase(orderRepo)
+
+	return handlers.NewOrderHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		quoteUC, placeUC, getUC, receiptUC, cancelUC, refundUC, slotsUC, statusUC, accuracyUC,
+	)
+}
+
+// newPricer builds the pricer shared by quotes, checkout and table orders.
+func (a *App) newPricer(
+	db *gorm.DB, estimator *orderUC.Estimator, ledger *loyalty.Ledger, wallets *wallet.Ledger,
+) *orderUC.Pricer {
+	return orderUC.NewPricer(
+		infraPostgres.NewRestaurantRepository(db),
+		infraPostgres.NewMenuItemRepository(db),
+		infraPostgres.NewAddressRepository(db),
+		infraPostgres.NewDeliveryZoneRepository(db),
+		tax.NewTableCalculator(infraPostgres.NewTaxRateRepository(db)),
+		estimator, ledger, wallets,
+	)
+}
+
+func (a *App) newEstimator(orderRepo order.Repository) *orderUC.Estimator {
+	return orderUC.NewEstimator(orderRepo, orderUC.ETAParams{
+		MinutesPerQueuedOrder: a.Config.ETA.MinutesPerQueuedOrder,
+		TravelSpeedKmh:        a.Config.ETA.TravelSpeedKmh,
+		HandoffMinutes:        a.Config.ETA.HandoffMinutes,
+	})
+}
+
+// newStockDeduction builds the recipe-based inventory deduction run when
+// orders are confirmed and reversed when they are cancelled.
+func (a *App) newStockDeduction(db *gorm.DB) *orderUC.StockDeduction {
+	deductor := inventory.NewDeductor(
+		infraPostgres.NewInventoryItemRepository(db),
+		infraPostgres.NewUnitRepository(db),
+		inventory.DeductionPolicy(a.Config.Inventory.DeductionPolicy),
+	)
+	return orderUC.NewStockDeduction(infraPostgres.NewRecipeRepository(db), deductor)
+}
+
+// newLoyaltyLedger builds the points ledger that checkout, delivery,
+// cancellation and refunds write to.
+func (a *App) newLoyaltyLedger(db *gorm.DB) *loyalty.Ledger {
+	return loyalty.NewLedger(infraPostgres.NewLoyaltyRepository(db), a.loyaltyProgram())
+}
+
+func (a *App) loyaltyProgram() loyalty.Program {
+	return loyalty.Program{
+		PointValue:   a.Config.Loyalty.PointValue,
+		ExpiryMonths: a.Config.Loyalty.ExpiryMonths,
+	}
+}
+
+// newWalletLedger builds the double-entry ledger that top-ups, gift cards,
+// checkout and refunds write to.
+func (a *App) newWalletLedger(db *gorm.DB) *wallet.Ledger {
+	return wallet.NewLedger(infraPostgres.NewWalletRepository(db))
+}
+
+// newPaymentProvider builds the configured card payment provider; only the
+// sandbox is supported.
+func (a *App) newPaymentProvider() payment.Provider {
+	if p := a.Config.Payments.Provider; p != "" && p != "sandbox" {
+		conLog.Warn().Str("provider", p).Msg("Unknown payment provider, using the sandbox")
+	}
+	return infraPayment.NewSandboxProvider()
+}
+
+func (a *App) initDeliveryZoneRouter(db *gorm.DB) *handlers.DeliveryZoneHandler {
+	repo := infraPostgres.NewDeliveryZoneRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	createUC := deliveryUC.NewCreateZoneUseCase(repo, restaurantRepo)
+	listUC := deliveryUC.NewListZonesUseCase(repo)
+	deleteUC := deliveryUC.NewDeleteZoneUseCase(repo)
+
+	return handlers.NewDeliveryZoneHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		createUC, listUC, deleteUC,
+	)
+}
+
+func (a *App) initAddressRouter(db *gorm.DB) *handlers.AddressHandler {
+	repo := infraPostgres.NewAddressRepository(db)
+	geo := a.newGeocoder()
+
+	createUC := addressUC.NewCreateAddressUseCase(repo, geo)
+	getUC := addressUC.NewGetAddressUseCase(repo)
+	listUC := addressUC.NewListAddressesUseCase(repo)
+	updateUC := addressUC.NewUpdateAddressUseCase(repo, geo)
+	deleteUC := addressUC.NewDeleteAddressUseCase(repo)
+
+	return handlers.NewAddressHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		createUC, getUC, listUC, updateUC, deleteUC,
+	)
+}
+
+func (a *App) initInventoryRouter(db *gorm.DB) *handlers.InventoryHandler {
+	repo := infraPostgres.NewInventoryItemRepository(db)
+	unitRepo := infraPostgres.NewUnitRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	listUnitsUC := inventoryUC.NewListUnitsUseCase(unitRepo)
+	createUC := inventoryUC.NewCreateItemUseCase(repo, unitRepo, restaurantRepo)
+	getUC := inventoryUC.NewGetItemUseCase(repo)
+	listUC := inventoryUC.NewListItemsUseCase(repo)
+	updateUC := inventoryUC.NewUpdateItemUseCase(repo)
+	archiveUC := inventoryUC.NewArchiveItemUseCase(repo)
+	receiveUC := inventoryUC.NewReceiveStockUseCase(repo, unitRepo, transactor)
+	consumeUC := inventoryUC.NewConsumeStockUseCase(repo, unitRepo, transactor)
+	adjustUC := inventoryUC.NewAdjustStockUseCase(repo, unitRepo, transactor)
+	transactionsUC := inventoryUC.NewListTransactionsUseCase(repo)
+	expiringUC := inventoryUC.NewListExpiringUseCase(repo)
+
+	return handlers.NewInventoryHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		listUnitsUC, createUC, getUC, listUC, updateUC, archiveUC,
+		receiveUC, consumeUC, adjustUC, transactionsUC, expiringUC,
+	)
+}
+
+func (a *App) initPurchaseOrderRouter(db *gorm.DB) *handlers.PurchaseOrderHandler {
+	repo := infraPostgres.NewPurchaseOrderRepository(db)
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	supplierRepo := infraPostgres.NewSupplierRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	priceRepo := infraPostgres.NewSupplierPriceRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	createUC := purchasingUC.NewCreatePurchaseOrderUseCase(repo, itemRepo, supplierRepo, restaurantRepo)
+	updateUC := purchasingUC.NewUpdatePurchaseOrderUseCase(repo, itemRepo, supplierRepo, transactor)
+	getUC := purchasingUC.NewGetPurchaseOrderUseCase(repo)
+	listUC := purchasingUC.NewListPurchaseOrdersUseCase(repo)
+	statusUC := purchasingUC.NewUpdatePurchaseOrderStatusUseCase(repo, transactor)
+	receiveUC := purchasingUC.NewReceivePurchaseOrderUseCase(repo, itemRepo, priceRepo, transactor)
+	suggestUC := a.newSuggestReorders(db)
+	draftUC := purchasingUC.NewDraftReordersUseCase(suggestUC, repo, transactor)
+
+	return handlers.NewPurchaseOrderHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		createUC, updateUC, getUC, listUC, statusUC, receiveUC, suggestUC, draftUC,
+	)
+}
+
+func (a *App) newSuggestReorders(db *gorm.DB) *purchasingUC.SuggestReordersUseCase {
+	return purchasingUC.NewSuggestReordersUseCase(
+		infraPostgres.NewPurchaseOrderRepository(db),
+		infraPostgres.NewInventoryItemRepository(db),
+		infraPostgres.NewSupplierRepository(db),
+		purchasingUC.ReorderParams{
+			Mode:         purchasing.ReorderMode(a.Config.Inventory.ReorderMode),
+			ForecastDays: a.Config.Inventory.ForecastDays,
+		},
+	)
+}
+
+func (a *App) initRecipeRouter(db *gorm.DB) *handlers.RecipeHandler {
+	repo := infraPostgres.NewRecipeRepository(db)
+	menuRepo := infraPostgres.NewMenuItemRepository(db)
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	unitRepo := infraPostgres.NewUnitRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	saveUC := recipeUC.NewSaveRecipeUseCase(repo, menuRepo, itemRepo, unitRepo)
+	getUC := recipeUC.NewGetRecipeUseCase(repo)
+	deleteUC := recipeUC.NewDeleteRecipeUseCase(repo, menuRepo)
+	foodCostUC := recipeUC.NewFoodCostReportUseCase(repo, restaurantRepo, a.Config.Inventory.FoodCostThreshold)
+
+	return handlers.NewRecipeHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		saveUC, getUC, deleteUC, foodCostUC,
+	)
+}
+
+func (a *App) initStockAlertRouter(db *gorm.DB) *handlers.StockAlertHandler {
+	repo := infraPostgres.NewStockAlertRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	listUC := inventoryUC.NewListAlertsUseCase(repo)
+	acknowledgeUC := inventoryUC.NewAcknowledgeAlertUseCase(repo, transactor)
+	resolveUC := inventoryUC.NewResolveAlertUseCase(repo, transactor)
+	listSubscriptionsUC := inventoryUC.NewListAlertSubscriptionsUseCase(repo)
+	subscribeUC := inventoryUC.NewCreateAlertSubscriptionUseCase(repo, restaurantRepo)
+	unsubscribeUC := inventoryUC.NewDeleteAlertSubscriptionUseCase(repo)
+
+	return handlers.NewStockAlertHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		listUC, acknowledgeUC, resolveUC, listSubscriptionsUC, subscribeUC, unsubscribeUC,
+	)
+}
+
+func (a *App) initReportRouter(db *gorm.DB) *handlers.ReportHandler {
+	repo := infraPostgres.NewReportRepository(db)
+	menuRepo := infraPostgres.NewMenuItemRepository(db)
+	recipeRepo := infraPostgres.NewRecipeRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	dailySalesUC := reportUC.NewGetDailySalesUseCase(repo, restaurantRepo)
+	hourlySalesUC := reportUC.NewGetHourlySalesUseCase(repo, restaurantRepo)
+	itemSalesUC := reportUC.NewGetItemSalesUseCase(repo, restaurantRepo)
+	categorySalesUC := reportUC.NewGetCategorySalesUseCase(repo, restaurantRepo)
+	orderSummaryUC := reportUC.NewGetOrderSummaryUseCase(repo, restaurantRepo)
+	cancellationsUC := reportUC.NewGetCancellationsUseCase(repo, restaurantRepo)
+	valueUC := reportUC.NewGetInventoryValueUseCase(repo, restaurantRepo)
+	lowStockUC := reportUC.NewGetLowStockUseCase(repo, restaurantRepo)
+	usageUC := reportUC.NewGetInventoryUsageUseCase(repo, restaurantRepo)
+	menuEngineeringUC := reportUC.NewGetMenuEngineeringUseCase(repo, menuRepo, recipeRepo, restaurantRepo)
+
+	return handlers.NewReportHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		dailySalesUC, hourlySalesUC, itemSalesUC, categorySalesUC, orderSummaryUC,
+		cancellationsUC, valueUC, lowStockUC, usageUC, menuEngineeringUC,
+	)
+}
+
+func (a *App) initWasteRouter(db *gorm.DB) *handlers.WasteHandler {
+	repo := infraPostgres.NewInventoryItemRepository(db)
+	wasteRepo := infraPostgres.NewWasteRepository(db)
+	unitRepo := infraPostgres.NewUnitRepository(db)
+	recipeRepo := infraPostgres.NewRecipeRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+	// Wasted portions follow the same shortage policy as orders
+	deductor := inventory.NewDeductor(repo, unitRepo, inventory.DeductionPolicy(a.Config.Inventory.DeductionPolicy))
+
+	logUC := inventoryUC.NewLogWasteUseCase(repo, wasteRepo, unitRepo, recipeRepo, deductor, transactor)
+	listUC := inventoryUC.NewListWasteUseCase(wasteRepo)
+	reportUC := inventoryUC.NewWasteReportUseCase(wasteRepo, restaurantRepo)
+
+	return handlers.NewWasteHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		logUC, listUC, reportUC,
+	)
+}
+
+func (a *App) initStocktakeRouter(db *gorm.DB) *handlers.StocktakeHandler {
+	repo := infraPostgres.NewStocktakeRepository(db)
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	unitRepo := infraPostgres.NewUnitRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	openUC := stocktakeUC.NewOpenStocktakeUseCase(repo, itemRepo, restaurantRepo)
+	getUC := stocktakeUC.NewGetStocktakeUseCase(repo)
+	listUC := stocktakeUC.NewListStocktakesUseCase(repo)
+	countsUC := stocktakeUC.NewRecordCountsUseCase(repo, itemRepo, unitRepo, transactor)
+	statusUC := stocktakeUC.NewUpdateStocktakeStatusUseCase(repo, transactor)
+	approveUC := stocktakeUC.NewApproveStocktakeUseCase(repo, itemRepo, transactor)
+
+	return handlers.NewStocktakeHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		openUC, getUC, listUC, countsUC, statusUC, approveUC,
+	)
+}
+
+func (a *App) initTransferRouter(db *gorm.DB) *handlers.TransferHandler {
+	repo := infraPostgres.NewStockTransferRepository(db)
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	requestUC := transferUC.NewRequestTransferUseCase(repo, itemRepo, restaurantRepo)
+	getUC := transferUC.NewGetTransferUseCase(repo)
+	listUC := transferUC.NewListTransfersUseCase(repo)
+	shipUC := transferUC.NewShipTransferUseCase(repo, itemRepo, transactor)
+	receiveUC := transferUC.NewReceiveTransferUseCase(repo, itemRepo, transactor)
+	cancelUC := transferUC.NewCancelTransferUseCase(repo, transactor)
+
+	return handlers.NewTransferHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		requestUC, getUC, listUC, shipUC, receiveUC, cancelUC,
+	)
+}
+
+func (a *App) initSupplierRouter(db *gorm.DB) *handlers.SupplierHandler {
+	repo := infraPostgres.NewSupplierRepository(db)
+	priceRepo := infraPostgres.NewSupplierPriceRepository(db)
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	createUC := supplierUC.NewCreateSupplierUseCase(repo)
+	getUC := supplierUC.NewGetSupplierUseCase(repo)
+	listUC := supplierUC.NewListSuppliersUseCase(repo)
+	updateUC := supplierUC.NewUpdateSupplierUseCase(repo)
+	deactivateUC := supplierUC.NewDeactivateSupplierUseCase(repo)
+	setPricesUC := supplierUC.NewSetPricesUseCase(repo, priceRepo, itemRepo, transactor)
+	listPricesUC := supplierUC.NewListPricesUseCase(repo, priceRepo)
+	deletePriceUC := supplierUC.NewDeletePriceUseCase(priceRepo)
+	itemPricesUC := supplierUC.NewListItemPricesUseCase(priceRepo, itemRepo)
+	historyUC := supplierUC.NewListPriceHistoryUseCase(priceRepo, itemRepo)
+	recommendUC := supplierUC.NewRecommendSupplierUseCase(priceRepo)
+
+	return handlers.NewSupplierHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		createUC, getUC, listUC, updateUC, deactivateUC,
+		setPricesUC, listPricesUC, deletePriceUC, itemPricesUC, historyUC, recommendUC,
+	)
+}
+
+func (a *App) initValuationRouter(db *gorm.DB) *handlers.ValuationHandler {
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	valueUC := inventoryUC.NewValueInventoryUseCase(itemRepo, restaurantRepo)
+	setMethodUC := inventoryUC.NewSetValuationMethodUseCase(restaurantRepo)
+
+	return handlers.NewValuationHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		valueUC, setMethodUC,
+	)
+}
+
+func (a *App) initMenuRouter(db *gorm.DB) *handlers.MenuHandler {
+	repo := infraPostgres.NewMenuItemRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	listUC := menuUC.NewListMenuUseCase(repo, restaurantRepo)
+	availabilityUC := menuUC.NewListAvailabilityChangesUseCase(repo)
+
+	return handlers.NewMenuHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		listUC, availabilityUC,
+	)
+}
+
+func (a *App) initScanRouter(db *gorm.DB) *handlers.ScanHandler {
+	repo := infraPostgres.NewScanSessionRepository(db)
+	itemRepo := infraPostgres.NewInventoryItemRepository(db)
+	unitRepo := infraPostgres.NewUnitRepository(db)
+	poRepo := infraPostgres.NewPurchaseOrderRepository(db)
+	stocktakeRepo := infraPostgres.NewStocktakeRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	priceRepo := infraPostgres.NewSupplierPriceRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	receiveUC := purchasingUC.NewReceivePurchaseOrderUseCase(poRepo, itemRepo, priceRepo, transactor)
+	countsUC := stocktakeUC.NewRecordCountsUseCase(stocktakeRepo, itemRepo, unitRepo, transactor)
+
+	lookupUC := scanUC.NewLookupBarcodeUseCase(itemRepo, restaurantRepo)
+	startUC := scanUC.NewStartSessionUseCase(repo, poRepo, stocktakeRepo)
+	getUC := scanUC.NewGetSessionUseCase(repo)
+	listUC := scanUC.NewListSessionsUseCase(repo)
+	recordUC := scanUC.NewRecordScanUseCase(repo, itemRepo, unitRepo, poRepo, stocktakeRepo, transactor)
+	commitUC := scanUC.NewCommitSessionUseCase(repo, poRepo, receiveUC, countsUC, transactor)
+	cancelUC := scanUC.NewCancelSessionUseCase(repo, transactor)
+	listUnknownUC := scanUC.NewListUnknownBarcodesUseCase(repo)
+	mapUC := scanUC.NewMapBarcodeUseCase(repo, itemRepo, unitRepo, poRepo, stocktakeRepo, transactor)
+	dismissUC := scanUC.NewDismissBarcodeUseCase(repo)
+
+	return handlers.NewScanHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		lookupUC, startUC, getUC, listUC, recordUC, commitUC, cancelUC, listUnknownUC, mapUC, dismissUC,
+	)
+}
+
+func (a *App) initReviewRouter(db *gorm.DB) *handlers.ReviewHandler {
+	repo := infraPostgres.NewReviewRepository(db)
+	orderRepo := infraPostgres.NewOrderRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+
+	createUC := reviewUC.NewCreateReviewUseCase(repo, orderRepo, transactor)
+	getUC := reviewUC.NewGetOrderReviewUseCase(repo, orderRepo)
+	listUC := reviewUC.NewListReviewsUseCase(repo, restaurantRepo)
+	replyUC := reviewUC.NewReplyReviewUseCase(repo, transactor)
+	hideUC := reviewUC.NewHideReviewUseCase(repo, transactor)
+	restoreUC := reviewUC.NewRestoreReviewUseCase(repo, transactor)
+
+	return handlers.NewReviewHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		createUC, getUC, listUC, replyUC, hideUC, restoreUC,
+	)
+}
+
+func (a *App) initLoyaltyRouter(db *gorm.DB) *handlers.LoyaltyHandler {
+	repo := infraPostgres.NewLoyaltyRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+
+	accountUC := loyaltyUC.NewGetAccountUseCase(repo, a.loyaltyProgram())
+	entriesUC := loyaltyUC.NewListEntriesUseCase(repo)
+	getRuleUC := loyaltyUC.NewGetRuleUseCase(repo)
+	saveRuleUC := loyaltyUC.NewSaveRuleUseCase(repo, restaurantRepo)
+
+	return handlers.NewLoyaltyHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		accountUC, entriesUC, getRuleUC, saveRuleUC,
+	)
+}
+
+func (a *App) initWalletRouter(db *gorm.DB) *handlers.WalletHandler {
+	repo := infraPostgres.NewWalletRepository(db)
+	giftCardRepo := infraPostgres.NewGiftCardRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+	ledger := wallet.NewLedger(repo)
+	provider := a.newPaymentProvider()
+
+	getUC := walletUC.NewGetWalletUseCase(repo)
+	postingsUC := walletUC.NewListWalletPostingsUseCase(repo)
+	topUpUC := walletUC.NewTopUpWalletUseCase(ledger, provider, transactor)
+	purchaseUC := walletUC.NewPurchaseGiftCardUseCase(giftCardRepo, ledger, provider, a.newEmailSender(), transactor)
+	redeemUC := walletUC.NewRedeemGiftCardUseCase(giftCardRepo, ledger, transactor)
+	listCardsUC := walletUC.NewListGiftCardsUseCase(giftCardRepo)
+
+	return handlers.NewWalletHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		getUC, postingsUC, topUpUC, purchaseUC, redeemUC, listCardsUC,
+	)
+}
+
+// initTableRouter returns nil, leaving dine-in off, until tables.token_secret
+// is set.
+func (a *App) initTableRouter(db *gorm.DB) *handlers.TableHandler {
+	if !a.Config.Tables.HasSecret() {
+		conLog.Error().Msg("tables.token_secret is empty, dine-in table routes are disabled")
+		return nil
+	}
+	repo := infraPostgres.NewTableRepository(db)
+	sessionRepo := infraPostgres.NewTableSessionRepository(db)
+	orderRepo := infraPostgres.NewOrderRepository(db)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	transactor := infraPostgres.NewTransactor(db)
+	signer := table.NewSigner(a.Config.Tables.TokenSecret)
+	orderURL := a.Config.Tables.OrderURL
+
+	createUC := tableUC.NewCreateTableUseCase(repo, restaurantRepo)
+	listUC := tableUC.NewListTablesUseCase(repo)
+	updateUC := tableUC.NewUpdateTableUseCase(repo)
+	qrUC := tableUC.NewGetTableQRUseCase(repo, signer, orderURL)
+	rotateUC := tableUC.NewRotateTableTokenUseCase(repo, signer, orderURL)
+	scanUC := tableUC.NewScanTableUseCase(repo, sessionRepo, orderRepo, transactor, signer)
+	getTabUC := tableUC.NewGetTabUseCase(sessionRepo, orderRepo)
+	listTabsUC := tableUC.NewListTabsUseCase(sessionRepo)
+	moveUC := tableUC.NewMoveTabUseCase(repo, sessionRepo, orderRepo, transactor)
+	closeUC := tableUC.NewCloseTabUseCase(sessionRepo, orderRepo, transactor)
+	pricer := a.newPricer(db, a.newEstimator(orderRepo), a.newLoyaltyLedger(db), a.newWalletLedger(db))
+	placeUC := orderUC.NewPlaceTableOrderUseCase(orderRepo, sessionRepo, transactor, pricer)
+
+	return handlers.NewTableHandler(
+		middleware.JWTAuthMiddleware(a.Config.JWT.Secret),
+		middleware.TableSessionMiddleware(signer),
+		createUC, listUC, updateUC, qrUC, rotateUC, scanUC, getTabUC, listTabsUC, moveUC, closeUC, placeUC,
+	)
+}
+
+// newEmailSender sends email through the configured SMTP host, or to the
+// log until one is configured.
+func (a *App) newEmailSender() notification.Sender {
+	cfg := a.Config.Notifications
+	if cfg.SMTPHost == "" {
+		return infraNotification.NewLogNotifier(conLog)
+	}
+	return infraNotification.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From)
+}
+
+// newAlertSenders builds a sender per subscription channel.
+func (a *App) newAlertSenders() map[inventory.SubscriptionChannel]notification.Sender {
+	cfg := a.Config.Notifications
+	return map[inventory.SubscriptionChannel]notification.Sender{
+		inventory.ChannelEmail:   a.newEmailSender(),
+		inventory.ChannelWebhook: infraNotification.NewWebhookSender(time.Duration(cfg.WebhookTimeout)*time.Second, cfg.WebhookSecret),
+	}
+}
+
+// newGeocoder builds the configured Geocoder. If the static file can't be
+// loaded every address is rejected as not geocodable rather than accepted
+// without coordinates.
+func (a *App) newGeocoder() address.Geocoder {
+	geo, err := geocoder.LoadStaticGeocoder(a.Config.Geocoder.File)
+	if err != nil {
+		conLog.Error().Err(err).Str("file", a.Config.Geocoder.File).Msg("Failed to load static geocoder")
+		return geocoder.NewStaticGeocoder(nil)
+	}
+	return geo
+}
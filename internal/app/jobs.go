@@ -0,0 +1,182 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	infraNotification "github.com/james-wukong/orders-api/internal/infrastructure/notification"
+	infraPostgres "github.com/james-wukong/orders-api/internal/infrastructure/postgres"
+	inventoryUC "github.com/james-wukong/orders-api/internal/usecase/inventory"
+	loyaltyUC "github.com/james-wukong/orders-api/internal/usecase/loyalty"
+	orderUC "github.com/james-wukong/orders-api/internal/usecase/order"
+	purchasingUC "github.com/james-wukong/orders-api/internal/usecase/purchasing"
+	"gorm.io/gorm"
+)
+
+// JobRunner runs periodic background jobs until it is stopped. Every replica
+// runs its own jobs; each job must be safe to run concurrently.
+type JobRunner struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// every calls fn on each tick of interval until the runner is stopped
+func (j *JobRunner) every(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := fn(ctx); err != nil && ctx.Err() == nil {
+					conLog.Error().Err(err).Str("job", name).Msg("Background job failed")
+				}
+			}
+		}
+	}()
+}
+
+// Stop cancels all jobs and waits for running ones to return
+func (j *JobRunner) Stop() {
+	j.cancel()
+	j.wg.Wait()
+}
+
+func (a *App) startJobs(db *gorm.DB) *JobRunner {
+	// Jobs outlive the bootstrap context, so they get their own
+	ctx, cancel := context.WithCancel(context.Background())
+	runner := &JobRunner{cancel: cancel}
+	if !a.Config.Jobs.Enabled {
+		return runner
+	}
+
+	orderRepo := infraPostgres.NewOrderRepository(db)
+	releaseUC := orderUC.NewReleaseScheduledOrdersUseCase(
+		orderRepo,
+		infraPostgres.NewTransactor(db),
+		infraNotification.NewLogNotifier(conLog),
+		a.newEstimator(orderRepo),
+		a.newStockDeduction(db),
+	)
+	interval := time.Duration(a.Config.Jobs.ReleaseOrdersInterval) * time.Second
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	runner.every(ctx, "release_scheduled_orders", interval, func(ctx context.Context) error {
+		n, err := releaseUC.Execute(ctx, time.Now())
+		if n > 0 {
+			conLog.Info().Int("count", n).Msg("Released scheduled orders to the kitchen")
+		}
+		return err
+	})
+
+	draftUC := purchasingUC.NewDraftReordersUseCase(
+		a.newSuggestReorders(db),
+		infraPostgres.NewPurchaseOrderRepository(db),
+		infraPostgres.NewTransactor(db),
+	)
+	restaurantRepo := infraPostgres.NewRestaurantRepository(db)
+	reorderInterval := time.Duration(a.Config.Jobs.ReorderInterval) * time.Second
+	if reorderInterval <= 0 {
+		reorderInterval = time.Hour
+	}
+	runner.every(ctx, "draft_reorders", reorderInterval, func(ctx context.Context) error {
+		return forEachRestaurant(ctx, restaurantRepo, func(res *restaurant.Restaurant) error {
+			_, drafts, err := draftUC.Execute(ctx, res.ID, "", nil, time.Now())
+			if errors.Is(err, purchasing.ErrReorderInProgress) {
+				return nil // another replica has it
+			}
+			if err != nil {
+				return err
+			}
+			if len(drafts) > 0 {
+				conLog.Info().Str("restaurant_id", res.ID.String()).Int("count", len(drafts)).
+					Msg("Drafted purchase orders from low stock")
+			}
+			return nil
+		})
+	})
+
+	expiryUC := inventoryUC.NewCheckExpiryUseCase(
+		infraPostgres.NewInventoryItemRepository(db),
+		infraPostgres.NewTransactor(db),
+	)
+	expiryInterval := time.Duration(a.Config.Jobs.ExpiryCheckInterval) * time.Second
+	if expiryInterval <= 0 {
+		expiryInterval = time.Hour
+	}
+	runner.every(ctx, "check_expiry", expiryInterval, func(ctx context.Context) error {
+		return forEachRestaurant(ctx, restaurantRepo, func(res *restaurant.Restaurant) error {
+			alerts, err := expiryUC.Execute(ctx, res.ID, time.Now())
+			if len(alerts) > 0 {
+				conLog.Info().Str("restaurant_id", res.ID.String()).Int("count", len(alerts)).
+					Msg("Raised expiry alerts")
+			}
+			return err
+		})
+	})
+
+	notifyUC := inventoryUC.NewNotifyAlertsUseCase(
+		infraPostgres.NewStockAlertRepository(db),
+		a.newAlertSenders(),
+		infraPostgres.NewTransactor(db),
+	)
+	notifyInterval := time.Duration(a.Config.Jobs.AlertNotifyInterval) * time.Second
+	if notifyInterval <= 0 {
+		notifyInterval = time.Minute
+	}
+	runner.every(ctx, "notify_stock_alerts", notifyInterval, func(ctx context.Context) error {
+		n, err := notifyUC.Execute(ctx, time.Now())
+		if n > 0 {
+			conLog.Info().Int("count", n).Msg("Sent stock alerts to subscribers")
+		}
+		return err
+	})
+
+	expirePointsUC := loyaltyUC.NewExpirePointsUseCase(
+		infraPostgres.NewLoyaltyRepository(db),
+		a.newLoyaltyLedger(db),
+		infraPostgres.NewTransactor(db),
+	)
+	loyaltyInterval := time.Duration(a.Config.Jobs.LoyaltyExpiryInterval) * time.Second
+	if loyaltyInterval <= 0 {
+		loyaltyInterval = time.Hour
+	}
+	runner.every(ctx, "expire_loyalty_points", loyaltyInterval, func(ctx context.Context) error {
+		n, err := expirePointsUC.Execute(ctx, time.Now())
+		if n > 0 {
+			conLog.Info().Int("count", n).Msg("Expired unspent loyalty points")
+		}
+		return err
+	})
+	return runner
+}
+
+// forEachRestaurant calls fn for every restaurant, a page at a time
+func forEachRestaurant(
+	ctx context.Context, repo restaurant.Repository, fn func(res *restaurant.Restaurant) error,
+) error {
+	const page = 100
+	for offset := 0; ; offset += page {
+		restaurants, err := repo.List(ctx, page, offset)
+		if err != nil {
+			return err
+		}
+		for _, res := range restaurants {
+			if err := fn(res); err != nil {
+				return err
+			}
+		}
+		if len(restaurants) < page {
+			return nil
+		}
+	}
+}
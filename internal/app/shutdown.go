@@ -13,6 +13,11 @@ func Shutdown(ctx context.Context, app *App) error {
 		conLog.Error().Err(err).Msg("Error shutting down HTTP server")
 		return err
 	}
+	// Stop background jobs before their connections go away
+	if app.Jobs != nil {
+		app.Jobs.Stop()
+	}
+
 	// Close database and Redis connections if they exist
 	sqlDB, _ := app.Database.DB.DB()
 	if sqlDB != nil {
@@ -7,12 +7,20 @@ import (
 )
 
 type Config struct {
-	App      AppConfig      `mapstructure:"app"`
-	Database DatabaseConfig `mapstructure:"databases"`
-	Caches   CacheConfig    `mapstructure:"caches"`
-	NewRelic NewRelicConfig `mapstructure:"newrelic"`
-	JWT      JwtConfig      `mapstructure:"jwt"`
-	OTP      OtpConfig      `mapstructure:"otp"`
+	App           AppConfig           `mapstructure:"app"`
+	Database      DatabaseConfig      `mapstructure:"databases"`
+	Caches        CacheConfig         `mapstructure:"caches"`
+	NewRelic      NewRelicConfig      `mapstructure:"newrelic"`
+	JWT           JwtConfig           `mapstructure:"jwt"`
+	OTP           OtpConfig           `mapstructure:"otp"`
+	Geocoder      GeocoderConfig      `mapstructure:"geocoder"`
+	Jobs          JobsConfig          `mapstructure:"jobs"`
+	ETA           EtaConfig           `mapstructure:"eta"`
+	Inventory     InventoryConfig     `mapstructure:"inventory"`
+	Notifications NotificationsConfig `mapstructure:"notifications"`
+	Loyalty       LoyaltyConfig       `mapstructure:"loyalty"`
+	Payments      PaymentsConfig      `mapstructure:"payments"`
+	Tables        TablesConfig        `mapstructure:"tables"`
 }
 
 type AppConfig struct {
@@ -71,6 +79,79 @@ type OtpConfig struct {
 	Password string `mapstructure:"password"`
 }
 
+type GeocoderConfig struct {
+	Provider string `mapstructure:"provider"` // only "static" is supported
+	File     string `mapstructure:"file"`
+}
+
+type JobsConfig struct {
+	Enabled               bool `mapstructure:"enabled"`
+	ReleaseOrdersInterval int  `mapstructure:"release_orders_interval"` // seconds
+	ReorderInterval       int  `mapstructure:"reorder_interval"`        // seconds
+	ExpiryCheckInterval   int  `mapstructure:"expiry_check_interval"`   // seconds
+	AlertNotifyInterval   int  `mapstructure:"alert_notify_interval"`   // seconds
+	LoyaltyExpiryInterval int  `mapstructure:"loyalty_expiry_interval"` // seconds
+}
+
+type EtaConfig struct {
+	MinutesPerQueuedOrder float64 `mapstructure:"minutes_per_queued_order"`
+	TravelSpeedKmh        float64 `mapstructure:"travel_speed_kmh"`
+	HandoffMinutes        int     `mapstructure:"handoff_minutes"`
+}
+
+type InventoryConfig struct {
+	// DeductionPolicy is "reject" to refuse confirming orders that would
+	// drive stock negative, or "allow" to confirm them and raise an alert
+	DeductionPolicy string `mapstructure:"deduction_policy"`
+	// FoodCostThreshold is the food-cost percentage of the menu price above
+	// which the food-cost report flags an item
+	FoodCostThreshold float64 `mapstructure:"food_cost_threshold"`
+	// ReorderMode is "standard" or "forecast"; ForecastDays is the trailing
+	// usage window forecasts average over
+	ReorderMode  string `mapstructure:"reorder_mode"`
+	ForecastDays int    `mapstructure:"forecast_days"`
+}
+
+// NotificationsConfig configures how stock alerts reach subscribers. Without
+// an SMTP host, emails are written to the log instead.
+type NotificationsConfig struct {
+	SMTPHost       string `mapstructure:"smtp_host"`
+	SMTPPort       int    `mapstructure:"smtp_port"`
+	SMTPUsername   string `mapstructure:"smtp_username"`
+	SMTPPassword   string `mapstructure:"smtp_password"`
+	From           string `mapstructure:"from"`
+	WebhookSecret  string `mapstructure:"webhook_secret"`  // signs webhook bodies when set
+	WebhookTimeout int    `mapstructure:"webhook_timeout"` // seconds
+}
+
+// LoyaltyConfig holds the points program settings shared by every
+// restaurant; how orders earn points is set per restaurant.
+type LoyaltyConfig struct {
+	// PointValue is what one point takes off an order at checkout
+	PointValue float64 `mapstructure:"point_value"`
+	// ExpiryMonths is how long points last once earned; 0 keeps them forever
+	ExpiryMonths int `mapstructure:"expiry_months"`
+}
+
+type PaymentsConfig struct {
+	Provider string `mapstructure:"provider"` // only "sandbox" is supported
+}
+
+// TablesConfig configures dine-in QR codes. Changing TokenSecret retires
+// every printed code and every open tab's guest tokens.
+type TablesConfig struct {
+	TokenSecret string `mapstructure:"token_secret"`
+	// OrderURL is the page a table's QR code opens; the table token is
+	// added as its "table" parameter
+	OrderURL string `mapstructure:"order_url"`
+}
+
+// HasSecret reports whether TokenSecret is set. Anyone who knows the secret
+// can sign table and guest tokens, so dine-in stays off without one.
+func (c TablesConfig) HasSecret() bool {
+	return c.TokenSecret != ""
+}
+
 func InitConfig() *Config {
 	viper.SetConfigName("conf") // Name of your file (config.yaml)
 	viper.SetConfigType("yml")
@@ -0,0 +1,67 @@
+// Package address defines the delivery Address entity.
+package address
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Address struct {
+	ID                   uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID               uuid.UUID `gorm:"type:uuid;not null"`
+	Label                string    `gorm:"size:50"`
+	StreetAddress        string    `gorm:"type:text;not null"`
+	Apartment            string    `gorm:"size:50"`
+	City                 string    `gorm:"size:100;not null"`
+	State                string    `gorm:"size:100"`
+	PostalCode           string    `gorm:"size:20;not null"`
+	Country              string    `gorm:"size:100;default:'USA'"`
+	Latitude             *float64  `gorm:"type:decimal(10,8)"`
+	Longitude            *float64  `gorm:"type:decimal(11,8)"`
+	Phone                string    `gorm:"size:20"`
+	IsDefault            bool      `gorm:"default:false"`
+	DeliveryInstructions string    `gorm:"type:text"`
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Address) TableName() string {
+	return "delivery_addresses"
+}
+
+// HasCoordinates reports whether the address has been geocoded.
+func (a *Address) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
+
+// NewAddress is a Factory Function that ensures an Address
+// is always created with a valid ID and default business state.
+func NewAddress(userID uuid.UUID, street, city, postalCode string) *Address {
+	return &Address{
+		ID:            uuid.New(),
+		UserID:        userID,
+		StreetAddress: street,
+		City:          city,
+		PostalCode:    postalCode,
+		Country:       "USA",
+	}
+}
+
+// Query returns the parts of the address a Geocoder needs.
+func (a *Address) Query() Query {
+	return Query{
+		StreetAddress: a.StreetAddress,
+		City:          a.City,
+		State:         a.State,
+		PostalCode:    a.PostalCode,
+		Country:       a.Country,
+	}
+}
+
+// SetCoordinates stores the geocoded position on the address.
+func (a *Address) SetCoordinates(c *Coordinates) {
+	lat, lng := c.Latitude, c.Longitude
+	a.Latitude = &lat
+	a.Longitude = &lng
+}
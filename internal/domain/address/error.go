@@ -0,0 +1,8 @@
+package address
+
+import "errors"
+
+var (
+	ErrAddressNotFound    = errors.New("delivery address not found")
+	ErrAddressNotGeocoded = errors.New("address could not be geocoded")
+)
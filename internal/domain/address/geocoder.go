@@ -0,0 +1,24 @@
+package address
+
+import "context"
+
+// Query is the postal address to locate.
+type Query struct {
+	StreetAddress string
+	City          string
+	State         string
+	PostalCode    string
+	Country       string
+}
+
+// Coordinates is a geocoded position.
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Geocoder resolves a postal address to coordinates. It returns
+// ErrAddressNotGeocoded when the address cannot be located.
+type Geocoder interface {
+	Geocode(ctx context.Context, q Query) (*Coordinates, error)
+}
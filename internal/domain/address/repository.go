@@ -0,0 +1,22 @@
+// Package address defines the domain model and repository interface for managing delivery addresses.
+package address
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository persists addresses. Create and Update keep at most one default
+// address per user: saving an address with IsDefault set clears the flag on
+// the user's other addresses in the same transaction.
+type Repository interface {
+	Create(ctx context.Context, address *Address) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Address, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Address, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	Update(ctx context.Context, address *Address) error
+	// Delete removes the address and, if it was the default, promotes the
+	// user's most recently updated remaining address.
+	Delete(ctx context.Context, address *Address) error
+}
@@ -0,0 +1,102 @@
+// Package delivery defines delivery zones and the rules that decide whether,
+// and for how much, a restaurant delivers to a given point.
+package delivery
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ZoneType selects how a zone's area is described.
+type ZoneType string
+
+const (
+	// ZoneRadius is a ring around the restaurant between MinRadiusKm and MaxRadiusKm.
+	ZoneRadius ZoneType = "radius"
+	// ZonePolygon is an arbitrary GeoJSON (multi)polygon.
+	ZonePolygon ZoneType = "polygon"
+)
+
+type Zone struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID    uuid.UUID `gorm:"type:uuid;not null"`
+	Name            string    `gorm:"size:100;not null"`
+	ZoneType        ZoneType  `gorm:"size:20;not null"`
+	MinRadiusKm     float64   `gorm:"type:decimal(8,3);default:0"`
+	MaxRadiusKm     float64   `gorm:"type:decimal(8,3);default:0"`
+	Polygon         *Geometry `gorm:"type:jsonb"`
+	DeliveryFee     float64   `gorm:"type:decimal(10,2);default:0.00"`
+	MinimumOrder    float64   `gorm:"type:decimal(10,2);default:0.00"`
+	ExtraETAMinutes int       `gorm:"column:extra_eta_minutes;default:0"`
+	Priority        int       `gorm:"default:0"`
+	IsActive        bool      `gorm:"default:true"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Zone) TableName() string {
+	return "delivery_zones"
+}
+
+// NewRadiusZone is a Factory Function for a ring-shaped zone.
+func NewRadiusZone(restaurantID uuid.UUID, name string, minKm, maxKm float64) (*Zone, error) {
+	if minKm < 0 || maxKm <= minKm {
+		return nil, ErrInvalidRadius
+	}
+	return &Zone{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		Name:         name,
+		ZoneType:     ZoneRadius,
+		MinRadiusKm:  minKm,
+		MaxRadiusKm:  maxKm,
+		IsActive:     true,
+	}, nil
+}
+
+// NewPolygonZone is a Factory Function for a GeoJSON polygon zone.
+func NewPolygonZone(restaurantID uuid.UUID, name string, geometry *Geometry) (*Zone, error) {
+	if geometry == nil {
+		return nil, ErrInvalidGeometry
+	}
+	if err := geometry.Validate(); err != nil {
+		return nil, err
+	}
+	return &Zone{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		Name:         name,
+		ZoneType:     ZonePolygon,
+		Polygon:      geometry,
+		IsActive:     true,
+	}, nil
+}
+
+// Point is a latitude/longitude pair.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Contains reports whether dest is served by the zone of a restaurant located at origin.
+func (z *Zone) Contains(origin, dest Point) bool {
+	if !z.IsActive {
+		return false
+	}
+	switch z.ZoneType {
+	case ZoneRadius:
+		d := DistanceKm(origin.Lat, origin.Lng, dest.Lat, dest.Lng)
+		return d >= z.MinRadiusKm && d <= z.MaxRadiusKm
+	case ZonePolygon:
+		return z.Polygon != nil && z.Polygon.Contains(dest.Lat, dest.Lng)
+	default:
+		return false
+	}
+}
+
+// Quote is the outcome of matching an address against a restaurant's zones.
+type Quote struct {
+	Zone       *Zone
+	DistanceKm float64
+}
@@ -0,0 +1,26 @@
+package delivery
+
+import "errors"
+
+var (
+	ErrZoneNotFound          = errors.New("delivery zone not found")
+	ErrInvalidGeometry       = errors.New("invalid GeoJSON polygon")
+	ErrInvalidRadius         = errors.New("max_radius_km must be greater than min_radius_km")
+	ErrInvalidZoneType       = errors.New("invalid delivery zone type")
+	ErrRestaurantNotLocated  = errors.New("restaurant has no coordinates")
+	ErrOutsideDeliveryArea   = errors.New("address is outside every delivery zone")
+	ErrNoDeliveryZones       = errors.New("restaurant has no delivery zones")
+	ErrAddressRequired       = errors.New("a delivery address is required for delivery orders")
+	ErrAddressNotLocated     = errors.New("delivery address has no coordinates")
+	ErrBelowZoneMinimumOrder = errors.New("order subtotal is below the minimum for this delivery zone")
+)
+
+// Machine-readable codes returned alongside the error message so clients can
+// react without parsing text.
+const (
+	CodeOutsideDeliveryArea   = "OUTSIDE_DELIVERY_AREA"
+	CodeNoDeliveryZones       = "NO_DELIVERY_ZONES"
+	CodeAddressRequired       = "DELIVERY_ADDRESS_REQUIRED"
+	CodeAddressNotLocated     = "DELIVERY_ADDRESS_NOT_GEOCODED"
+	CodeBelowZoneMinimumOrder = "BELOW_ZONE_MINIMUM_ORDER"
+)
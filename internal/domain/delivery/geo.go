@@ -0,0 +1,124 @@
+package delivery
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+)
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm returns the great-circle distance between two points using the haversine formula.
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// Geometry is a GeoJSON Polygon or MultiPolygon geometry.
+// Positions are [longitude, latitude] as the GeoJSON spec requires.
+type Geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type ring [][2]float64
+
+func (g *Geometry) polygons() ([][]ring, error) {
+	switch g.Type {
+	case "Polygon":
+		var p []ring
+		if err := json.Unmarshal(g.Coordinates, &p); err != nil {
+			return nil, ErrInvalidGeometry
+		}
+		return [][]ring{p}, nil
+	case "MultiPolygon":
+		var mp [][]ring
+		if err := json.Unmarshal(g.Coordinates, &mp); err != nil {
+			return nil, ErrInvalidGeometry
+		}
+		return mp, nil
+	default:
+		return nil, ErrInvalidGeometry
+	}
+}
+
+// Validate checks the geometry is a well-formed (multi)polygon with closed rings.
+func (g *Geometry) Validate() error {
+	polys, err := g.polygons()
+	if err != nil {
+		return err
+	}
+	if len(polys) == 0 {
+		return ErrInvalidGeometry
+	}
+	for _, p := range polys {
+		if len(p) == 0 {
+			return ErrInvalidGeometry
+		}
+		for _, r := range p {
+			if len(r) < 4 || r[0] != r[len(r)-1] {
+				return ErrInvalidGeometry
+			}
+		}
+	}
+	return nil
+}
+
+// Contains reports whether the point lies inside the geometry.
+// The first ring of each polygon is the outer boundary; further rings are holes.
+func (g *Geometry) Contains(lat, lng float64) bool {
+	polys, err := g.polygons()
+	if err != nil {
+		return false
+	}
+	for _, p := range polys {
+		if len(p) == 0 || !p[0].contains(lat, lng) {
+			continue
+		}
+		inHole := false
+		for _, hole := range p[1:] {
+			if hole.contains(lat, lng) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// contains uses ray casting; delivery zones are small enough to treat as planar.
+func (r ring) contains(lat, lng float64) bool {
+	inside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		xi, yi := r[i][0], r[i][1]
+		xj, yj := r[j][0], r[j][1]
+		if (yi > lat) != (yj > lat) && lng < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func (g Geometry) Value() (driver.Value, error) {
+	raw, err := json.Marshal(g)
+	return string(raw), err
+}
+
+func (g *Geometry) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, g)
+	case string:
+		return json.Unmarshal([]byte(v), g)
+	default:
+		return errors.New("delivery: unsupported geometry type")
+	}
+}
@@ -0,0 +1,82 @@
+package delivery
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// square is a closed ring of [lng, lat] positions from (min, min) to (max, max)
+func square(minLng, minLat, maxLng, maxLat float64) [][2]float64 {
+	return [][2]float64{
+		{minLng, minLat}, {maxLng, minLat}, {maxLng, maxLat}, {minLng, maxLat}, {minLng, minLat},
+	}
+}
+
+func geometry(t *testing.T, typ string, coordinates any) *Geometry {
+	t.Helper()
+	raw, err := json.Marshal(coordinates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Geometry{Type: typ, Coordinates: raw}
+}
+
+func TestGeometryContains(t *testing.T) {
+	outer := square(0, 0, 10, 10)
+	hole := square(4, 4, 6, 6)
+	island := square(4.5, 4.5, 5.5, 5.5)
+
+	polygon := geometry(t, "Polygon", [][][2]float64{outer})
+	withHole := geometry(t, "Polygon", [][][2]float64{outer, hole})
+	multi := geometry(t, "MultiPolygon", [][][][2]float64{{outer, hole}, {island}})
+
+	tests := []struct {
+		name     string
+		geometry *Geometry
+		lat, lng float64
+		want     bool
+	}{
+		{"inside polygon", polygon, 5, 5, true},
+		{"outside polygon", polygon, 11, 5, false},
+		{"latitude and longitude are not swapped", geometry(t, "Polygon", [][][2]float64{square(0, 0, 20, 2)}), 1, 15, true},
+		{"inside outer ring, outside hole", withHole, 2, 2, true},
+		{"inside hole", withHole, 5, 5, false},
+		{"between hole and outer ring", withHole, 5, 8, true},
+		{"hole of one polygon covered by another", multi, 5, 5, true},
+		{"in hole, outside the island", multi, 4.2, 4.2, false},
+		{"outside every polygon", multi, -1, -1, false},
+		{"unsupported type", geometry(t, "Point", [2]float64{5, 5}), 5, 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geometry.Contains(tt.lat, tt.lng); got != tt.want {
+				t.Errorf("Contains(%v, %v) = %v, want %v", tt.lat, tt.lng, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometryValidate(t *testing.T) {
+	open := [][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+
+	tests := []struct {
+		name     string
+		geometry *Geometry
+		wantErr  bool
+	}{
+		{"closed polygon", geometry(t, "Polygon", [][][2]float64{square(0, 0, 1, 1)}), false},
+		{"polygon with hole", geometry(t, "Polygon", [][][2]float64{square(0, 0, 10, 10), square(4, 4, 6, 6)}), false},
+		{"open ring", geometry(t, "Polygon", [][][2]float64{open}), true},
+		{"too few positions", geometry(t, "Polygon", [][][2]float64{{{0, 0}, {1, 1}, {0, 0}}}), true},
+		{"no rings", geometry(t, "Polygon", [][][2]float64{}), true},
+		{"empty multipolygon", geometry(t, "MultiPolygon", [][][][2]float64{}), true},
+		{"wrong type", geometry(t, "LineString", [][2]float64{{0, 0}, {1, 1}}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.geometry.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, zone *Zone) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Zone, error)
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*Zone, error)
+	Update(ctx context.Context, zone *Zone) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,30 @@
+package delivery
+
+import "sort"
+
+// Resolve picks the zone that serves dest. When zones overlap, the lowest
+// Priority wins, then the cheapest fee. It returns ErrOutsideDeliveryArea
+// when no active zone contains the point.
+func Resolve(zones []*Zone, origin, dest Point) (*Quote, error) {
+	candidates := make([]*Zone, 0, len(zones))
+	for _, z := range zones {
+		if z.Contains(origin, dest) {
+			candidates = append(candidates, z)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, ErrOutsideDeliveryArea
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority < candidates[j].Priority
+		}
+		return candidates[i].DeliveryFee < candidates[j].DeliveryFee
+	})
+
+	return &Quote{
+		Zone:       candidates[0],
+		DistanceKm: DistanceKm(origin.Lat, origin.Lng, dest.Lat, dest.Lng),
+	}, nil
+}
@@ -0,0 +1,182 @@
+package inventory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlertType mirrors alert_type_enum.
+type AlertType string
+
+const (
+	AlertLowStock     AlertType = "low_stock"
+	AlertOutOfStock   AlertType = "out_of_stock"
+	AlertExpiringSoon AlertType = "expiring_soon"
+	AlertExpired      AlertType = "expired"
+)
+
+func (t AlertType) Valid() bool {
+	switch t {
+	case AlertLowStock, AlertOutOfStock, AlertExpiringSoon, AlertExpired:
+		return true
+	}
+	return false
+}
+
+// Alert is a stock condition that needs attention (stock_alerts). Only one
+// alert per item, type and expiry date is open at a time.
+type Alert struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	InventoryItemID uuid.UUID  `gorm:"type:uuid;not null"`
+	AlertType       AlertType  `gorm:"type:alert_type_enum;not null"`
+	CurrentStock    *float64   `gorm:"type:decimal(12,3)"`
+	ThresholdValue  *float64   `gorm:"type:decimal(12,3)"`
+	ExpiryDate      *time.Time `gorm:"type:date"`
+	IsResolved      bool       `gorm:"not null;default:false"`
+	ResolvedAt      *time.Time
+	ResolvedBy      *uuid.UUID `gorm:"type:uuid"`
+	AcknowledgedAt  *time.Time
+	AcknowledgedBy  *uuid.UUID `gorm:"type:uuid"`
+	// NotifiedAt is set once subscribers have been sent the alert
+	NotifiedAt *time.Time
+	// NotifyAttempts counts the runs that have claimed the alert for sending.
+	// A run's claim lapses at NotifyClaimedUntil, so an alert whose run
+	// failed or died before sending it is picked up again.
+	NotifyAttempts     int `gorm:"not null;default:0"`
+	NotifyClaimedUntil *time.Time
+	Notes              string    `gorm:"type:text"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+
+	Item *Item `gorm:"foreignKey:InventoryItemID"`
+}
+
+func (Alert) TableName() string {
+	return "stock_alerts"
+}
+
+// Acknowledge records that someone has seen the alert and is on it. It
+// stays open until resolved.
+func (a *Alert) Acknowledge(by uuid.UUID, at time.Time) error {
+	if a.IsResolved {
+		return ErrAlertResolved
+	}
+	if a.AcknowledgedAt == nil {
+		a.AcknowledgedAt = &at
+		a.AcknowledgedBy = &by
+	}
+	return nil
+}
+
+// Resolve closes the alert, acknowledging it too if nobody had.
+func (a *Alert) Resolve(by uuid.UUID, at time.Time, notes string) error {
+	if err := a.Acknowledge(by, at); err != nil {
+		return err
+	}
+	a.IsResolved = true
+	a.ResolvedAt = &at
+	a.ResolvedBy = &by
+	if notes != "" {
+		if a.Notes != "" {
+			a.Notes += "\n"
+		}
+		a.Notes += notes
+	}
+	return nil
+}
+
+// Subject is a one-line summary of the alert for notifications. The Item
+// must be loaded.
+func (a *Alert) Subject() string {
+	switch a.AlertType {
+	case AlertLowStock:
+		return fmt.Sprintf("%s is running low", a.Item.Name)
+	case AlertOutOfStock:
+		return fmt.Sprintf("%s is out of stock", a.Item.Name)
+	case AlertExpiringSoon:
+		return fmt.Sprintf("%s is expiring soon", a.Item.Name)
+	case AlertExpired:
+		return fmt.Sprintf("%s has expired", a.Item.Name)
+	}
+	return fmt.Sprintf("Stock alert for %s", a.Item.Name)
+}
+
+// Body describes the alert for notifications. The Item must be loaded.
+func (a *Alert) Body() string {
+	unit := ""
+	if a.Item.Unit != nil {
+		unit = " " + a.Item.Unit.Abbreviation
+	}
+	body := a.Subject() + "."
+	if a.CurrentStock != nil {
+		body += fmt.Sprintf(" Stock: %g%s.", *a.CurrentStock, unit)
+	}
+	if a.ThresholdValue != nil && a.AlertType == AlertLowStock {
+		body += fmt.Sprintf(" Minimum: %g%s.", *a.ThresholdValue, unit)
+	}
+	if a.ExpiryDate != nil {
+		body += " Expiry date: " + a.ExpiryDate.Format(time.DateOnly) + "."
+	}
+	if a.Notes != "" {
+		body += "\n" + a.Notes
+	}
+	return body
+}
+
+// AlertStatus narrows alert listings. Open alerts are not resolved;
+// unacknowledged ones are open and nobody has acknowledged them yet.
+type AlertStatus string
+
+const (
+	AlertStatusOpen           AlertStatus = "open"
+	AlertStatusUnacknowledged AlertStatus = "unacknowledged"
+	AlertStatusResolved       AlertStatus = "resolved"
+	AlertStatusAll            AlertStatus = "all"
+)
+
+// AlertFilter narrows AlertRepository.List. Zero values match everything except
+// Status, which defaults to open alerts.
+type AlertFilter struct {
+	Status          AlertStatus
+	Type            AlertType
+	InventoryItemID *uuid.UUID
+	Limit           int
+}
+
+// SubscriptionChannel is how a subscriber is sent new alerts.
+type SubscriptionChannel string
+
+const (
+	ChannelEmail   SubscriptionChannel = "email"
+	ChannelWebhook SubscriptionChannel = "webhook"
+)
+
+// AlertSubscription sends a restaurant's new stock alerts to an email
+// address or webhook URL on behalf of one of its inventory managers
+// (stock_alert_subscriptions).
+type AlertSubscription struct {
+	ID           uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID uuid.UUID           `gorm:"type:uuid;not null"`
+	UserID       uuid.UUID           `gorm:"type:uuid;not null"`
+	Channel      SubscriptionChannel `gorm:"size:20;not null"`
+	Target       string              `gorm:"type:text;not null"`
+	IsActive     bool                `gorm:"not null;default:true"`
+	CreatedAt    time.Time           `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time           `gorm:"autoUpdateTime"`
+}
+
+func (AlertSubscription) TableName() string {
+	return "stock_alert_subscriptions"
+}
+
+func NewAlertSubscription(restaurantID, userID uuid.UUID, channel SubscriptionChannel, target string) *AlertSubscription {
+	return &AlertSubscription{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		UserID:       userID,
+		Channel:      channel,
+		Target:       target,
+		IsActive:     true,
+	}
+}
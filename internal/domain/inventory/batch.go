@@ -0,0 +1,219 @@
+package inventory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Batch is one received lot of an item (inventory_batches). The item's
+// CurrentStock stays the total; batches say which lots it is made of and
+// when each expires. Stock not held in any batch, e.g. from a positive
+// adjustment, is untracked and used after all batches.
+type Batch struct {
+	ID                uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	InventoryItemID   uuid.UUID  `gorm:"type:uuid;not null"`
+	BatchNumber       string     `gorm:"size:100"`
+	ExpiryDate        *time.Time `gorm:"type:date"`
+	QuantityReceived  float64    `gorm:"type:decimal(12,3);not null"`
+	QuantityRemaining float64    `gorm:"type:decimal(12,3);not null"`
+	UnitCost          *float64   `gorm:"type:decimal(10,2)"`
+	ReceivedAt        time.Time  `gorm:"not null"`
+	ReferenceType     string     `gorm:"size:50"`
+	ReferenceID       *uuid.UUID `gorm:"type:uuid"`
+	// ExpiryAlert is the last expiry alert raised for the lot
+	ExpiryAlert *AlertType `gorm:"type:alert_type_enum"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime"`
+
+	Item *Item `gorm:"foreignKey:InventoryItemID"`
+}
+
+func (Batch) TableName() string {
+	return "inventory_batches"
+}
+
+// NewBatch records the lot behind a purchase entry returned by Receive and
+// stamps the entry with it. Without an expiry date the item's shelf life, if
+// set, decides one. A receipt that only makes up for negative stock leaves
+// nothing in the lot beyond what is now on hand.
+func (i *Item) NewBatch(entry *Transaction, number string, expiry *time.Time, at time.Time) *Batch {
+	if expiry == nil && i.ShelfLifeDays != nil {
+		day := at.Truncate(24*time.Hour).AddDate(0, 0, *i.ShelfLifeDays)
+		expiry = &day
+	}
+	remaining := entry.Quantity
+	if i.CurrentStock < remaining {
+		remaining = max(i.CurrentStock, 0)
+	}
+	entry.BatchNumber = number
+	entry.ExpiryDate = expiry
+	return &Batch{
+		ID:                uuid.New(),
+		InventoryItemID:   i.ID,
+		BatchNumber:       number,
+		ExpiryDate:        expiry,
+		QuantityReceived:  entry.Quantity,
+		QuantityRemaining: remaining,
+		UnitCost:          entry.UnitCost,
+		ReceivedAt:        at,
+		ReferenceType:     entry.ReferenceType,
+		ReferenceID:       entry.ReferenceID,
+	}
+}
+
+// BatchDraw is how much a movement took from one batch.
+type BatchDraw struct {
+	Batch    *Batch
+	Quantity float64
+}
+
+// SortFEFO orders batches first-expiring first. Undated batches come last,
+// oldest first.
+func SortFEFO(batches []*Batch) {
+	sort.SliceStable(batches, func(a, b int) bool {
+		ea, eb := batches[a].ExpiryDate, batches[b].ExpiryDate
+		switch {
+		case ea != nil && eb != nil && !ea.Equal(*eb):
+			return ea.Before(*eb)
+		case ea != nil && eb == nil:
+			return true
+		case ea == nil && eb != nil:
+			return false
+		}
+		return batches[a].ReceivedAt.Before(batches[b].ReceivedAt)
+	})
+}
+
+// TakeFEFO removes qty from batches, first-expiring first, and returns what
+// it took from each. Whatever the batches can't cover comes out of
+// untracked stock and is not returned.
+func TakeFEFO(batches []*Batch, qty float64) []BatchDraw {
+	SortFEFO(batches)
+	var draws []BatchDraw
+	left := RoundQty(qty)
+	for _, b := range batches {
+		if left <= 0 {
+			break
+		}
+		if b.QuantityRemaining <= 0 {
+			continue
+		}
+		take := min(left, b.QuantityRemaining)
+		b.QuantityRemaining = RoundQty(b.QuantityRemaining - take)
+		left = RoundQty(left - take)
+		draws = append(draws, BatchDraw{Batch: b, Quantity: take})
+	}
+	return draws
+}
+
+// DrawFEFO is Draw split by batch: one usage entry per batch taken from,
+// first-expiring first, plus one for any remainder not held in a batch.
+// The caller saves the batches in the returned draws.
+func (i *Item) DrawFEFO(qty float64, batches []*Batch) ([]*Transaction, []BatchDraw) {
+	return i.drawFEFO(TransactionUsage, qty, batches)
+}
+
+func (i *Item) drawFEFO(t TransactionType, qty float64, batches []*Batch) ([]*Transaction, []BatchDraw) {
+	draws := TakeFEFO(batches, qty)
+	entries := make([]*Transaction, 0, len(draws)+1)
+	left := RoundQty(qty)
+	for _, d := range draws {
+		entry := i.draw(t, d.Quantity)
+		entry.BatchNumber = d.Batch.BatchNumber
+		entry.ExpiryDate = d.Batch.ExpiryDate
+		entries = append(entries, entry)
+		left = RoundQty(left - d.Quantity)
+	}
+	if left > 0 {
+		entries = append(entries, i.draw(t, left))
+	}
+	return entries, draws
+}
+
+// ConsumeFEFO is Consume split by batch like DrawFEFO. It never drives
+// stock negative.
+func (i *Item) ConsumeFEFO(qty float64, batches []*Batch) ([]*Transaction, []BatchDraw, error) {
+	if qty <= 0 {
+		return nil, nil, ErrNonPositiveQuantity
+	}
+	if RoundQty(i.CurrentStock-qty) < 0 {
+		return nil, nil, ErrInsufficientStock
+	}
+	entries, draws := i.DrawFEFO(qty, batches)
+	return entries, draws, nil
+}
+
+// WasteFEFO writes qty off as waste, split by batch like DrawFEFO, with
+// the reason on every entry. It never drives stock negative.
+func (i *Item) WasteFEFO(qty float64, reason AdjustmentReason, batches []*Batch) ([]*Transaction, []BatchDraw, error) {
+	if qty <= 0 {
+		return nil, nil, ErrNonPositiveQuantity
+	}
+	if !reason.IsWaste() {
+		return nil, nil, ErrInvalidWasteReason
+	}
+	if RoundQty(i.CurrentStock-qty) < 0 {
+		return nil, nil, ErrInsufficientStock
+	}
+	entries, draws := i.drawFEFO(TransactionWaste, qty, batches)
+	for _, entry := range entries {
+		entry.Reason = string(reason)
+	}
+	return entries, draws, nil
+}
+
+// TransferOutFEFO sends qty to another restaurant, split by batch like
+// DrawFEFO. It never drives stock negative.
+func (i *Item) TransferOutFEFO(qty float64, batches []*Batch) ([]*Transaction, []BatchDraw, error) {
+	if qty <= 0 {
+		return nil, nil, ErrNonPositiveQuantity
+	}
+	if RoundQty(i.CurrentStock-qty) < 0 {
+		return nil, nil, ErrInsufficientStock
+	}
+	entries, draws := i.drawFEFO(TransactionTransfer, qty, batches)
+	return entries, draws, nil
+}
+
+// ExpiryStatus says where the batch stands on the given day: AlertExpired
+// on or after its expiry date, AlertExpiringSoon within alertDays of it, and
+// "" otherwise or when it has no expiry date.
+func (b *Batch) ExpiryStatus(on time.Time, alertDays int) AlertType {
+	if b.ExpiryDate == nil {
+		return ""
+	}
+	day := on.Truncate(24 * time.Hour)
+	switch {
+	case !day.Before(*b.ExpiryDate):
+		return AlertExpired
+	case !day.AddDate(0, 0, alertDays).Before(*b.ExpiryDate):
+		return AlertExpiringSoon
+	}
+	return ""
+}
+
+// DaysLeft is the number of whole days until the batch expires, negative
+// once it has. It is nil for undated batches.
+func (b *Batch) DaysLeft(on time.Time) *int {
+	if b.ExpiryDate == nil {
+		return nil
+	}
+	days := int(b.ExpiryDate.Sub(on.Truncate(24*time.Hour)).Hours() / 24)
+	return &days
+}
+
+// Value is what the remaining quantity cost, at the lot's own cost when it
+// has one and the item's average cost otherwise.
+func (b *Batch) Value() float64 {
+	cost := 0.0
+	switch {
+	case b.UnitCost != nil:
+		cost = *b.UnitCost
+	case b.Item != nil:
+		cost = b.Item.AverageCost
+	}
+	return money.Round(b.QuantityRemaining * cost)
+}
@@ -0,0 +1,377 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeductionPolicy decides what happens when an order needs more stock than
+// is on hand.
+type DeductionPolicy string
+
+const (
+	// DeductionReject fails the deduction, and with it the order confirmation
+	DeductionReject DeductionPolicy = "reject"
+	// DeductionAllowNegative lets stock go negative and raises an alert
+	DeductionAllowNegative DeductionPolicy = "allow"
+)
+
+// Component is the amount of one inventory item used by a single portion of
+// a menu item. A nil UnitID means the item's own unit.
+type Component struct {
+	InventoryItemID uuid.UUID
+	Quantity        float64
+	UnitID          *uuid.UUID
+	Optional        bool
+}
+
+// Portion is how many portions of a menu item an order contains.
+type Portion struct {
+	MenuItemID uuid.UUID
+	Quantity   int
+}
+
+// Reference identifies what stock was used for, e.g. an order. Movement
+// defaults to TransactionUsage. Reason, when set, replaces the default
+// "Used for <Label>" on the ledger entries, and the label goes to their notes.
+type Reference struct {
+	Type     string
+	ID       uuid.UUID
+	Label    string
+	Movement TransactionType
+	Reason   string
+}
+
+// Shortage is stock that was missing when it was deducted.
+type Shortage struct {
+	Item      *Item
+	Required  float64
+	Available float64
+}
+
+// DeductionResult lists the ledger entries written and, under
+// DeductionAllowNegative, the shortages that were let through.
+type DeductionResult struct {
+	Transactions []*Transaction
+	Shortages    []Shortage
+}
+
+// Deductor takes the ingredients of ordered menu items out of stock.
+type Deductor struct {
+	repo   Repository
+	units  UnitRepository
+	policy DeductionPolicy
+}
+
+func NewDeductor(repo Repository, units UnitRepository, policy DeductionPolicy) *Deductor {
+	if policy != DeductionAllowNegative {
+		policy = DeductionReject
+	}
+	return &Deductor{
+		repo:   repo,
+		units:  units,
+		policy: policy,
+	}
+}
+
+// Deduct must run inside the caller's transaction. It locks every affected
+// item, converts recipe quantities into each item's unit and checks all
+// shortages before writing anything, so a rejected deduction leaves stock
+// untouched; it returns ErrInsufficientStock with the shortages, for the
+// caller to alert on. Stock comes out of the first-expiring batches first,
+// with one ledger entry per batch. Optional ingredients are not deducted.
+func (d *Deductor) Deduct(
+	ctx context.Context,
+	ref Reference,
+	portions []Portion,
+	components map[uuid.UUID][]Component,
+	performedBy *uuid.UUID,
+) (*DeductionResult, error) {
+	// 1. Total portions per menu item, however many lines it appears on
+	perMenuItem := make(map[uuid.UUID]int, len(portions))
+	for _, p := range portions {
+		perMenuItem[p.MenuItemID] += p.Quantity
+	}
+
+	// 2. Lock the items in id order so concurrent deductions can't deadlock
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for menuItemID := range perMenuItem {
+		for _, c := range components[menuItemID] {
+			if !c.Optional && !seen[c.InventoryItemID] {
+				seen[c.InventoryItemID] = true
+				ids = append(ids, c.InventoryItemID)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	items := make(map[uuid.UUID]*Item, len(ids))
+	for _, id := range ids {
+		item, err := d.repo.LockByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			return nil, fmt.Errorf("%w: %s", ErrItemNotFound, id)
+		}
+		items[id] = item
+	}
+
+	// 3. Required quantity per item, in the item's unit
+	required := make(map[uuid.UUID]float64, len(ids))
+	for menuItemID, n := range perMenuItem {
+		for _, c := range components[menuItemID] {
+			if c.Optional {
+				continue
+			}
+			qty, err := d.toItemUnit(ctx, c.Quantity*float64(n), c.UnitID, items[c.InventoryItemID])
+			if err != nil {
+				return nil, err
+			}
+			required[c.InventoryItemID] += qty
+		}
+	}
+
+	// 4. Check every item before touching any
+	result := &DeductionResult{}
+	for _, id := range ids {
+		item, qty := items[id], RoundQty(required[id])
+		if RoundQty(item.CurrentStock-qty) < 0 {
+			result.Shortages = append(result.Shortages, Shortage{
+				Item:      item,
+				Required:  qty,
+				Available: item.CurrentStock,
+			})
+		}
+	}
+	if len(result.Shortages) > 0 && d.policy == DeductionReject {
+		names := make([]string, 0, len(result.Shortages))
+		for _, s := range result.Shortages {
+			names = append(names, s.Item.Name)
+		}
+		return &DeductionResult{Shortages: result.Shortages},
+			fmt.Errorf("%w: %s", ErrInsufficientStock, strings.Join(names, ", "))
+	}
+
+	// 5. Apply and record
+	for _, id := range ids {
+		item, qty := items[id], RoundQty(required[id])
+		if qty <= 0 {
+			continue
+		}
+		batches, err := d.repo.LockBatches(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		movement := ref.Movement
+		if movement == "" {
+			movement = TransactionUsage
+		}
+		entries, draws := item.drawFEFO(movement, qty, batches)
+		for _, draw := range draws {
+			if err := d.repo.UpdateBatch(ctx, draw.Batch); err != nil {
+				return nil, err
+			}
+		}
+		if err := d.repo.Update(ctx, item); err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			entry.ReferenceType = ref.Type
+			entry.ReferenceID = &ref.ID
+			entry.Reason = "Used for " + ref.Label
+			if ref.Reason != "" {
+				entry.Reason = ref.Reason
+				entry.Notes = ref.Label
+			}
+			entry.PerformedBy = performedBy
+			if err := d.repo.CreateTransaction(ctx, entry); err != nil {
+				return nil, err
+			}
+		}
+		result.Transactions = append(result.Transactions, entries...)
+	}
+	if err := d.AlertShortages(ctx, ref, result.Shortages); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AlertShortages raises an out-of-stock alert for every shortage of ref.
+func (d *Deductor) AlertShortages(ctx context.Context, ref Reference, shortages []Shortage) error {
+	for _, s := range shortages {
+		stock, short := s.Item.CurrentStock, RoundQty(s.Required-s.Available)
+		threshold := 0.0
+		err := d.repo.CreateAlert(ctx, &Alert{
+			ID:              uuid.New(),
+			InventoryItemID: s.Item.ID,
+			AlertType:       AlertOutOfStock,
+			CurrentStock:    &stock,
+			ThresholdValue:  &threshold,
+			Notes:           fmt.Sprintf("%s was short by %g", ref.Label, short),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore puts back what deductions for ref took, for an order cancelled
+// after its stock was deducted. It must run inside the caller's
+// transaction. Each item gets return entries for what ref's entries took
+// net of what was already put back, so restoring twice changes nothing.
+// Stock goes back into the batches it came from while they still exist, and
+// is untracked otherwise.
+func (d *Deductor) Restore(ctx context.Context, ref Reference, performedBy *uuid.UUID) ([]*Transaction, error) {
+	entries, err := d.repo.ListByReference(ctx, ref.Type, ref.ID)
+	if err != nil {
+		return nil, err
+	}
+	movement := ref.Movement
+	if movement == "" {
+		movement = TransactionUsage
+	}
+
+	// 1. Net quantity taken per item and lot
+	type lot struct {
+		number string
+		expiry string
+	}
+	taken := make(map[uuid.UUID]map[lot]float64)
+	for _, e := range entries {
+		if e.TransactionType != movement && e.TransactionType != TransactionReturn {
+			continue
+		}
+		key := lot{number: e.BatchNumber}
+		if e.ExpiryDate != nil {
+			key.expiry = e.ExpiryDate.Format(time.DateOnly)
+		}
+		if taken[e.InventoryItemID] == nil {
+			taken[e.InventoryItemID] = make(map[lot]float64)
+		}
+		taken[e.InventoryItemID][key] += e.QuantityBefore - e.QuantityAfter
+	}
+
+	// 2. Lock the items in id order, like Deduct, and put the stock back
+	ids := make([]uuid.UUID, 0, len(taken))
+	for id := range taken {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	var restored []*Transaction
+	for _, id := range ids {
+		// Stock put back before may have gone to another lot than it came
+		// from, so the item's total caps what is still owed
+		owed := 0.0
+		for _, qty := range taken[id] {
+			owed += qty
+		}
+		if owed = RoundQty(owed); owed <= 0 {
+			continue
+		}
+
+		lots := make([]lot, 0, len(taken[id]))
+		var numbers []string
+		for key, qty := range taken[id] {
+			if RoundQty(qty) <= 0 {
+				continue
+			}
+			lots = append(lots, key)
+			if key.number != "" {
+				numbers = append(numbers, key.number)
+			}
+		}
+		sort.Slice(lots, func(i, j int) bool {
+			if lots[i].expiry != lots[j].expiry {
+				return lots[i].expiry < lots[j].expiry
+			}
+			return lots[i].number < lots[j].number
+		})
+
+		item, err := d.repo.LockByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			return nil, fmt.Errorf("%w: %s", ErrItemNotFound, id)
+		}
+		var batches []*Batch
+		if len(numbers) > 0 {
+			if batches, err = d.repo.LockBatchesByNumber(ctx, id, numbers); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, key := range lots {
+			qty := min(RoundQty(taken[id][key]), owed)
+			if qty <= 0 {
+				break
+			}
+			owed = RoundQty(owed - qty)
+			entry := item.costed(item.move(TransactionReturn, qty))
+			for _, b := range batches {
+				expiry := ""
+				if b.ExpiryDate != nil {
+					expiry = b.ExpiryDate.Format(time.DateOnly)
+				}
+				if key.number == "" || b.BatchNumber != key.number || expiry != key.expiry {
+					continue
+				}
+				b.QuantityRemaining = RoundQty(b.QuantityRemaining + qty)
+				if err := d.repo.UpdateBatch(ctx, b); err != nil {
+					return nil, err
+				}
+				entry.BatchNumber = b.BatchNumber
+				entry.ExpiryDate = b.ExpiryDate
+				break
+			}
+			entry.ReferenceType = ref.Type
+			entry.ReferenceID = &ref.ID
+			entry.Reason = "Put back from " + ref.Label
+			if ref.Reason != "" {
+				entry.Reason = ref.Reason
+				entry.Notes = ref.Label
+			}
+			entry.PerformedBy = performedBy
+			restored = append(restored, entry)
+		}
+		if err := d.repo.Update(ctx, item); err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range restored {
+		if err := d.repo.CreateTransaction(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+	return restored, nil
+}
+
+func (d *Deductor) toItemUnit(ctx context.Context, qty float64, unitID *uuid.UUID, item *Item) (float64, error) {
+	if unitID == nil || item.UnitOfMeasureID == nil || *unitID == *item.UnitOfMeasureID {
+		return qty, nil
+	}
+	from, err := d.units.GetByID(ctx, *unitID)
+	if err != nil {
+		return 0, err
+	}
+	if from == nil {
+		return 0, ErrUnitNotFound
+	}
+	if item.Unit == nil {
+		return 0, ErrItemWithoutUnit
+	}
+	converted, err := Convert(qty, from, item.Unit)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s to %s for %s", err, from.Abbreviation, item.Unit.Abbreviation, item.Name)
+	}
+	return converted, nil
+}
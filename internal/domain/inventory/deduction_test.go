@@ -0,0 +1,325 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeRepository keeps items in memory. Only the methods Deduct uses are
+// implemented; the embedded interface panics on anything else.
+type fakeRepository struct {
+	Repository
+	items        map[uuid.UUID]*Item
+	batches      map[uuid.UUID][]*Batch
+	updated      map[uuid.UUID]*Item
+	transactions []*Transaction
+	alerts       []*Alert
+	batchUpdates int
+}
+
+func newFakeRepository(items ...*Item) *fakeRepository {
+	r := &fakeRepository{
+		items:   make(map[uuid.UUID]*Item),
+		batches: make(map[uuid.UUID][]*Batch),
+		updated: make(map[uuid.UUID]*Item),
+	}
+	for _, it := range items {
+		r.items[it.ID] = it
+	}
+	return r
+}
+
+func (r *fakeRepository) LockByID(ctx context.Context, id uuid.UUID) (*Item, error) {
+	it, ok := r.items[id]
+	if !ok {
+		return nil, nil
+	}
+	locked := *it
+	return &locked, nil
+}
+
+func (r *fakeRepository) LockBatches(ctx context.Context, itemID uuid.UUID) ([]*Batch, error) {
+	return r.batches[itemID], nil
+}
+
+func (r *fakeRepository) LockBatchesByNumber(ctx context.Context, itemID uuid.UUID, numbers []string) ([]*Batch, error) {
+	return r.batches[itemID], nil
+}
+
+func (r *fakeRepository) ListByReference(ctx context.Context, refType string, refID uuid.UUID) ([]*Transaction, error) {
+	var out []*Transaction
+	for _, tx := range r.transactions {
+		if tx.ReferenceType == refType && tx.ReferenceID != nil && *tx.ReferenceID == refID {
+			out = append(out, tx)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeRepository) UpdateBatch(ctx context.Context, batch *Batch) error {
+	r.batchUpdates++
+	return nil
+}
+
+func (r *fakeRepository) Update(ctx context.Context, item *Item) error {
+	r.updated[item.ID] = item
+	saved := *item
+	r.items[item.ID] = &saved
+	return nil
+}
+
+func (r *fakeRepository) CreateTransaction(ctx context.Context, tx *Transaction) error {
+	r.transactions = append(r.transactions, tx)
+	return nil
+}
+
+func (r *fakeRepository) CreateAlert(ctx context.Context, alert *Alert) error {
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *fakeRepository) wrote() bool {
+	return len(r.updated) > 0 || len(r.transactions) > 0 || len(r.alerts) > 0 || r.batchUpdates > 0
+}
+
+type fakeUnitRepository struct {
+	UnitRepository
+	units map[uuid.UUID]*Unit
+}
+
+func (r *fakeUnitRepository) GetByID(ctx context.Context, id uuid.UUID) (*Unit, error) {
+	return r.units[id], nil
+}
+
+func TestDeductorDeduct(t *testing.T) {
+	kg := &Unit{ID: uuid.New(), Name: "Kilogram", Abbreviation: "kg", Type: UnitWeight, BaseUnit: "kg", ConversionFactor: 1}
+	g := &Unit{ID: uuid.New(), Name: "Gram", Abbreviation: "g", Type: UnitWeight, BaseUnit: "kg", ConversionFactor: 0.001}
+	litre := &Unit{ID: uuid.New(), Name: "Litre", Abbreviation: "L", Type: UnitVolume, BaseUnit: "L", ConversionFactor: 1}
+	units := &fakeUnitRepository{units: map[uuid.UUID]*Unit{kg.ID: kg, g.ID: g, litre.ID: litre}}
+
+	item := func(name string, stock float64) *Item {
+		return &Item{ID: uuid.New(), Name: name, UnitOfMeasureID: &kg.ID, Unit: kg, CurrentStock: stock}
+	}
+	beef := item("Beef", 5)
+	cheese := item("Cheese", 1)
+	pickles := item("Pickles", 0)
+	burger, fries := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		policy     DeductionPolicy
+		portions   []Portion
+		components map[uuid.UUID][]Component
+		batches    map[uuid.UUID][]*Batch
+		wantErr    error
+		// wantStock is the stock saved per item; items left out must not be saved
+		wantStock     map[uuid.UUID]float64
+		wantEntries   int
+		wantShortages int
+	}{
+		{
+			name:     "same menu item on several lines is deducted once per portion",
+			policy:   DeductionReject,
+			portions: []Portion{{MenuItemID: burger, Quantity: 1}, {MenuItemID: fries, Quantity: 1}, {MenuItemID: burger, Quantity: 2}},
+			components: map[uuid.UUID][]Component{
+				burger: {{InventoryItemID: beef.ID, Quantity: 0.2}},
+			},
+			wantStock:   map[uuid.UUID]float64{beef.ID: 4.4},
+			wantEntries: 1,
+		},
+		{
+			name:     "recipe unit is converted to the item's unit",
+			policy:   DeductionReject,
+			portions: []Portion{{MenuItemID: burger, Quantity: 3}},
+			components: map[uuid.UUID][]Component{
+				burger: {{InventoryItemID: cheese.ID, Quantity: 50, UnitID: &g.ID}},
+			},
+			wantStock:   map[uuid.UUID]float64{cheese.ID: 0.85},
+			wantEntries: 1,
+		},
+		{
+			name:     "incompatible units are rejected",
+			policy:   DeductionAllowNegative,
+			portions: []Portion{{MenuItemID: burger, Quantity: 1}},
+			components: map[uuid.UUID][]Component{
+				burger: {
+					{InventoryItemID: beef.ID, Quantity: 0.2},
+					{InventoryItemID: cheese.ID, Quantity: 0.05, UnitID: &litre.ID},
+				},
+			},
+			wantErr: ErrIncompatibleUnits,
+		},
+		{
+			name:     "optional ingredients are skipped",
+			policy:   DeductionReject,
+			portions: []Portion{{MenuItemID: burger, Quantity: 2}},
+			components: map[uuid.UUID][]Component{
+				burger: {
+					{InventoryItemID: beef.ID, Quantity: 0.2},
+					{InventoryItemID: pickles.ID, Quantity: 0.01, Optional: true},
+				},
+			},
+			wantStock:   map[uuid.UUID]float64{beef.ID: 4.6},
+			wantEntries: 1,
+		},
+		{
+			name:     "first-expiring batches are used first",
+			policy:   DeductionReject,
+			portions: []Portion{{MenuItemID: burger, Quantity: 3}},
+			components: map[uuid.UUID][]Component{
+				burger: {{InventoryItemID: beef.ID, Quantity: 0.2}},
+			},
+			batches: map[uuid.UUID][]*Batch{
+				beef.ID: {{ID: uuid.New(), QuantityRemaining: 0.4}, {ID: uuid.New(), QuantityRemaining: 1}},
+			},
+			wantStock:   map[uuid.UUID]float64{beef.ID: 4.4},
+			wantEntries: 2,
+		},
+		{
+			name:     "reject policy fails on any shortage and writes nothing",
+			policy:   DeductionReject,
+			portions: []Portion{{MenuItemID: burger, Quantity: 2}},
+			components: map[uuid.UUID][]Component{
+				burger: {
+					{InventoryItemID: cheese.ID, Quantity: 0.1},
+					{InventoryItemID: beef.ID, Quantity: 3},
+				},
+			},
+			wantErr: ErrInsufficientStock,
+		},
+		{
+			name:     "allow-negative policy deducts and reports the shortage",
+			policy:   DeductionAllowNegative,
+			portions: []Portion{{MenuItemID: burger, Quantity: 2}},
+			components: map[uuid.UUID][]Component{
+				burger: {
+					{InventoryItemID: cheese.ID, Quantity: 0.1},
+					{InventoryItemID: beef.ID, Quantity: 3},
+				},
+			},
+			wantStock:     map[uuid.UUID]float64{beef.ID: -1, cheese.ID: 0.8},
+			wantEntries:   2,
+			wantShortages: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository(beef, cheese, pickles)
+			for id, batches := range tt.batches {
+				repo.batches[id] = batches
+			}
+			d := NewDeductor(repo, units, tt.policy)
+
+			ref := Reference{Type: "order", ID: uuid.New(), Label: "order 1"}
+			res, err := d.Deduct(context.Background(), ref, tt.portions, tt.components, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrInsufficientStock) && (res == nil || len(res.Shortages) == 0) {
+				t.Errorf("rejected deduction didn't return its shortages")
+			}
+			if err != nil {
+				if repo.wrote() {
+					t.Errorf("failed deduction wrote %d items, %d entries, %d alerts, %d batches",
+						len(repo.updated), len(repo.transactions), len(repo.alerts), repo.batchUpdates)
+				}
+				return
+			}
+
+			if len(repo.updated) != len(tt.wantStock) {
+				t.Errorf("saved %d items, want %d", len(repo.updated), len(tt.wantStock))
+			}
+			for id, want := range tt.wantStock {
+				saved, ok := repo.updated[id]
+				if !ok {
+					t.Errorf("%s not saved", repo.items[id].Name)
+					continue
+				}
+				if saved.CurrentStock != want {
+					t.Errorf("%s stock = %v, want %v", saved.Name, saved.CurrentStock, want)
+				}
+			}
+			if len(repo.transactions) != tt.wantEntries || len(res.Transactions) != tt.wantEntries {
+				t.Errorf("entries = %d written, %d returned, want %d",
+					len(repo.transactions), len(res.Transactions), tt.wantEntries)
+			}
+			for _, entry := range repo.transactions {
+				if entry.ReferenceID == nil || *entry.ReferenceID != ref.ID {
+					t.Errorf("entry not linked to the reference")
+				}
+			}
+			if len(res.Shortages) != tt.wantShortages || len(repo.alerts) != tt.wantShortages {
+				t.Errorf("shortages = %d, alerts = %d, want %d", len(res.Shortages), len(repo.alerts), tt.wantShortages)
+			}
+		})
+	}
+}
+
+func TestNewDeductorDefaultsToReject(t *testing.T) {
+	if d := NewDeductor(nil, nil, "unknown"); d.policy != DeductionReject {
+		t.Errorf("policy = %q, want %q", d.policy, DeductionReject)
+	}
+}
+
+func TestDeductorRestore(t *testing.T) {
+	kg := &Unit{ID: uuid.New(), Name: "Kilogram", Abbreviation: "kg", Type: UnitWeight, BaseUnit: "kg", ConversionFactor: 1}
+	units := &fakeUnitRepository{units: map[uuid.UUID]*Unit{kg.ID: kg}}
+	expiry := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	burger := uuid.New()
+
+	beef := &Item{ID: uuid.New(), Name: "Beef", UnitOfMeasureID: &kg.ID, Unit: kg, CurrentStock: 5}
+	bun := &Item{ID: uuid.New(), Name: "Bun", UnitOfMeasureID: &kg.ID, Unit: kg, CurrentStock: 0.1}
+	lot := &Batch{ID: uuid.New(), InventoryItemID: beef.ID, BatchNumber: "L1", ExpiryDate: &expiry, QuantityRemaining: 0.4}
+	repo := newFakeRepository(beef, bun)
+	repo.batches[beef.ID] = []*Batch{lot}
+
+	d := NewDeductor(repo, units, DeductionAllowNegative)
+	ref := Reference{Type: "order", ID: uuid.New(), Label: "order 1"}
+	components := map[uuid.UUID][]Component{
+		burger: {{InventoryItemID: beef.ID, Quantity: 0.3}, {InventoryItemID: bun.ID, Quantity: 0.08}},
+	}
+	if _, err := d.Deduct(context.Background(), ref, []Portion{{MenuItemID: burger, Quantity: 2}}, components, nil); err != nil {
+		t.Fatalf("Deduct: %v", err)
+	}
+	// Another order's entries must not be put back
+	other := Reference{Type: "order", ID: uuid.New(), Label: "order 2"}
+	if _, err := d.Deduct(context.Background(), other, []Portion{{MenuItemID: burger, Quantity: 1}}, components, nil); err != nil {
+		t.Fatalf("Deduct: %v", err)
+	}
+	if beef := repo.items[beef.ID]; beef.CurrentStock != 4.1 || lot.QuantityRemaining != 0 {
+		t.Fatalf("after deducting beef = %v, lot = %v", beef.CurrentStock, lot.QuantityRemaining)
+	}
+
+	restored, err := d.Restore(context.Background(), ref, nil)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	// Beef came from the lot and from untracked stock, the bun from
+	// untracked stock that went negative
+	if len(restored) != 3 {
+		t.Errorf("restored %d entries, want 3", len(restored))
+	}
+	for _, e := range restored {
+		if e.TransactionType != TransactionReturn || e.QuantityAfter <= e.QuantityBefore {
+			t.Errorf("entry %+v doesn't put stock back", e)
+		}
+	}
+	if got := repo.items[beef.ID].CurrentStock; got != 4.7 {
+		t.Errorf("beef stock = %v, want 4.7", got)
+	}
+	if got := repo.items[bun.ID].CurrentStock; got != 0.02 {
+		t.Errorf("bun stock = %v, want 0.02", got)
+	}
+	if lot.QuantityRemaining != 0.4 {
+		t.Errorf("lot L1 holds %v, want 0.4", lot.QuantityRemaining)
+	}
+
+	again, err := d.Restore(context.Background(), ref, nil)
+	if err != nil || len(again) != 0 {
+		t.Errorf("second Restore = %d entries, %v", len(again), err)
+	}
+}
@@ -0,0 +1,201 @@
+// Package inventory defines inventory items, units of measure and the stock
+// ledger. Stock is always held in the item's own unit.
+package inventory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+type Item struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID    uuid.UUID  `gorm:"type:uuid;not null"`
+	CategoryID      *uuid.UUID `gorm:"type:uuid"`
+	SupplierID      *uuid.UUID `gorm:"type:uuid"`
+	SKU             *string    `gorm:"column:sku;size:100;unique"`
+	Name            string     `gorm:"size:255;not null"`
+	Description     string     `gorm:"type:text"`
+	UnitOfMeasureID *uuid.UUID `gorm:"type:uuid"`
+
+	CurrentStock    float64  `gorm:"type:decimal(12,3);default:0.00"`
+	MinimumStock    float64  `gorm:"type:decimal(12,3);default:0.00"`
+	MaximumStock    *float64 `gorm:"type:decimal(12,3)"`
+	ReorderPoint    *float64 `gorm:"type:decimal(12,3)"`
+	ReorderQuantity *float64 `gorm:"type:decimal(12,3)"`
+
+	UnitCost         float64    `gorm:"type:decimal(10,2);default:0.00"`
+	AverageCost      float64    `gorm:"type:decimal(10,2);default:0.00"`
+	LastPurchaseCost *float64   `gorm:"type:decimal(10,2)"`
+	LastPurchaseDate *time.Time `gorm:"type:date"`
+
+	StorageLocation string `gorm:"size:255"`
+	ShelfLifeDays   *int
+	ExpiryAlertDays int `gorm:"default:7"`
+
+	IsActive              bool `gorm:"default:true"`
+	IsPerishable          bool `gorm:"default:false"`
+	RequiresRefrigeration bool `gorm:"default:false"`
+
+	Barcode   string    `gorm:"size:100"`
+	ImageURL  string    `gorm:"type:text"`
+	Notes     string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	Unit *Unit `gorm:"foreignKey:UnitOfMeasureID"`
+}
+
+func (Item) TableName() string {
+	return "inventory_items"
+}
+
+// NewItem is a Factory Function that ensures an Item
+// is always created with a valid ID and default business state.
+func NewItem(restaurantID uuid.UUID, name string, unit *Unit) *Item {
+	return &Item{
+		ID:              uuid.New(),
+		RestaurantID:    restaurantID,
+		Name:            name,
+		UnitOfMeasureID: &unit.ID,
+		Unit:            unit,
+		ExpiryAlertDays: 7,
+		IsActive:        true,
+	}
+}
+
+// Stock operations below change CurrentStock and return the ledger entry to
+// record. Quantities are in the item's unit; callers convert first.
+
+// Receive adds purchased stock and folds its cost into the weighted average.
+func (i *Item) Receive(qty, unitCost float64, at time.Time) (*Transaction, error) {
+	if qty <= 0 {
+		return nil, ErrNonPositiveQuantity
+	}
+	if unitCost < 0 {
+		return nil, ErrNegativeCost
+	}
+
+	i.foldCost(qty, unitCost)
+	cost := money.Round(unitCost)
+	i.UnitCost = cost
+	i.LastPurchaseCost = &cost
+	day := at.Truncate(24 * time.Hour)
+	i.LastPurchaseDate = &day
+
+	tx := i.move(TransactionPurchase, qty)
+	tx.UnitCost = &cost
+	total := money.Round(qty * unitCost)
+	tx.TotalCost = &total
+	return tx, nil
+}
+
+// TransferIn adds stock moved in from another restaurant at the cost it had
+// there. Like Receive it folds that cost into the weighted average, but it
+// is not a purchase.
+func (i *Item) TransferIn(qty, unitCost float64) (*Transaction, error) {
+	if qty <= 0 {
+		return nil, ErrNonPositiveQuantity
+	}
+	if unitCost < 0 {
+		return nil, ErrNegativeCost
+	}
+	i.foldCost(qty, unitCost)
+	tx := i.move(TransactionTransfer, qty)
+	cost := money.Round(unitCost)
+	tx.UnitCost = &cost
+	total := money.Round(qty * unitCost)
+	tx.TotalCost = &total
+	return tx, nil
+}
+
+// foldCost blends qty at unitCost into the average cost of the stock on hand
+func (i *Item) foldCost(qty, unitCost float64) {
+	if total := i.CurrentStock + qty; i.CurrentStock > 0 && total > 0 {
+		i.AverageCost = money.Round((i.CurrentStock*i.AverageCost + qty*unitCost) / total)
+	} else {
+		i.AverageCost = money.Round(unitCost)
+	}
+}
+
+// Consume takes stock out for use. It never drives stock negative.
+func (i *Item) Consume(qty float64) (*Transaction, error) {
+	if qty <= 0 {
+		return nil, ErrNonPositiveQuantity
+	}
+	if RoundQty(i.CurrentStock-qty) < 0 {
+		return nil, ErrInsufficientStock
+	}
+	return i.Draw(qty), nil
+}
+
+// Draw takes qty out for use even if that drives stock negative. Callers
+// decide beforehand whether a shortage is acceptable.
+func (i *Item) Draw(qty float64) *Transaction {
+	return i.draw(TransactionUsage, qty)
+}
+
+// draw takes qty out as a movement of type t, valued at the average cost
+func (i *Item) draw(t TransactionType, qty float64) *Transaction {
+	return i.costed(i.move(t, -qty))
+}
+
+// Adjust corrects stock by delta, which may be negative, and returns both the
+// adjustment record and its ledger entry.
+func (i *Item) Adjust(delta float64, reason AdjustmentReason) (*Adjustment, *Transaction, error) {
+	if delta == 0 {
+		return nil, nil, ErrNonPositiveQuantity
+	}
+	if !reason.Valid() {
+		return nil, nil, ErrInvalidAdjustmentReason
+	}
+	if RoundQty(i.CurrentStock+delta) < 0 {
+		return nil, nil, ErrInsufficientStock
+	}
+
+	before := i.CurrentStock
+	tx := i.costed(i.move(TransactionAdjustment, delta))
+	cost := i.AverageCost
+	impact := money.Round(delta * cost)
+	adj := &Adjustment{
+		ID:               uuid.New(),
+		InventoryItemID:  i.ID,
+		AdjustmentReason: reason,
+		QuantityBefore:   before,
+		QuantityAdjusted: RoundQty(delta),
+		QuantityAfter:    i.CurrentStock,
+		CostPerUnit:      &cost,
+		TotalValueImpact: &impact,
+	}
+	return adj, tx, nil
+}
+
+// move applies a signed change and returns its ledger entry. The recorded
+// quantity is always positive; the type says which way stock moved.
+func (i *Item) move(t TransactionType, delta float64) *Transaction {
+	before := i.CurrentStock
+	i.CurrentStock = RoundQty(before + delta)
+	qty := delta
+	if qty < 0 {
+		qty = -qty
+	}
+	return &Transaction{
+		ID:              uuid.New(),
+		InventoryItemID: i.ID,
+		TransactionType: t,
+		Quantity:        RoundQty(qty),
+		UnitOfMeasureID: i.UnitOfMeasureID,
+		QuantityBefore:  before,
+		QuantityAfter:   i.CurrentStock,
+	}
+}
+
+// costed values an outgoing or corrective movement at the average cost
+func (i *Item) costed(tx *Transaction) *Transaction {
+	cost := i.AverageCost
+	total := money.Round(tx.Quantity * cost)
+	tx.UnitCost = &cost
+	tx.TotalCost = &total
+	return tx
+}
@@ -0,0 +1,32 @@
+package inventory
+
+import "errors"
+
+var (
+	ErrItemNotFound              = errors.New("inventory item not found")
+	ErrUnitNotFound              = errors.New("unit of measure not found")
+	ErrIncompatibleUnits         = errors.New("units measure different kinds of quantity and cannot be converted")
+	ErrItemWithoutUnit           = errors.New("inventory item has no unit of measure")
+	ErrNonPositiveQuantity       = errors.New("quantity must be greater than zero")
+	ErrNegativeCost              = errors.New("unit cost cannot be negative")
+	ErrInsufficientStock         = errors.New("not enough stock")
+	ErrInvalidAdjustmentReason   = errors.New("invalid adjustment reason")
+	ErrItemInactive              = errors.New("inventory item is archived")
+	ErrDuplicateSKU              = errors.New("the restaurant already has an inventory item with this SKU")
+	ErrDuplicateBarcode          = errors.New("the restaurant already has an inventory item with this barcode")
+	ErrAlertNotFound             = errors.New("stock alert not found")
+	ErrAlertResolved             = errors.New("stock alert is already resolved")
+	ErrSubscriptionNotFound      = errors.New("alert subscription not found")
+	ErrDuplicateSubscription     = errors.New("this address is already subscribed to the restaurant's alerts")
+	ErrInvalidSubscriptionTarget = errors.New("subscription target must be an email address for email or an http(s) URL for webhooks")
+	ErrInvalidWasteReason        = errors.New("waste reason must be damaged, expired, spoiled or other")
+	ErrInvalidWasteTarget        = errors.New("waste must name either an inventory item or a menu item")
+	ErrInvalidValuationMethod    = errors.New("valuation method must be fifo or weighted_average")
+	ErrInvalidPeriod             = errors.New("period must end after it starts")
+)
+
+// Machine-readable codes returned alongside the error message so clients can
+// react without parsing text.
+const (
+	CodeInsufficientStock = "INSUFFICIENT_STOCK"
+)
@@ -0,0 +1,71 @@
+package inventory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WasteSuggestion proposes writing off what is left of an expired batch.
+type WasteSuggestion struct {
+	Batch    *Batch
+	Quantity float64
+	Value    float64
+	Reason   AdjustmentReason
+}
+
+// SuggestWaste returns nil unless the batch has expired with stock left.
+// The batch's Item must be loaded.
+func (b *Batch) SuggestWaste(on time.Time) *WasteSuggestion {
+	if b.QuantityRemaining <= 0 || b.ExpiryStatus(on, b.Item.ExpiryAlertDays) != AlertExpired {
+		return nil
+	}
+	return &WasteSuggestion{
+		Batch:    b,
+		Quantity: b.QuantityRemaining,
+		Value:    b.Value(),
+		Reason:   ReasonExpired,
+	}
+}
+
+// RaiseExpiryAlert returns the alert to raise for the batch on the given
+// day, or nil when its status hasn't moved on since the last alert. It
+// records the new status on the batch, which the caller saves. The batch's
+// Item must be loaded.
+func (b *Batch) RaiseExpiryAlert(on time.Time) *Alert {
+	status := b.ExpiryStatus(on, b.Item.ExpiryAlertDays)
+	if status == "" || b.QuantityRemaining <= 0 || (b.ExpiryAlert != nil && *b.ExpiryAlert == status) {
+		return nil
+	}
+	b.ExpiryAlert = &status
+
+	qty := fmt.Sprintf("%g", b.QuantityRemaining)
+	if b.Item.Unit != nil {
+		qty += " " + b.Item.Unit.Abbreviation
+	}
+	expiry := b.ExpiryDate.Format(time.DateOnly)
+	notes := fmt.Sprintf("%s of %s (%s) expires on %s", b.label(), b.Item.Name, qty, expiry)
+	if status == AlertExpired {
+		notes = fmt.Sprintf("%s of %s expired on %s; suggest logging %s (%.2f) as waste",
+			b.label(), b.Item.Name, expiry, qty, b.Value())
+	}
+
+	stock := b.QuantityRemaining
+	return &Alert{
+		ID:              uuid.New(),
+		InventoryItemID: b.InventoryItemID,
+		AlertType:       status,
+		CurrentStock:    &stock,
+		ExpiryDate:      b.ExpiryDate,
+		Notes:           notes,
+	}
+}
+
+// label names the batch in alert text
+func (b *Batch) label() string {
+	if b.BatchNumber != "" {
+		return "Batch " + b.BatchNumber
+	}
+	return "Batch received " + b.ReceivedAt.Format(time.DateOnly)
+}
@@ -0,0 +1,111 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ItemFilter narrows ListByRestaurant. Zero values match everything.
+type ItemFilter struct {
+	Search          string
+	StorageLocation string
+	CategoryID      *uuid.UUID
+	IncludeInactive bool
+}
+
+type Repository interface {
+	Create(ctx context.Context, item *Item) error
+	// GetByID loads the item with its unit.
+	GetByID(ctx context.Context, id uuid.UUID) (*Item, error)
+	// LockByID loads the item with its unit and locks the row until the
+	// surrounding transaction ends. Stock changes must go through it.
+	LockByID(ctx context.Context, id uuid.UUID) (*Item, error)
+	// GetBySKU finds the restaurant's item with the SKU. SKUs are unique per
+	// restaurant, so the same SKU names the same product at every venue.
+	GetBySKU(ctx context.Context, restaurantID uuid.UUID, sku string) (*Item, error)
+	// GetByBarcode finds the restaurant's item whose barcode is one of codes,
+	// the forms one scan may have been stored in, or failing that whose SKU
+	// is, for shelves labelled with in-house codes.
+	GetByBarcode(ctx context.Context, restaurantID uuid.UUID, codes []string) (*Item, error)
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, filter ItemFilter) ([]*Item, error)
+	// ListBelowReorderPoint returns active items whose stock is at or below
+	// their reorder point, or their minimum stock when no point is set.
+	ListBelowReorderPoint(ctx context.Context, restaurantID uuid.UUID) ([]*Item, error)
+	Update(ctx context.Context, item *Item) error
+
+	CreateTransaction(ctx context.Context, tx *Transaction) error
+	CreateAdjustment(ctx context.Context, adj *Adjustment) error
+	ListTransactions(ctx context.Context, itemID uuid.UUID, limit int) ([]*Transaction, error)
+	// ListByReference returns the ledger entries recorded for what the
+	// reference names, e.g. an order, oldest first.
+	ListByReference(ctx context.Context, refType string, refID uuid.UUID) ([]*Transaction, error)
+	// ListLedger returns every ledger entry of the restaurant's items made
+	// before the time, oldest first.
+	ListLedger(ctx context.Context, restaurantID uuid.UUID, before time.Time) ([]*Transaction, error)
+	// UsageTotals sums usage per item over [from, to) from daily_inventory_usage.
+	UsageTotals(ctx context.Context, itemIDs []uuid.UUID, from, to time.Time) (map[uuid.UUID]float64, error)
+	// CreateAlert does nothing if an open alert for the same item, type and
+	// expiry date already exists.
+	CreateAlert(ctx context.Context, alert *Alert) error
+
+	CreateBatch(ctx context.Context, batch *Batch) error
+	// LockBatches returns the item's batches with stock left, first-expiring
+	// first, locked until the surrounding transaction ends.
+	LockBatches(ctx context.Context, itemID uuid.UUID) ([]*Batch, error)
+	// LockBatchesByNumber returns the item's batches with one of the batch
+	// numbers, used up or not, locked until the surrounding transaction ends.
+	LockBatchesByNumber(ctx context.Context, itemID uuid.UUID, numbers []string) ([]*Batch, error)
+	UpdateBatch(ctx context.Context, batch *Batch) error
+	// ListExpiringBatches returns batches of the restaurant's active items
+	// with stock left that expire within the item's expiry alert days of on,
+	// or already have, earliest first, with item and unit loaded.
+	ListExpiringBatches(ctx context.Context, restaurantID uuid.UUID, on time.Time) ([]*Batch, error)
+	// LockExpiringBatches is ListExpiringBatches, locking the batches and
+	// skipping any another transaction holds.
+	LockExpiringBatches(ctx context.Context, restaurantID uuid.UUID, on time.Time) ([]*Batch, error)
+}
+
+type AlertRepository interface {
+	// GetByID loads the alert with its item and the item's unit.
+	GetByID(ctx context.Context, id uuid.UUID) (*Alert, error)
+	LockByID(ctx context.Context, id uuid.UUID) (*Alert, error)
+	// List returns the restaurant's alerts, newest first.
+	List(ctx context.Context, restaurantID uuid.UUID, filter AlertFilter) ([]*Alert, error)
+	Update(ctx context.Context, alert *Alert) error
+	// LockUnnotified returns up to limit alerts nobody has been sent yet,
+	// oldest first, with item and unit loaded. Alerts claimed past now,
+	// claimed maxAttempts times already or held by another transaction are
+	// skipped.
+	LockUnnotified(ctx context.Context, now time.Time, maxAttempts, limit int) ([]*Alert, error)
+	// MarkNotified records that the alert was sent and drops its claim.
+	MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error
+	// ReleaseClaim drops the alert's claim so the next run sends it.
+	ReleaseClaim(ctx context.Context, id uuid.UUID) error
+
+	CreateSubscription(ctx context.Context, sub *AlertSubscription) error
+	GetSubscription(ctx context.Context, id uuid.UUID) (*AlertSubscription, error)
+	// ListSubscriptions returns a user's subscriptions to a restaurant's alerts.
+	ListSubscriptions(ctx context.Context, restaurantID, userID uuid.UUID) ([]*AlertSubscription, error)
+	// ListActiveSubscriptions returns everyone a restaurant's alerts go to.
+	ListActiveSubscriptions(ctx context.Context, restaurantID uuid.UUID) ([]*AlertSubscription, error)
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+}
+
+type WasteRepository interface {
+	Create(ctx context.Context, entry *WasteEntry) error
+	// List returns the restaurant's waste entries logged in [from, to),
+	// newest first, with item and unit loaded.
+	List(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) ([]*WasteEntry, error)
+	// Lines sums the waste ledger entries of the restaurant's items in
+	// [from, to) per day, item and reason.
+	Lines(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) ([]WasteLine, error)
+}
+
+type UnitRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*Unit, error)
+	// FindByCode matches an abbreviation ("g") or, case-insensitively, a name.
+	FindByCode(ctx context.Context, code string) (*Unit, error)
+	List(ctx context.Context) ([]*Unit, error)
+}
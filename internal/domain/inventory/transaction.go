@@ -0,0 +1,96 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionType mirrors transaction_type_enum.
+type TransactionType string
+
+const (
+	TransactionPurchase   TransactionType = "purchase"
+	TransactionUsage      TransactionType = "usage"
+	TransactionWaste      TransactionType = "waste"
+	TransactionAdjustment TransactionType = "adjustment"
+	TransactionTransfer   TransactionType = "transfer"
+	TransactionReturn     TransactionType = "return"
+)
+
+// AdjustmentReason mirrors adjustment_reason_enum.
+type AdjustmentReason string
+
+const (
+	ReasonDamaged            AdjustmentReason = "damaged"
+	ReasonExpired            AdjustmentReason = "expired"
+	ReasonTheft              AdjustmentReason = "theft"
+	ReasonMiscounted         AdjustmentReason = "miscounted"
+	ReasonSpoiled            AdjustmentReason = "spoiled"
+	ReasonReturnedToSupplier AdjustmentReason = "returned_to_supplier"
+	ReasonOther              AdjustmentReason = "other"
+)
+
+func (r AdjustmentReason) Valid() bool {
+	switch r {
+	case ReasonDamaged, ReasonExpired, ReasonTheft, ReasonMiscounted,
+		ReasonSpoiled, ReasonReturnedToSupplier, ReasonOther:
+		return true
+	}
+	return false
+}
+
+// IsWaste reports whether stock lost for the reason is logged as waste
+// rather than corrected through an adjustment.
+func (r AdjustmentReason) IsWaste() bool {
+	switch r {
+	case ReasonDamaged, ReasonExpired, ReasonSpoiled, ReasonOther:
+		return true
+	}
+	return false
+}
+
+// Transaction is one entry of the stock ledger (inventory_transactions).
+type Transaction struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	InventoryItemID uuid.UUID       `gorm:"type:uuid;not null"`
+	TransactionType TransactionType `gorm:"type:transaction_type_enum;not null"`
+	Quantity        float64         `gorm:"type:decimal(12,3);not null"`
+	UnitOfMeasureID *uuid.UUID      `gorm:"type:uuid"`
+	QuantityBefore  float64         `gorm:"type:decimal(12,3);not null"`
+	QuantityAfter   float64         `gorm:"type:decimal(12,3);not null"`
+	UnitCost        *float64        `gorm:"type:decimal(10,2)"`
+	TotalCost       *float64        `gorm:"type:decimal(10,2)"`
+	ReferenceType   string          `gorm:"size:50"`
+	ReferenceID     *uuid.UUID      `gorm:"type:uuid"`
+	Reason          string          `gorm:"type:text"`
+	BatchNumber     string          `gorm:"size:100"`
+	ExpiryDate      *time.Time      `gorm:"type:date"`
+	PerformedBy     *uuid.UUID      `gorm:"type:uuid"`
+	Notes           string          `gorm:"type:text"`
+	CreatedAt       time.Time       `gorm:"autoCreateTime"`
+}
+
+func (Transaction) TableName() string {
+	return "inventory_transactions"
+}
+
+// Adjustment is a manual stock correction (inventory_adjustments).
+type Adjustment struct {
+	ID               uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	InventoryItemID  uuid.UUID        `gorm:"type:uuid;not null"`
+	AdjustmentReason AdjustmentReason `gorm:"type:adjustment_reason_enum;not null"`
+	QuantityBefore   float64          `gorm:"type:decimal(12,3);not null"`
+	QuantityAdjusted float64          `gorm:"type:decimal(12,3);not null"`
+	QuantityAfter    float64          `gorm:"type:decimal(12,3);not null"`
+	CostPerUnit      *float64         `gorm:"type:decimal(10,2)"`
+	TotalValueImpact *float64         `gorm:"type:decimal(10,2)"`
+	ReasonDetails    string           `gorm:"type:text"`
+	AdjustedBy       *uuid.UUID       `gorm:"type:uuid"`
+	ApprovedBy       *uuid.UUID       `gorm:"type:uuid"`
+	CreatedAt        time.Time        `gorm:"autoCreateTime"`
+}
+
+func (Adjustment) TableName() string {
+	return "inventory_adjustments"
+}
@@ -0,0 +1,57 @@
+package inventory
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UnitType groups units that measure the same kind of quantity.
+type UnitType string
+
+const (
+	UnitWeight UnitType = "weight"
+	UnitVolume UnitType = "volume"
+	UnitCount  UnitType = "count"
+)
+
+// Unit mirrors units_of_measure. ConversionFactor is how many BaseUnit one
+// of this unit is worth, e.g. Gram has base unit "kg" and factor 0.001.
+type Unit struct {
+	ID               uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name             string    `gorm:"size:50;unique;not null"`
+	Abbreviation     string    `gorm:"size:10;not null"`
+	Type             UnitType  `gorm:"size:20;not null"`
+	BaseUnit         string    `gorm:"size:50"`
+	ConversionFactor float64   `gorm:"type:decimal(18,9);default:1.0"`
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+}
+
+func (Unit) TableName() string {
+	return "units_of_measure"
+}
+
+// CompatibleWith reports whether quantities can be converted between u and
+// other. Both must be the same type and share a base unit, so weight never
+// converts to volume and boxes never convert to pieces.
+func (u *Unit) CompatibleWith(other *Unit) bool {
+	return u.Type == other.Type && u.BaseUnit == other.BaseUnit
+}
+
+// Convert expresses qty of unit from in unit to, going through the shared
+// base unit. The result is rounded to the 3 decimals stock is stored with.
+func Convert(qty float64, from, to *Unit) (float64, error) {
+	if from.ID == to.ID {
+		return qty, nil
+	}
+	if !from.CompatibleWith(to) || to.ConversionFactor == 0 {
+		return 0, ErrIncompatibleUnits
+	}
+	return RoundQty(qty * from.ConversionFactor / to.ConversionFactor), nil
+}
+
+// RoundQty rounds a stock quantity to the precision of DECIMAL(12,3).
+func RoundQty(qty float64) float64 {
+	return math.Round(qty*1000) / 1000
+}
@@ -0,0 +1,349 @@
+package inventory
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// ValuationMethod mirrors valuation_method_enum: how a restaurant costs the
+// stock it holds and uses.
+type ValuationMethod string
+
+const (
+	// ValuationFIFO costs stock from layers opened by each receipt, using
+	// the oldest first.
+	ValuationFIFO ValuationMethod = "fifo"
+	// ValuationWeightedAverage costs stock at a moving average folded on
+	// every receipt, the way Item.AverageCost is kept.
+	ValuationWeightedAverage ValuationMethod = "weighted_average"
+)
+
+func (m ValuationMethod) Valid() bool {
+	return m == ValuationFIFO || m == ValuationWeightedAverage
+}
+
+// Movement is a quantity, in the item's unit, and what it cost under the
+// valuation method. Booked is what the ledger entries recorded as their
+// total cost, for reconciliation.
+type Movement struct {
+	Quantity float64
+	Value    float64
+	Booked   float64
+}
+
+func (m *Movement) add(qty, value, booked float64) {
+	m.Quantity = RoundQty(m.Quantity + qty)
+	m.Value = money.Sum(m.Value, value)
+	m.Booked = money.Sum(m.Booked, booked)
+}
+
+// ItemValuation rolls one item's stock forward over a period:
+//
+//	Closing = Opening + Purchases + TransfersIn - Usage - Waste
+//	          - TransfersOut - Returns + Adjustments + Unledgered + Revaluation
+//
+// Outgoing movements are positive; Adjustments and Unledgered are signed.
+// Unledgered is stock that changed without a ledger entry, found where an
+// entry's quantity before doesn't follow from the one preceding it.
+// Revaluation only has a value: what negative stock cost once it was made
+// up at a different price, plus rounding to cents.
+type ItemValuation struct {
+	Item         *Item
+	Opening      Movement
+	Purchases    Movement
+	TransfersIn  Movement
+	Usage        Movement
+	Waste        Movement
+	TransfersOut Movement
+	Returns      Movement
+	Adjustments  Movement
+	Unledgered   Movement
+	Revaluation  float64
+	Closing      Movement
+	// UnitCost is what a unit of closing stock is worth
+	UnitCost float64
+}
+
+// CostOfGoodsUsed is the value of stock used, wasted or otherwise lost in
+// the period: opening plus purchases less closing, leaving transfers and
+// returns out.
+func (v *ItemValuation) CostOfGoodsUsed() float64 {
+	return money.Sum(v.Usage.Value, v.Waste.Value, -v.Adjustments.Value, -v.Unledgered.Value, -v.Revaluation)
+}
+
+// Valuation is a period-close report over [From, To) for a restaurant.
+// Totals sums the items' values; quantities of different items don't add
+// up, so it leaves them zero.
+type Valuation struct {
+	RestaurantID uuid.UUID
+	Method       ValuationMethod
+	From         time.Time
+	To           time.Time
+	Items        []*ItemValuation
+	Totals       ItemValuation
+}
+
+// Value replays the restaurant's stock ledger with the method. entries must
+// hold every entry of the items made before to, in any order; entries of
+// other items are ignored. Items without stock or movements are left out.
+func Value(
+	restaurantID uuid.UUID, method ValuationMethod, from, to time.Time, items []*Item, entries []*Transaction,
+) *Valuation {
+	byItem := make(map[uuid.UUID][]*Transaction, len(items))
+	for _, e := range entries {
+		byItem[e.InventoryItemID] = append(byItem[e.InventoryItemID], e)
+	}
+
+	report := &Valuation{RestaurantID: restaurantID, Method: method, From: from, To: to}
+	for _, item := range items {
+		v := valueItem(item, method, from, byItem[item.ID])
+		if v.Opening == (Movement{}) && v.Closing == (Movement{}) && !v.moved() {
+			continue
+		}
+		report.Items = append(report.Items, v)
+		report.Totals.sum(v)
+	}
+	sort.Slice(report.Items, func(a, b int) bool {
+		return report.Items[a].Item.Name < report.Items[b].Item.Name
+	})
+	return report
+}
+
+func valueItem(item *Item, method ValuationMethod, from time.Time, entries []*Transaction) *ItemValuation {
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].CreatedAt.Before(entries[b].CreatedAt)
+	})
+
+	var flow costFlow = &averageCost{}
+	if method == ValuationFIFO {
+		flow = &fifoCost{}
+	}
+	v := &ItemValuation{Item: item}
+	var (
+		stock  float64
+		opened bool
+	)
+	open := func() {
+		v.Opening = Movement{Quantity: stock, Value: money.Round(flow.value())}
+		opened = true
+	}
+	for _, e := range entries {
+		if !opened && !e.CreatedAt.Before(from) {
+			open()
+		}
+		inPeriod := opened
+
+		// Stock that moved outside the ledger before this entry
+		if gap := RoundQty(e.QuantityBefore - stock); gap != 0 {
+			cost := costOf(flow, item)
+			before := money.Round(flow.value())
+			value := apply(flow, gap, cost)
+			if inPeriod {
+				v.Unledgered.add(gap, value, 0)
+				v.Revaluation = money.Sum(v.Revaluation, money.Round(flow.value())-before-value)
+			}
+			stock = e.QuantityBefore
+		}
+
+		delta := RoundQty(e.QuantityAfter - e.QuantityBefore)
+		if delta == 0 {
+			continue
+		}
+		cost := costOf(flow, item)
+		if delta > 0 && e.UnitCost != nil &&
+			(e.TransactionType == TransactionPurchase || e.TransactionType == TransactionTransfer) {
+			cost = *e.UnitCost
+		}
+		before := money.Round(flow.value())
+		value := apply(flow, delta, cost)
+		stock = e.QuantityAfter
+		if !inPeriod {
+			continue
+		}
+		v.Revaluation = money.Sum(v.Revaluation, money.Round(flow.value())-before-value)
+
+		var booked float64
+		if e.TotalCost != nil {
+			booked = *e.TotalCost
+		}
+		switch {
+		case e.TransactionType == TransactionAdjustment:
+			if delta < 0 {
+				booked = -booked
+			}
+			v.Adjustments.add(delta, value, booked)
+		case e.TransactionType == TransactionPurchase:
+			v.Purchases.add(delta, value, booked)
+		case e.TransactionType == TransactionTransfer && delta > 0:
+			v.TransfersIn.add(delta, value, booked)
+		case e.TransactionType == TransactionTransfer:
+			v.TransfersOut.add(-delta, -value, booked)
+		case e.TransactionType == TransactionWaste:
+			v.Waste.add(-delta, -value, signed(booked, -delta))
+		case e.TransactionType == TransactionReturn && delta < 0:
+			v.Returns.add(-delta, -value, signed(booked, -delta))
+		default:
+			// Stock put back from a cancelled order undoes usage
+			v.Usage.add(-delta, -value, signed(booked, -delta))
+		}
+	}
+	if !opened {
+		open()
+	}
+	v.Closing = Movement{Quantity: stock, Value: money.Round(flow.value())}
+	if stock != 0 {
+		v.UnitCost = money.Round(v.Closing.Value / stock)
+	}
+	return v
+}
+
+// costOf is the flow's cost, or the item's average before anything with a
+// cost came in
+func costOf(flow costFlow, item *Item) float64 {
+	if cost := flow.cost(); cost != 0 {
+		return cost
+	}
+	return item.AverageCost
+}
+
+// apply moves qty (signed) through the flow, valuing stock coming in at
+// cost, and returns the signed value moved
+func apply(flow costFlow, qty, cost float64) float64 {
+	if qty > 0 {
+		flow.in(qty, cost)
+		return money.Round(qty * cost)
+	}
+	return -money.Round(flow.out(-qty))
+}
+
+// signed gives a booked cost the sign of the quantity it goes with
+func signed(booked, qty float64) float64 {
+	if qty < 0 {
+		return -booked
+	}
+	return booked
+}
+
+func (v *ItemValuation) moved() bool {
+	for _, m := range []Movement{
+		v.Purchases, v.TransfersIn, v.Usage, v.Waste, v.TransfersOut, v.Returns, v.Adjustments, v.Unledgered,
+	} {
+		if m != (Movement{}) {
+			return true
+		}
+	}
+	return v.Revaluation != 0
+}
+
+func (v *ItemValuation) sum(o *ItemValuation) {
+	for _, p := range []struct{ dst, src *Movement }{
+		{&v.Opening, &o.Opening}, {&v.Purchases, &o.Purchases}, {&v.TransfersIn, &o.TransfersIn},
+		{&v.Usage, &o.Usage}, {&v.Waste, &o.Waste}, {&v.TransfersOut, &o.TransfersOut},
+		{&v.Returns, &o.Returns}, {&v.Adjustments, &o.Adjustments}, {&v.Unledgered, &o.Unledgered},
+		{&v.Closing, &o.Closing},
+	} {
+		// Quantities of different items don't add up
+		p.dst.Value = money.Sum(p.dst.Value, p.src.Value)
+		p.dst.Booked = money.Sum(p.dst.Booked, p.src.Booked)
+	}
+	v.Revaluation = money.Sum(v.Revaluation, o.Revaluation)
+}
+
+// costFlow tracks what the stock on hand cost as it moves
+type costFlow interface {
+	in(qty, unitCost float64)
+	// out takes qty out and returns what it cost
+	out(qty float64) float64
+	value() float64
+	// cost values stock that comes in without a cost of its own
+	cost() float64
+}
+
+// averageCost folds every receipt into one moving average, rounded to
+// cents like Item.AverageCost
+type averageCost struct {
+	qty, avg float64
+}
+
+func (a *averageCost) in(qty, unitCost float64) {
+	if total := a.qty + qty; a.qty > 0 && total > 0 {
+		a.avg = money.Round((a.qty*a.avg + qty*unitCost) / total)
+	} else {
+		a.avg = money.Round(unitCost)
+	}
+	a.qty = RoundQty(a.qty + qty)
+}
+
+func (a *averageCost) out(qty float64) float64 {
+	a.qty = RoundQty(a.qty - qty)
+	return qty * a.avg
+}
+
+func (a *averageCost) value() float64 {
+	return a.qty * a.avg
+}
+
+func (a *averageCost) cost() float64 {
+	return a.avg
+}
+
+// fifoCost keeps a layer per receipt, oldest first. Stock taken out beyond
+// the layers is costed at the latest receipt's cost and owed as a negative
+// layer that the next receipt makes up first.
+type fifoCost struct {
+	layers []costLayer
+	last   float64
+}
+
+type costLayer struct {
+	qty, cost float64
+}
+
+func (f *fifoCost) in(qty, unitCost float64) {
+	f.last = unitCost
+	for len(f.layers) > 0 && f.layers[0].qty < 0 && qty > 0 {
+		covered := math.Min(qty, -f.layers[0].qty)
+		f.layers[0].qty = RoundQty(f.layers[0].qty + covered)
+		qty = RoundQty(qty - covered)
+		if f.layers[0].qty == 0 {
+			f.layers = f.layers[1:]
+		}
+	}
+	if qty > 0 {
+		f.layers = append(f.layers, costLayer{qty: qty, cost: unitCost})
+	}
+}
+
+func (f *fifoCost) out(qty float64) float64 {
+	var cost float64
+	for len(f.layers) > 0 && f.layers[0].qty > 0 && qty > 0 {
+		taken := math.Min(qty, f.layers[0].qty)
+		cost += taken * f.layers[0].cost
+		f.layers[0].qty = RoundQty(f.layers[0].qty - taken)
+		qty = RoundQty(qty - taken)
+		if f.layers[0].qty == 0 {
+			f.layers = f.layers[1:]
+		}
+	}
+	if qty > 0 {
+		f.layers = append(f.layers, costLayer{qty: -qty, cost: f.last})
+		cost += qty * f.last
+	}
+	return cost
+}
+
+func (f *fifoCost) value() float64 {
+	var v float64
+	for _, l := range f.layers {
+		v += l.qty * l.cost
+	}
+	return v
+}
+
+func (f *fifoCost) cost() float64 {
+	return f.last
+}
@@ -0,0 +1,176 @@
+package inventory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// WasteEntry is stock a cook threw away (waste_entries): Quantity of an
+// inventory item in UnitOfMeasureID, or Quantity portions of a menu item,
+// which come out of stock through its recipe. Its 'waste' ledger entries
+// reference it.
+type WasteEntry struct {
+	ID              uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID    uuid.UUID        `gorm:"type:uuid;not null"`
+	InventoryItemID *uuid.UUID       `gorm:"type:uuid"`
+	MenuItemID      *uuid.UUID       `gorm:"type:uuid"`
+	Quantity        float64          `gorm:"type:decimal(12,3);not null"`
+	UnitOfMeasureID *uuid.UUID       `gorm:"type:uuid"`
+	Reason          AdjustmentReason `gorm:"type:adjustment_reason_enum;not null"`
+	TotalCost       float64          `gorm:"type:decimal(10,2);not null;default:0"`
+	Notes           string           `gorm:"type:text"`
+	LoggedBy        *uuid.UUID       `gorm:"type:uuid"`
+	CreatedAt       time.Time        `gorm:"autoCreateTime"`
+
+	Item *Item `gorm:"foreignKey:InventoryItemID"`
+	Unit *Unit `gorm:"foreignKey:UnitOfMeasureID"`
+}
+
+func (WasteEntry) TableName() string {
+	return "waste_entries"
+}
+
+// NewWasteEntry starts an entry; the caller sets what was wasted.
+func NewWasteEntry(restaurantID uuid.UUID, qty float64, reason AdjustmentReason, loggedBy uuid.UUID) (*WasteEntry, error) {
+	if qty <= 0 {
+		return nil, ErrNonPositiveQuantity
+	}
+	if !reason.IsWaste() {
+		return nil, ErrInvalidWasteReason
+	}
+	return &WasteEntry{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		Quantity:     RoundQty(qty),
+		Reason:       reason,
+		LoggedBy:     &loggedBy,
+	}, nil
+}
+
+// Reference is what the Deductor records menu item waste against, so its
+// ledger entries come out as waste with the entry's reason.
+func (w *WasteEntry) Reference(label string) Reference {
+	return Reference{
+		Type:     "waste",
+		ID:       w.ID,
+		Label:    label,
+		Movement: TransactionWaste,
+		Reason:   string(w.Reason),
+	}
+}
+
+// Post links the ledger entries written for the waste to it and totals
+// their cost. Entries the Deductor wrote under Reference are already linked.
+func (w *WasteEntry) Post(entries []*Transaction) {
+	costs := make([]float64, 0, len(entries))
+	for _, e := range entries {
+		e.ReferenceType = "waste"
+		e.ReferenceID = &w.ID
+		e.PerformedBy = w.LoggedBy
+		if e.TotalCost != nil {
+			costs = append(costs, *e.TotalCost)
+		}
+	}
+	w.TotalCost = money.Sum(costs...)
+}
+
+// WasteLine is the waste of one item for one reason on one day, summed from
+// the ledger.
+type WasteLine struct {
+	Day             time.Time
+	InventoryItemID uuid.UUID
+	ItemName        string
+	Unit            string
+	Reason          AdjustmentReason
+	Quantity        float64
+	Cost            float64
+}
+
+// WasteItemTotal is the waste of one item over a report.
+type WasteItemTotal struct {
+	InventoryItemID uuid.UUID
+	ItemName        string
+	Unit            string
+	Quantity        float64
+	Cost            float64
+}
+
+// WasteCost is waste cost grouped under a reason or day.
+type WasteCost struct {
+	Reason AdjustmentReason
+	Day    time.Time
+	Cost   float64
+}
+
+// WasteReport breaks waste cost down by reason and item, costliest first,
+// and by day, oldest first.
+type WasteReport struct {
+	From     time.Time
+	To       time.Time
+	Total    float64
+	ByReason []WasteCost
+	ByItem   []WasteItemTotal
+	ByDay    []WasteCost
+}
+
+// SummarizeWaste rolls ledger lines up into a report covering [from, to).
+func SummarizeWaste(from, to time.Time, lines []WasteLine) *WasteReport {
+	report := &WasteReport{From: from, To: to}
+	byReason := make(map[AdjustmentReason]*WasteCost)
+	byDay := make(map[time.Time]*WasteCost)
+	byItem := make(map[uuid.UUID]*WasteItemTotal)
+	total := make([]float64, 0, len(lines))
+	for _, l := range lines {
+		total = append(total, l.Cost)
+
+		r, ok := byReason[l.Reason]
+		if !ok {
+			r = &WasteCost{Reason: l.Reason}
+			byReason[l.Reason] = r
+		}
+		r.Cost = money.Sum(r.Cost, l.Cost)
+
+		d, ok := byDay[l.Day]
+		if !ok {
+			d = &WasteCost{Day: l.Day}
+			byDay[l.Day] = d
+		}
+		d.Cost = money.Sum(d.Cost, l.Cost)
+
+		it, ok := byItem[l.InventoryItemID]
+		if !ok {
+			it = &WasteItemTotal{InventoryItemID: l.InventoryItemID, ItemName: l.ItemName, Unit: l.Unit}
+			byItem[l.InventoryItemID] = it
+		}
+		it.Quantity = RoundQty(it.Quantity + l.Quantity)
+		it.Cost = money.Sum(it.Cost, l.Cost)
+	}
+	report.Total = money.Sum(total...)
+
+	for _, r := range byReason {
+		report.ByReason = append(report.ByReason, *r)
+	}
+	sort.Slice(report.ByReason, func(a, b int) bool {
+		if report.ByReason[a].Cost != report.ByReason[b].Cost {
+			return report.ByReason[a].Cost > report.ByReason[b].Cost
+		}
+		return report.ByReason[a].Reason < report.ByReason[b].Reason
+	})
+	for _, it := range byItem {
+		report.ByItem = append(report.ByItem, *it)
+	}
+	sort.Slice(report.ByItem, func(a, b int) bool {
+		if report.ByItem[a].Cost != report.ByItem[b].Cost {
+			return report.ByItem[a].Cost > report.ByItem[b].Cost
+		}
+		return report.ByItem[a].ItemName < report.ByItem[b].ItemName
+	})
+	for _, d := range byDay {
+		report.ByDay = append(report.ByDay, *d)
+	}
+	sort.Slice(report.ByDay, func(a, b int) bool { return report.ByDay[a].Day.Before(report.ByDay[b].Day) })
+	return report
+}
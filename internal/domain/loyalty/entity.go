@@ -0,0 +1,102 @@
+// Package loyalty defines the points program: per-restaurant earning rules,
+// each customer's append-only points ledger and the balance it adds up to.
+package loyalty
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EntryType mirrors loyalty_entry_type_enum.
+type EntryType string
+
+const (
+	// EntryEarn credits points for a delivered order
+	EntryEarn EntryType = "earn"
+	// EntryRedeem debits points spent as a discount at checkout
+	EntryRedeem EntryType = "redeem"
+	// EntryEarnReversal takes back the points a refunded order earned
+	EntryEarnReversal EntryType = "earn_reversal"
+	// EntryRedeemReversal gives back the points spent on a cancelled or
+	// refunded order
+	EntryRedeemReversal EntryType = "redeem_reversal"
+	// EntryExpiry debits points that expired unspent
+	EntryExpiry EntryType = "expiry"
+)
+
+// Rule is how a restaurant's orders earn and redeem points. Orders earn
+// PointsPerUnit points per whole currency unit paid for food, once their
+// subtotal reaches MinimumSubtotal. Points may pay for at most
+// MaxRedeemPercent of the subtotal; 0 turns redemption off.
+type Rule struct {
+	RestaurantID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	PointsPerUnit    float64   `gorm:"type:decimal(10,4);not null;default:1"`
+	MinimumSubtotal  float64   `gorm:"type:decimal(10,2);not null;default:0"`
+	MaxRedeemPercent float64   `gorm:"type:decimal(5,2);not null;default:0"`
+	IsActive         bool      `gorm:"not null;default:true"`
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Rule) TableName() string {
+	return "loyalty_rules"
+}
+
+// Validate checks the rule's values are within the ranges the database
+// accepts.
+func (r *Rule) Validate() error {
+	if r.PointsPerUnit < 0 || r.MinimumSubtotal < 0 ||
+		r.MaxRedeemPercent < 0 || r.MaxRedeemPercent > 100 {
+		return ErrInvalidRule
+	}
+	return nil
+}
+
+// PointsFor returns the points an order earns for what was paid for its
+// food: the subtotal less the discount. Fractions of a point are dropped.
+func (r *Rule) PointsFor(subtotal, discount float64) int {
+	if r == nil || !r.IsActive || subtotal < r.MinimumSubtotal {
+		return 0
+	}
+	paid := subtotal - discount
+	if paid <= 0 {
+		return 0
+	}
+	// The epsilon keeps 10.00 x 1 from flooring to 9
+	return int(math.Floor(paid*r.PointsPerUnit + 1e-9))
+}
+
+// Account holds a customer's balance, the sum of their ledger entries.
+type Account struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Balance   int       `gorm:"not null;default:0"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Account) TableName() string {
+	return "loyalty_accounts"
+}
+
+// Entry is one line of the ledger. Points are negative for debits.
+// BalanceAfter is the account balance once the entry is applied, and may
+// be negative when a refund takes back points already spent.
+type Entry struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null"`
+	OrderID      *uuid.UUID `gorm:"type:uuid"`
+	RestaurantID *uuid.UUID `gorm:"type:uuid"`
+	Type         EntryType  `gorm:"type:loyalty_entry_type_enum;not null"`
+	Points       int        `gorm:"not null"`
+	BalanceAfter int        `gorm:"not null"`
+	// ExpiresAt is set on credits; nil never expires
+	ExpiresAt *time.Time
+	Note      string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (Entry) TableName() string {
+	return "loyalty_ledger"
+}
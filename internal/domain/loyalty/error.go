@@ -0,0 +1,17 @@
+package loyalty
+
+import "errors"
+
+var (
+	ErrRuleNotFound         = errors.New("restaurant has no loyalty rule")
+	ErrInvalidRule          = errors.New("loyalty rule values are out of range")
+	ErrInsufficientPoints   = errors.New("not enough loyalty points")
+	ErrRedemptionNotAllowed = errors.New("restaurant does not accept loyalty points")
+	ErrRedemptionExceedsCap = errors.New("loyalty points exceed the share of the order they may pay for")
+)
+
+// Machine-readable codes returned alongside the error message so clients can
+// react without parsing text.
+const (
+	CodeInsufficientPoints = "INSUFFICIENT_LOYALTY_POINTS"
+)
@@ -0,0 +1,260 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Program holds the settings shared by every restaurant: what a point is
+// worth at checkout and how many months earned points last. Zero months
+// means points never expire.
+type Program struct {
+	PointValue   float64
+	ExpiryMonths int
+}
+
+// Ledger writes the points ledger. Every write locks the customer's
+// account first and must run inside the caller's transaction, so the
+// balance is checked and moved as one step even under concurrent
+// checkouts.
+type Ledger struct {
+	repo    Repository
+	program Program
+}
+
+func NewLedger(repo Repository, program Program) *Ledger {
+	return &Ledger{
+		repo:    repo,
+		program: program,
+	}
+}
+
+// Discount prices paying for part of an order at the restaurant with
+// points, without spending them. The balance is checked again, under
+// lock, when the order is placed.
+func (l *Ledger) Discount(
+	ctx context.Context, userID, restaurantID uuid.UUID, points int, subtotal float64,
+) (float64, error) {
+	if points <= 0 {
+		return 0, nil
+	}
+	rule, err := l.repo.GetRule(ctx, restaurantID)
+	if err != nil {
+		return 0, err
+	}
+	if rule == nil || !rule.IsActive || rule.MaxRedeemPercent <= 0 || l.program.PointValue <= 0 {
+		return 0, ErrRedemptionNotAllowed
+	}
+	discount := money.Round(float64(points) * l.program.PointValue)
+	if discount > money.Round(subtotal*rule.MaxRedeemPercent/100) {
+		return 0, ErrRedemptionExceedsCap
+	}
+
+	acc, err := l.repo.GetAccount(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if acc == nil || acc.Balance < points {
+		return 0, ErrInsufficientPoints
+	}
+	return discount, nil
+}
+
+// Redeem spends the points the order was priced with. It runs in the
+// transaction that saves the order.
+func (l *Ledger) Redeem(ctx context.Context, o *order.Order) error {
+	if o.PointsRedeemed <= 0 || o.UserID == nil {
+		return nil
+	}
+	acc, err := l.repo.LockAccount(ctx, *o.UserID)
+	if err != nil {
+		return err
+	}
+	if acc.Balance < o.PointsRedeemed {
+		return ErrInsufficientPoints
+	}
+	return l.append(ctx, acc, orderEntry(o, EntryRedeem, -o.PointsRedeemed))
+}
+
+// Earn credits a delivered order with the points its restaurant's rule
+// gives. It returns nil when the order earns nothing, as guest orders never
+// do.
+func (l *Ledger) Earn(ctx context.Context, o *order.Order, now time.Time) (*Entry, error) {
+	if o.UserID == nil {
+		return nil, nil
+	}
+	rule, err := l.repo.GetRule(ctx, o.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	points := rule.PointsFor(o.Subtotal, o.Discount)
+	if points == 0 {
+		return nil, nil
+	}
+	acc, err := l.repo.LockAccount(ctx, *o.UserID)
+	if err != nil {
+		return nil, err
+	}
+	e := orderEntry(o, EntryEarn, points)
+	e.ExpiresAt = l.expiresAt(now)
+	return e, l.append(ctx, acc, e)
+}
+
+// Reverse takes back the points the order earned and gives back the ones
+// spent on it, for a refund or cancellation. Points taken back may leave
+// the balance negative when they were already spent. Earned points that
+// Expire has already debited are left alone; those spent before they
+// expired are taken back like any other. Points given back expire as if
+// newly earned. Reversing twice changes nothing.
+func (l *Ledger) Reverse(ctx context.Context, o *order.Order, note string, now time.Time) ([]*Entry, error) {
+	if o.UserID == nil {
+		return nil, nil
+	}
+	acc, err := l.repo.LockAccount(ctx, *o.UserID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := l.repo.ListByOrder(ctx, o.ID)
+	if err != nil {
+		return nil, err
+	}
+	var expired map[uuid.UUID]int
+	for _, e := range entries {
+		if e.Type == EntryEarn && e.ExpiresAt != nil && !e.ExpiresAt.After(now) {
+			history, err := l.repo.ListEntries(ctx, *o.UserID, 0, 0)
+			if err != nil {
+				return nil, err
+			}
+			expired = expiredCredits(history)
+			break
+		}
+	}
+	points := make(map[EntryType]int, len(entries))
+	for _, e := range entries {
+		points[e.Type] += e.Points - expired[e.ID]
+	}
+
+	var reversals []*Entry
+	if earned := points[EntryEarn]; earned > 0 && points[EntryEarnReversal] == 0 {
+		e := orderEntry(o, EntryEarnReversal, -earned)
+		e.Note = note
+		reversals = append(reversals, e)
+	}
+	if spent := -points[EntryRedeem]; spent > 0 && points[EntryRedeemReversal] == 0 {
+		e := orderEntry(o, EntryRedeemReversal, spent)
+		e.ExpiresAt = l.expiresAt(now)
+		e.Note = note
+		reversals = append(reversals, e)
+	}
+	for _, e := range reversals {
+		if err := l.append(ctx, acc, e); err != nil {
+			return nil, err
+		}
+	}
+	return reversals, nil
+}
+
+// Expire debits the customer's points that expired unspent. Points are
+// spent oldest first, so whatever the balance holds beyond the live
+// credits has expired. It returns nil when nothing has.
+func (l *Ledger) Expire(ctx context.Context, userID uuid.UUID, now time.Time) (*Entry, error) {
+	acc, err := l.repo.LockAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	live, err := l.repo.LivePoints(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	expired := acc.Balance - live
+	if expired <= 0 {
+		return nil, nil
+	}
+	e := &Entry{ID: uuid.New(), UserID: userID, Type: EntryExpiry, Points: -expired}
+	return e, l.append(ctx, acc, e)
+}
+
+// expiredCredits replays a ledger, given newest first, and returns how much
+// of each credit expiry entries debited. As in Expire, points are spent
+// oldest first, and an expiry debits the oldest of the credits expired by
+// then that are still unspent. Points an earn reversal takes back come off
+// the order's own credit.
+func expiredCredits(newestFirst []*Entry) map[uuid.UUID]int {
+	type credit struct {
+		entry *Entry
+		left  int
+	}
+	var credits []*credit
+	expired := make(map[uuid.UUID]int)
+	take := func(points int, from func(c *credit) bool) map[*credit]int {
+		taken := make(map[*credit]int)
+		for _, c := range credits {
+			if points <= 0 {
+				break
+			}
+			if c.left <= 0 || !from(c) {
+				continue
+			}
+			n := min(points, c.left)
+			c.left -= n
+			points -= n
+			taken[c] = n
+		}
+		return taken
+	}
+
+	for i := len(newestFirst) - 1; i >= 0; i-- {
+		e := newestFirst[i]
+		switch {
+		case e.Points > 0:
+			credits = append(credits, &credit{entry: e, left: e.Points})
+		case e.Type == EntryEarnReversal:
+			take(-e.Points, func(c *credit) bool {
+				return c.entry.Type == EntryEarn && sameOrder(c.entry, e)
+			})
+		case e.Type == EntryExpiry:
+			taken := take(-e.Points, func(c *credit) bool {
+				return c.entry.ExpiresAt != nil && !c.entry.ExpiresAt.After(e.CreatedAt)
+			})
+			for c, n := range taken {
+				expired[c.entry.ID] += n
+			}
+		default:
+			take(-e.Points, func(*credit) bool { return true })
+		}
+	}
+	return expired
+}
+
+func sameOrder(a, b *Entry) bool {
+	return a.OrderID != nil && b.OrderID != nil && *a.OrderID == *b.OrderID
+}
+
+func (l *Ledger) append(ctx context.Context, acc *Account, e *Entry) error {
+	acc.Balance += e.Points
+	e.BalanceAfter = acc.Balance
+	return l.repo.Append(ctx, acc, e)
+}
+
+func (l *Ledger) expiresAt(now time.Time) *time.Time {
+	if l.program.ExpiryMonths <= 0 {
+		return nil
+	}
+	at := now.UTC().AddDate(0, l.program.ExpiryMonths, 0)
+	return &at
+}
+
+func orderEntry(o *order.Order, t EntryType, points int) *Entry {
+	return &Entry{
+		ID:           uuid.New(),
+		UserID:       *o.UserID,
+		OrderID:      &o.ID,
+		RestaurantID: &o.RestaurantID,
+		Type:         t,
+		Points:       points,
+	}
+}
@@ -0,0 +1,207 @@
+package loyalty
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+)
+
+// fakeRepository holds one account and its ledger, oldest first. Only the
+// methods Reverse uses are implemented.
+type fakeRepository struct {
+	Repository
+	account  *Account
+	entries  []*Entry
+	appended []*Entry
+}
+
+func (r *fakeRepository) LockAccount(ctx context.Context, userID uuid.UUID) (*Account, error) {
+	return r.account, nil
+}
+
+func (r *fakeRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*Entry, error) {
+	var out []*Entry
+	for _, e := range r.entries {
+		if e.OrderID != nil && *e.OrderID == orderID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeRepository) ListEntries(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Entry, error) {
+	out := make([]*Entry, 0, len(r.entries))
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		out = append(out, r.entries[i])
+	}
+	return out, nil
+}
+
+func (r *fakeRepository) Append(ctx context.Context, acc *Account, e *Entry) error {
+	r.entries = append(r.entries, e)
+	r.appended = append(r.appended, e)
+	return nil
+}
+
+func TestLedgerReverse(t *testing.T) {
+	now := time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC)
+	before, after := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		balance int
+		// entries are the customer's ledger before the reversal, oldest
+		// first; other is another order of theirs
+		entries     func(o, other *order.Order) []*Entry
+		wantPoints  map[EntryType]int
+		wantBalance int
+	}{
+		{
+			name:    "live credit is taken back and spent points given back",
+			balance: 150,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &after
+				return []*Entry{orderEntry(o, EntryRedeem, -20), earn}
+			},
+			wantPoints:  map[EntryType]int{EntryEarnReversal: -100, EntryRedeemReversal: 20},
+			wantBalance: 70,
+		},
+		{
+			name:    "credit that never expires is taken back even when spent",
+			balance: 30,
+			entries: func(o, other *order.Order) []*Entry {
+				return []*Entry{orderEntry(o, EntryEarn, 100)}
+			},
+			wantPoints:  map[EntryType]int{EntryEarnReversal: -100},
+			wantBalance: -70,
+		},
+		{
+			name:    "expired credit Expire debited is left alone",
+			balance: 0,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &before
+				return []*Entry{earn, expiry(100, now)}
+			},
+			wantPoints:  map[EntryType]int{},
+			wantBalance: 0,
+		},
+		{
+			name:    "credit expiring right now is left alone once debited",
+			balance: 0,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &now
+				return []*Entry{orderEntry(o, EntryRedeem, -20), earn, expiry(100, now)}
+			},
+			wantPoints:  map[EntryType]int{EntryRedeemReversal: 20},
+			wantBalance: 20,
+		},
+		{
+			name:    "expired credit spent before it expired is taken back",
+			balance: 0,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &before
+				return []*Entry{earn, orderEntry(other, EntryRedeem, -100)}
+			},
+			wantPoints:  map[EntryType]int{EntryEarnReversal: -100},
+			wantBalance: -100,
+		},
+		{
+			name:    "only the unspent part of an expired credit is left alone",
+			balance: 0,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &before
+				return []*Entry{earn, orderEntry(other, EntryRedeem, -30), expiry(70, now)}
+			},
+			wantPoints:  map[EntryType]int{EntryEarnReversal: -30},
+			wantBalance: -30,
+		},
+		{
+			name:    "expired credit Expire has yet to debit is taken back",
+			balance: 100,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &before
+				return []*Entry{earn}
+			},
+			wantPoints:  map[EntryType]int{EntryEarnReversal: -100},
+			wantBalance: 0,
+		},
+		{
+			name:    "older credits expire before the order's",
+			balance: 100,
+			entries: func(o, other *order.Order) []*Entry {
+				older := orderEntry(other, EntryEarn, 50)
+				older.ExpiresAt = &before
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &before
+				return []*Entry{older, earn, expiry(50, now)}
+			},
+			wantPoints:  map[EntryType]int{EntryEarnReversal: -100},
+			wantBalance: 0,
+		},
+		{
+			name:    "already reversed",
+			balance: 50,
+			entries: func(o, other *order.Order) []*Entry {
+				earn := orderEntry(o, EntryEarn, 100)
+				earn.ExpiresAt = &after
+				return []*Entry{
+					earn, orderEntry(o, EntryRedeem, -20),
+					orderEntry(o, EntryEarnReversal, -100), orderEntry(o, EntryRedeemReversal, 20),
+				}
+			},
+			wantPoints:  map[EntryType]int{},
+			wantBalance: 50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.New()
+			o := &order.Order{ID: uuid.New(), UserID: &userID, RestaurantID: uuid.New()}
+			other := &order.Order{ID: uuid.New(), UserID: &userID, RestaurantID: o.RestaurantID}
+			repo := &fakeRepository{
+				account: &Account{UserID: userID, Balance: tt.balance},
+				entries: tt.entries(o, other),
+			}
+			l := NewLedger(repo, Program{PointValue: 0.01, ExpiryMonths: 12})
+
+			reversals, err := l.Reverse(context.Background(), o, "cancelled", now)
+			if err != nil {
+				t.Fatalf("Reverse: %v", err)
+			}
+			got := make(map[EntryType]int)
+			for _, e := range reversals {
+				got[e.Type] += e.Points
+			}
+			if len(got) != len(tt.wantPoints) {
+				t.Errorf("reversals = %v, want %v", got, tt.wantPoints)
+			}
+			for typ, want := range tt.wantPoints {
+				if got[typ] != want {
+					t.Errorf("%s = %d, want %d", typ, got[typ], want)
+				}
+			}
+			if repo.account.Balance != tt.wantBalance {
+				t.Errorf("balance = %d, want %d", repo.account.Balance, tt.wantBalance)
+			}
+
+			// A second reversal must not move anything
+			again, err := l.Reverse(context.Background(), o, "cancelled", now)
+			if err != nil || len(again) != 0 {
+				t.Errorf("second Reverse = %d entries, %v", len(again), err)
+			}
+		})
+	}
+}
+
+func expiry(points int, at time.Time) *Entry {
+	return &Entry{ID: uuid.New(), Type: EntryExpiry, Points: -points, CreatedAt: at}
+}
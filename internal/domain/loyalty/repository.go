@@ -0,0 +1,34 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// GetRule returns the restaurant's rule, or nil if it has none.
+	GetRule(ctx context.Context, restaurantID uuid.UUID) (*Rule, error)
+	SaveRule(ctx context.Context, r *Rule) error
+
+	// GetAccount returns the user's account, or nil if they never had points.
+	GetAccount(ctx context.Context, userID uuid.UUID) (*Account, error)
+	// LockAccount opens the user's account if needed and locks it until the
+	// surrounding transaction ends.
+	LockAccount(ctx context.Context, userID uuid.UUID) (*Account, error)
+	// Append inserts the entry and saves the account's balance; the account
+	// must be locked.
+	Append(ctx context.Context, acc *Account, e *Entry) error
+
+	// ListEntries returns the user's ledger, newest first; a limit of 0
+	// returns all of it.
+	ListEntries(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Entry, error)
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*Entry, error)
+	// LivePoints sums the user's credits still unexpired at the given time,
+	// leaving out points earned by orders since reversed.
+	LivePoints(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+	// ListExpired returns up to limit users whose balance is more than
+	// their live points at the given time.
+	ListExpired(ctx context.Context, at time.Time, limit int) ([]uuid.UUID, error)
+}
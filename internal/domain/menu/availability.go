@@ -0,0 +1,33 @@
+package menu
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reasons recorded in the availability log.
+const (
+	ReasonOutOfStock        = "out_of_stock"
+	ReasonIncompatibleUnits = "incompatible_units"
+	ReasonRestocked         = "restocked"
+)
+
+// AvailabilityChange is one entry of the audit trail of a menu item being
+// switched off or back on (menu_item_availability_log). The database writes
+// them whenever stock or a recipe change flips the item; InventoryItemID is
+// the ingredient that ran out, or whose recipe unit can't be converted to
+// its stock unit.
+type AvailabilityChange struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MenuItemID      uuid.UUID  `gorm:"type:uuid;not null"`
+	IsAvailable     bool       `gorm:"not null"`
+	Reason          string     `gorm:"size:50;not null"`
+	InventoryItemID *uuid.UUID `gorm:"type:uuid"`
+	Details         string     `gorm:"type:text"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+}
+
+func (AvailabilityChange) TableName() string {
+	return "menu_item_availability_log"
+}
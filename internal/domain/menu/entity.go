@@ -0,0 +1,51 @@
+// Package menu defines the MenuItem entity and related value objects.
+package menu
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+)
+
+type MenuItem struct {
+	ID                uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID      uuid.UUID    `gorm:"type:uuid;not null"`
+	CategoryID        *uuid.UUID   `gorm:"type:uuid"`
+	Name              string       `gorm:"size:255;not null"`
+	Slug              string       `gorm:"size:255;not null"`
+	Description       string       `gorm:"type:text"`
+	Price             float64      `gorm:"type:decimal(10,2);not null"`
+	DiscountPrice     *float64     `gorm:"type:decimal(10,2)"`
+	ImageURL          string       `gorm:"type:text"`
+	IsVegetarian      bool         `gorm:"default:false"`
+	IsVegan           bool         `gorm:"default:false"`
+	IsGlutenFree      bool         `gorm:"default:false"`
+	IsSpicy           bool         `gorm:"default:false"`
+	SpiceLevel        *int         `gorm:""`
+	Calories          *int         `gorm:""`
+	PreparationTime   *int         `gorm:""`
+	IsAvailable       bool         `gorm:"default:true"`
+	IsFeatured        bool         `gorm:"default:false"`
+	StockQuantity     *int         `gorm:""`
+	LowStockThreshold int          `gorm:"default:10"`
+	TaxCategory       tax.Category `gorm:"type:tax_category_enum;default:'food'"`
+	DisplayOrder      int          `gorm:"default:0"`
+
+	// StockDisabledAt is set while the item is off because an ingredient of
+	// its recipe ran out, UnavailableReason saying which. Restocking only
+	// switches those items back on.
+	StockDisabledAt   *time.Time `gorm:""`
+	UnavailableReason string     `gorm:"type:text"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// EffectivePrice is the price a customer pays for one unit right now.
+func (m *MenuItem) EffectivePrice() float64 {
+	if m.DiscountPrice != nil && *m.DiscountPrice > 0 && *m.DiscountPrice < m.Price {
+		return *m.DiscountPrice
+	}
+	return m.Price
+}
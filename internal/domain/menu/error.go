@@ -0,0 +1,9 @@
+package menu
+
+import "errors"
+
+var (
+	ErrMenuItemNotFound    = errors.New("menu item not found")
+	ErrMenuItemUnavailable = errors.New("menu item is not available")
+	ErrMenuItemWrongVenue  = errors.New("menu item does not belong to this restaurant")
+)
@@ -0,0 +1,25 @@
+// Package menu defines the domain model and repository interface for managing menu items.
+package menu
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*MenuItem, error)
+	// ListByIDs returns the menu items found; missing IDs are simply absent.
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*MenuItem, error)
+	// ListByRestaurant returns the restaurant's menu in display order.
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*MenuItem, error)
+
+	// RefreshAvailability switches the item off if its recipe can't be made
+	// from stock on hand, or back on if stock had switched it off and no
+	// longer does. Stock changes refresh the items they affect on their own;
+	// call it when a recipe changes.
+	RefreshAvailability(ctx context.Context, id uuid.UUID) error
+	// ListAvailabilityChanges returns the item's availability log, newest
+	// first.
+	ListAvailabilityChanges(ctx context.Context, id uuid.UUID, limit int) ([]*AvailabilityChange, error)
+}
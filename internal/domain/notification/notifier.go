@@ -0,0 +1,29 @@
+// Package notification defines how the application tells users about
+// events on their orders and stock without depending on a delivery channel.
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Message is a single notification addressed to one user.
+type Message struct {
+	UserID  uuid.UUID
+	Subject string
+	Body    string
+	Data    map[string]string
+}
+
+// Notifier delivers messages to users. Implementations must be safe for
+// concurrent use.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Sender delivers a message to an address on one channel, such as an email
+// address or a webhook URL. Implementations must be safe for concurrent use.
+type Sender interface {
+	Send(ctx context.Context, to string, msg Message) error
+}
@@ -0,0 +1,181 @@
+// Package order defines the Order entity and related value objects.
+// It represents how data looks in your database or business rules.
+package order
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+)
+
+// Status mirrors order_status_enum.
+type Status string
+
+const (
+	StatusPending        Status = "pending"
+	StatusConfirmed      Status = "confirmed"
+	StatusPreparing      Status = "preparing"
+	StatusReady          Status = "ready"
+	StatusOutForDelivery Status = "out_for_delivery"
+	StatusDelivered      Status = "delivered"
+	StatusCancelled      Status = "cancelled"
+)
+
+// Type mirrors order_type_enum.
+type Type string
+
+const (
+	TypeDelivery Type = "delivery"
+	TypePickup   Type = "pickup"
+	TypeDineIn   Type = "dine_in"
+)
+
+// PaymentStatus mirrors payment_status_enum.
+type PaymentStatus string
+
+const (
+	PaymentPending  PaymentStatus = "pending"
+	PaymentPaid     PaymentStatus = "paid"
+	PaymentFailed   PaymentStatus = "failed"
+	PaymentRefunded PaymentStatus = "refunded"
+)
+
+// PaymentMethod mirrors payment_method_enum.
+type PaymentMethod string
+
+const (
+	PaymentCreditCard    PaymentMethod = "credit_card"
+	PaymentDebitCard     PaymentMethod = "debit_card"
+	PaymentCash          PaymentMethod = "cash"
+	PaymentWallet        PaymentMethod = "wallet"
+	PaymentOnlinePayment PaymentMethod = "online_payment"
+)
+
+type Order struct {
+	ID                    uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrderNumber           string           `gorm:"size:50;unique;not null"`
+	UserID                *uuid.UUID       `gorm:"type:uuid"` // nil for guests ordering at a table
+	RestaurantID          uuid.UUID        `gorm:"type:uuid;not null"`
+	OrderType             Type             `gorm:"type:order_type_enum;not null;default:'delivery'"`
+	Status                Status           `gorm:"type:order_status_enum;not null;default:'pending'"`
+	Subtotal              float64          `gorm:"type:decimal(10,2);not null"`
+	Tax                   float64          `gorm:"type:decimal(10,2);default:0.00"`
+	TaxBreakdown          tax.Breakdown    `gorm:"type:jsonb;default:'[]'"`
+	DeliveryFee           float64          `gorm:"type:decimal(10,2);default:0.00"`
+	Discount              float64          `gorm:"type:decimal(10,2);default:0.00"`
+	PointsRedeemed        int              `gorm:"default:0"`
+	Tip                   float64          `gorm:"type:decimal(10,2);default:0.00"`
+	Total                 float64          `gorm:"type:decimal(10,2);not null"`
+	WalletAmount          float64          `gorm:"type:decimal(10,2);default:0.00"`
+	PaymentMethod         *PaymentMethod   `gorm:"type:payment_method_enum"`
+	PaymentStatus         PaymentStatus    `gorm:"type:payment_status_enum;default:'pending'"`
+	PaymentTransactionID  string           `gorm:"size:255"`
+	DeliveryAddressID     *uuid.UUID       `gorm:"type:uuid"`
+	DeliveryAddress       *AddressSnapshot `gorm:"column:delivery_address_snapshot;type:jsonb"`
+	DeliveryZoneID        *uuid.UUID       `gorm:"type:uuid"`
+	DeliveryDistanceKm    *float64         `gorm:"type:decimal(8,3)"`
+	PrepMinutes           int              `gorm:"default:0"`
+	TravelMinutes         int              `gorm:"default:0"`
+	DeliveryPhone         string           `gorm:"size:20"`
+	DeliveryInstructions  string           `gorm:"type:text"`
+	EstimatedDeliveryTime *time.Time
+	PromisedDeliveryTime  *time.Time
+	ScheduledFor          *time.Time
+	ReleaseAt             *time.Time
+	AcceptedAt            *time.Time
+	PreparingAt           *time.Time
+	ReadyAt               *time.Time
+	OutForDeliveryAt      *time.Time
+	DeliveredAt           *time.Time
+	CancelledAt           *time.Time
+	RefundedAt            *time.Time
+	SpecialInstructions   string     `gorm:"type:text"`
+	CancellationReason    string     `gorm:"type:text"`
+	RefundReason          string     `gorm:"type:text"`
+	DriverID              *uuid.UUID `gorm:"type:uuid"`
+	TableSessionID        *uuid.UUID `gorm:"type:uuid"`
+	TableNumber           string     `gorm:"size:20"`
+	CreatedAt             time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt             time.Time  `gorm:"autoUpdateTime"`
+
+	Items []*Item `gorm:"foreignKey:OrderID"`
+}
+
+type Item struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrderID             uuid.UUID `gorm:"type:uuid;not null"`
+	MenuItemID          uuid.UUID `gorm:"type:uuid;not null"`
+	ItemName            string    `gorm:"size:255;not null"`
+	ItemDescription     string    `gorm:"type:text"`
+	ItemImageURL        string    `gorm:"type:text"`
+	Quantity            int       `gorm:"not null"`
+	UnitPrice           float64   `gorm:"type:decimal(10,2);not null"`
+	Subtotal            float64   `gorm:"type:decimal(10,2);not null"`
+	SpecialInstructions string    `gorm:"type:text"`
+	CreatedAt           time.Time `gorm:"autoCreateTime"`
+}
+
+// PlacedBy reports whether the user placed the order. Guest orders have no
+// user.
+func (o *Order) PlacedBy(userID uuid.UUID) bool {
+	return o.UserID != nil && *o.UserID == userID
+}
+
+// IsScheduled reports whether the order is a pre-order still waiting to be
+// released to the kitchen.
+func (o *Order) IsScheduled() bool {
+	return o.ScheduledFor != nil && o.Status == StatusPending
+}
+
+func (Item) TableName() string {
+	return "order_items"
+}
+
+// NewOrder is a Factory Function that ensures an Order
+// is always created with a valid ID and default business state.
+// userID is nil for guests ordering at a table.
+func NewOrder(userID *uuid.UUID, restaurantID uuid.UUID, orderType Type) *Order {
+	return &Order{
+		ID:            uuid.New(),
+		UserID:        userID,
+		RestaurantID:  restaurantID,
+		OrderType:     orderType,
+		Status:        StatusPending,
+		PaymentStatus: PaymentPending,
+		TaxBreakdown:  tax.Breakdown{},
+	}
+}
+
+// AddressSnapshot freezes the delivery address at checkout time so later
+// edits to the address book don't change where a past order went.
+type AddressSnapshot struct {
+	Label         string  `json:"label,omitempty"`
+	StreetAddress string  `json:"street_address"`
+	Apartment     string  `json:"apartment,omitempty"`
+	City          string  `json:"city"`
+	State         string  `json:"state,omitempty"`
+	PostalCode    string  `json:"postal_code"`
+	Country       string  `json:"country"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+}
+
+func (a AddressSnapshot) Value() (driver.Value, error) {
+	raw, err := json.Marshal(a)
+	return string(raw), err
+}
+
+func (a *AddressSnapshot) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return errors.New("order: unsupported address snapshot type")
+	}
+}
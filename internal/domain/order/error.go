@@ -0,0 +1,30 @@
+package order
+
+import "errors"
+
+var (
+	ErrOrderNotFound     = errors.New("order not found")
+	ErrEmptyOrder        = errors.New("order must contain at least one item")
+	ErrInvalidOrderType  = errors.New("invalid order type")
+	ErrBelowMinimumOrder = errors.New("order subtotal is below the restaurant minimum")
+	ErrRestaurantClosed  = errors.New("restaurant is not accepting orders")
+	ErrNotOrderOwner     = errors.New("order belongs to another user")
+	ErrInvalidTransition = errors.New("order cannot move to the requested status")
+	ErrNotRefundable     = errors.New("only delivered or cancelled orders can be refunded")
+	ErrAlreadyRefunded   = errors.New("order is already refunded")
+	ErrNotPaid           = errors.New("order has not been paid")
+	ErrPaidInCash        = errors.New("cash payments are refunded at the restaurant")
+
+	ErrScheduleTooSoon     = errors.New("scheduled time is too soon to prepare the order")
+	ErrScheduleTooFar      = errors.New("scheduled time is too far in the future")
+	ErrScheduleNotAligned  = errors.New("scheduled time must be the start of a pre-order slot")
+	ErrScheduleClosed      = errors.New("restaurant is closed at the scheduled time")
+	ErrSlotFull            = errors.New("pre-order slot is fully booked")
+	ErrInvalidScheduleDate = errors.New("date must be formatted as YYYY-MM-DD")
+)
+
+// Machine-readable codes returned alongside the error message so clients can
+// react without parsing text.
+const (
+	CodeSlotFull = "PREORDER_SLOT_FULL"
+)
@@ -0,0 +1,60 @@
+package order
+
+import "time"
+
+// EstimateETA returns when the order is expected to reach the customer, or to
+// be ready for pickup and dine-in, given its current status.
+//
+// kitchenLoad is the number of other orders confirmed or preparing at the
+// restaurant; each delays an order that has not started by perQueuedOrder.
+// Delivered and cancelled orders keep their last estimate.
+func (o *Order) EstimateETA(kitchenLoad int, perQueuedOrder time.Duration, now time.Time) *time.Time {
+	prep := time.Duration(o.PrepMinutes) * time.Minute
+	travel := time.Duration(o.TravelMinutes) * time.Minute
+
+	var eta time.Time
+	switch o.Status {
+	case StatusPending, StatusConfirmed:
+		// Pre-orders are released early enough to arrive on time
+		if o.IsScheduled() {
+			return o.ScheduledFor
+		}
+		eta = now.Add(time.Duration(kitchenLoad)*perQueuedOrder + prep + travel)
+	case StatusPreparing:
+		eta = later(now, o.PreparingAt, prep).Add(travel)
+	case StatusReady:
+		eta = now.Add(travel)
+	case StatusOutForDelivery:
+		eta = later(now, o.OutForDeliveryAt, travel)
+	default:
+		return o.EstimatedDeliveryTime
+	}
+	return &eta
+}
+
+// later returns start+d, or now when that has already passed or start is unset
+func later(now time.Time, start *time.Time, d time.Duration) time.Time {
+	if start == nil {
+		return now
+	}
+	if t := start.Add(d); t.After(now) {
+		return t
+	}
+	return now
+}
+
+// ETAAccuracy compares delivered orders with the ETA promised at checkout.
+// Errors are delivered_at minus the promise, so positive means late.
+type ETAAccuracy struct {
+	From                time.Time
+	To                  time.Time
+	Orders              int
+	MeanErrorMinutes    float64
+	MeanAbsErrorMinutes float64
+	// OnTimeRate is the share of orders delivered at most OnTimeTolerance
+	// after the promise.
+	OnTimeRate float64
+}
+
+// OnTimeTolerance is how late an order may be and still count as on time.
+const OnTimeTolerance = 5 * time.Minute
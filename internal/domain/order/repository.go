@@ -0,0 +1,36 @@
+// Package order defines the domain model and repository interface for managing orders.
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create persists the order together with its items in one transaction.
+	Create(ctx context.Context, order *Order) error
+	// GetByID loads the order with its items.
+	GetByID(ctx context.Context, id uuid.UUID) (*Order, error)
+	// LockByID loads the order with its items and locks it until the
+	// surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Order, error)
+	Update(ctx context.Context, order *Order) error
+	// LockNextDueForRelease locks and returns one pending scheduled order whose
+	// release time has passed, with its items, skipping rows locked by other
+	// replicas. It must run inside a transaction and returns nil when nothing
+	// is due.
+	LockNextDueForRelease(ctx context.Context, now time.Time) (*Order, error)
+	// CountInKitchen counts the restaurant's confirmed and preparing orders,
+	// leaving out excludeID.
+	CountInKitchen(ctx context.Context, restaurantID, excludeID uuid.UUID) (int, error)
+	// ETAAccuracy summarises orders delivered in [from, to).
+	ETAAccuracy(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) (*ETAAccuracy, error)
+	// ListByTableSession returns the orders on a table's tab with their
+	// items, oldest first.
+	ListByTableSession(ctx context.Context, sessionID uuid.UUID) ([]*Order, error)
+	// SetTableNumber moves the tab's orders that are still being prepared
+	// or served to another table.
+	SetTableNumber(ctx context.Context, sessionID uuid.UUID, number string) error
+}
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Slot is a pre-order time window of a restaurant. Each slot accepts at most
+// Capacity scheduled orders to protect kitchen capacity.
+type Slot struct {
+	RestaurantID uuid.UUID
+	Start        time.Time
+	End          time.Time
+	Capacity     int
+	Reserved     int
+}
+
+// Remaining is the number of orders the slot can still accept.
+func (s Slot) Remaining() int {
+	if s.Reserved >= s.Capacity {
+		return 0
+	}
+	return s.Capacity - s.Reserved
+}
+
+// SlotRepository tracks reserved capacity per slot in shared storage so that
+// every API replica sees the same counts.
+type SlotRepository interface {
+	// Reserve atomically takes one unit of capacity and returns ErrSlotFull
+	// when none is left.
+	Reserve(ctx context.Context, restaurantID uuid.UUID, start time.Time, capacity int) error
+	// Release gives back one unit of capacity, e.g. when an order is cancelled.
+	Release(ctx context.Context, restaurantID uuid.UUID, start time.Time) error
+	// ListReserved returns reserved counts keyed by slot start (UTC) in [from, to).
+	ListReserved(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) (map[time.Time]int, error)
+}
@@ -0,0 +1,73 @@
+package order
+
+import "time"
+
+// transitions lists the statuses an order may move to from each status.
+// Pickup and dine-in orders skip out_for_delivery.
+var transitions = map[Status][]Status{
+	StatusPending:        {StatusConfirmed, StatusCancelled},
+	StatusConfirmed:      {StatusPreparing, StatusCancelled},
+	StatusPreparing:      {StatusReady, StatusCancelled},
+	StatusReady:          {StatusOutForDelivery, StatusDelivered},
+	StatusOutForDelivery: {StatusDelivered},
+}
+
+// CanTransitionTo reports whether the order may move to status.
+func (o *Order) CanTransitionTo(status Status) bool {
+	if status == StatusOutForDelivery && o.OrderType != TypeDelivery {
+		return false
+	}
+	for _, s := range transitions[o.Status] {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the order to status and stamps the matching timestamp.
+func (o *Order) TransitionTo(status Status, at time.Time) error {
+	if !o.CanTransitionTo(status) {
+		return ErrInvalidTransition
+	}
+
+	o.Status = status
+	switch status {
+	case StatusConfirmed:
+		o.AcceptedAt = &at
+	case StatusPreparing:
+		o.PreparingAt = &at
+	case StatusReady:
+		o.ReadyAt = &at
+	case StatusOutForDelivery:
+		o.OutForDeliveryAt = &at
+	case StatusDelivered:
+		o.DeliveredAt = &at
+	case StatusCancelled:
+		o.CancelledAt = &at
+	}
+	return nil
+}
+
+// Refund marks the payment of a delivered or cancelled order as refunded.
+// Orders still in progress are cancelled first. Only paid orders can be
+// refunded, and not those paid in cash, which is handed back at the
+// restaurant.
+func (o *Order) Refund(reason string, at time.Time) error {
+	if o.PaymentStatus == PaymentRefunded {
+		return ErrAlreadyRefunded
+	}
+	if o.Status != StatusDelivered && o.Status != StatusCancelled {
+		return ErrNotRefundable
+	}
+	if o.PaymentStatus != PaymentPaid {
+		return ErrNotPaid
+	}
+	if o.PaymentMethod != nil && *o.PaymentMethod == PaymentCash {
+		return ErrPaidInCash
+	}
+	o.PaymentStatus = PaymentRefunded
+	o.RefundReason = reason
+	o.RefundedAt = &at
+	return nil
+}
@@ -0,0 +1,47 @@
+// Package payment defines how the application charges and refunds cards
+// without depending on a payment provider.
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrPaymentDeclined = errors.New("payment was declined")
+	ErrInvalidAmount   = errors.New("payment amount must be positive")
+)
+
+// ChargeRequest charges Amount to the card the client-side token stands
+// for. Retrying with the same IdempotencyKey must not charge twice.
+type ChargeRequest struct {
+	Amount         float64
+	Token          string
+	Description    string
+	IdempotencyKey string
+}
+
+// RefundRequest gives back Amount of the charge ChargeID. Retrying with the
+// same IdempotencyKey must not refund twice, and returns the first refund's
+// reference.
+type RefundRequest struct {
+	ChargeID       string
+	Amount         float64
+	IdempotencyKey string
+}
+
+// Charge is a successful charge. ID is the provider's reference, used to
+// refund it.
+type Charge struct {
+	ID     string
+	Amount float64
+}
+
+// Provider charges and refunds cards. A declined card returns
+// ErrPaymentDeclined. Implementations must be safe for concurrent use.
+type Provider interface {
+	Charge(ctx context.Context, req ChargeRequest) (*Charge, error)
+	// Refund gives back part or all of a charge and returns the refund's
+	// reference.
+	Refund(ctx context.Context, req RefundRequest) (string, error)
+}
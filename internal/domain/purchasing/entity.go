@@ -0,0 +1,104 @@
+// Package purchasing defines purchase orders: stock ordered from a supplier,
+// approved, sent and received line by line.
+package purchasing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Status mirrors po_status_enum.
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusSubmitted Status = "submitted"
+	StatusApproved  Status = "approved"
+	StatusOrdered   Status = "ordered"
+	StatusReceived  Status = "received"
+	StatusCancelled Status = "cancelled"
+)
+
+type PurchaseOrder struct {
+	ID                   uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	PONumber             string     `gorm:"column:po_number;size:50;unique;not null"`
+	RestaurantID         uuid.UUID  `gorm:"type:uuid;not null"`
+	SupplierID           uuid.UUID  `gorm:"type:uuid;not null"`
+	Status               Status     `gorm:"type:po_status_enum;default:'draft'"`
+	OrderDate            time.Time  `gorm:"type:date;not null"`
+	ExpectedDeliveryDate *time.Time `gorm:"type:date"`
+	ActualDeliveryDate   *time.Time `gorm:"type:date"`
+	Subtotal             float64    `gorm:"type:decimal(10,2);default:0.00"`
+	Tax                  float64    `gorm:"type:decimal(10,2);default:0.00"`
+	ShippingCost         float64    `gorm:"type:decimal(10,2);default:0.00"`
+	Discount             float64    `gorm:"type:decimal(10,2);default:0.00"`
+	Total                float64    `gorm:"type:decimal(10,2);default:0.00"`
+	Notes                string     `gorm:"type:text"`
+	DeliveryInstructions string     `gorm:"type:text"`
+	CreatedBy            *uuid.UUID `gorm:"type:uuid"`
+	ApprovedBy           *uuid.UUID `gorm:"type:uuid"`
+	ReceivedBy           *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt            time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time  `gorm:"autoUpdateTime"`
+
+	Lines []*Line `gorm:"foreignKey:PurchaseOrderID"`
+}
+
+// Line is one item on a purchase order. Quantities and prices are in the
+// inventory item's unit.
+type Line struct {
+	ID               uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	PurchaseOrderID  uuid.UUID `gorm:"type:uuid;not null"`
+	InventoryItemID  uuid.UUID `gorm:"type:uuid;not null"`
+	QuantityOrdered  float64   `gorm:"type:decimal(12,3);not null"`
+	UnitPrice        float64   `gorm:"type:decimal(10,2);not null"`
+	QuantityReceived float64   `gorm:"type:decimal(12,3);default:0.00"`
+	Subtotal         float64   `gorm:"type:decimal(10,2);not null"`
+	Tax              float64   `gorm:"type:decimal(10,2);default:0.00"`
+	Total            float64   `gorm:"type:decimal(10,2);not null"`
+	Notes            string    `gorm:"type:text"`
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+
+	Item *inventory.Item `gorm:"foreignKey:InventoryItemID"`
+}
+
+func (Line) TableName() string {
+	return "purchase_order_items"
+}
+
+// NewPurchaseOrder is a Factory Function that ensures a PurchaseOrder
+// is always created with a valid ID and default business state. createdBy
+// is nil for drafts raised by the system.
+func NewPurchaseOrder(restaurantID, supplierID uuid.UUID, createdBy *uuid.UUID, at time.Time) *PurchaseOrder {
+	return &PurchaseOrder{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		SupplierID:   supplierID,
+		Status:       StatusDraft,
+		OrderDate:    at.Truncate(24 * time.Hour),
+		CreatedBy:    createdBy,
+	}
+}
+
+// NewLine prices a line for qty of item at unitPrice plus tax.
+func NewLine(item *inventory.Item, qty, unitPrice, tax float64) *Line {
+	subtotal := money.Round(qty * unitPrice)
+	return &Line{
+		ID:              uuid.New(),
+		InventoryItemID: item.ID,
+		QuantityOrdered: inventory.RoundQty(qty),
+		UnitPrice:       money.Round(unitPrice),
+		Subtotal:        subtotal,
+		Tax:             money.Round(tax),
+		Total:           money.Sum(subtotal, tax),
+		Item:            item,
+	}
+}
+
+// Outstanding is how much of the line is still to be delivered.
+func (l *Line) Outstanding() float64 {
+	return inventory.RoundQty(l.QuantityOrdered - l.QuantityReceived)
+}
@@ -0,0 +1,19 @@
+package purchasing
+
+import "errors"
+
+var (
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrLineNotFound          = errors.New("purchase order line not found")
+	ErrInvalidTransition     = errors.New("purchase order cannot move to the requested status")
+	ErrNotDraft              = errors.New("only draft purchase orders can be edited")
+	ErrEmptyPurchaseOrder    = errors.New("purchase order must contain at least one line")
+	ErrSelfApproval          = errors.New("purchase orders must be approved by someone other than their creator")
+	ErrNotOrdered            = errors.New("only ordered purchase orders can be received")
+	ErrOverReceipt           = errors.New("quantity received exceeds the quantity outstanding")
+	ErrAlreadyReceived       = errors.New("purchase order has receipts and can no longer be cancelled")
+	ErrDuplicateLine         = errors.New("an inventory item can only appear once on a purchase order")
+	ErrLineWrongVenue        = errors.New("inventory item belongs to another restaurant")
+	ErrInvalidReorderMode    = errors.New("reorder mode must be standard or forecast")
+	ErrReorderInProgress     = errors.New("reorder suggestions are already being drafted for this restaurant")
+)
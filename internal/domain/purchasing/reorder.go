@@ -0,0 +1,184 @@
+package purchasing
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// ReorderMode decides how suggested quantities are sized.
+type ReorderMode string
+
+const (
+	// ReorderStandard orders reorder_quantity, or enough to reach
+	// maximum_stock, or enough to reach twice the reorder point
+	ReorderStandard ReorderMode = "standard"
+	// ReorderForecast orders enough to last, at the trailing daily usage,
+	// until the delivery after the next one, keeping minimum_stock in hand
+	ReorderForecast ReorderMode = "forecast"
+)
+
+func (m ReorderMode) Valid() bool {
+	return m == ReorderStandard || m == ReorderForecast
+}
+
+// ReorderCandidate is an item at or below its reorder point.
+type ReorderCandidate struct {
+	Item *inventory.Item
+	// OnOrder is what open purchase orders will still deliver
+	OnOrder float64
+	// DailyUsage is the trailing average, used in forecast mode
+	DailyUsage float64
+}
+
+// ReorderLine is one suggested purchase.
+type ReorderLine struct {
+	ReorderCandidate
+	Quantity  float64
+	UnitPrice float64
+	Subtotal  float64
+}
+
+// ReorderProposal is a suggested purchase order for one supplier.
+type ReorderProposal struct {
+	Supplier     *supplier.Supplier
+	NextDelivery time.Time
+	Lines        []*ReorderLine
+	Subtotal     float64
+	// MeetsMinimum is false when Subtotal is below the supplier's minimum
+	// order amount; such proposals are not turned into drafts
+	MeetsMinimum bool
+}
+
+// ReorderPlan groups suggestions by supplier. Items without a supplier
+// can't be ordered automatically and are listed in Unassigned.
+type ReorderPlan struct {
+	Mode       ReorderMode
+	Proposals  []*ReorderProposal
+	Unassigned []*ReorderLine
+}
+
+// PlanReorders sizes a purchase for every candidate that still needs one
+// once stock on order is counted, and groups them by supplier.
+func PlanReorders(
+	mode ReorderMode, candidates []ReorderCandidate, suppliers map[uuid.UUID]*supplier.Supplier, now time.Time,
+) *ReorderPlan {
+	plan := &ReorderPlan{Mode: mode}
+	bySupplier := make(map[uuid.UUID]*ReorderProposal)
+	for _, c := range candidates {
+		// Enough is already on its way
+		if position(c) > reorderPoint(c.Item) {
+			continue
+		}
+		var sup *supplier.Supplier
+		if c.Item.SupplierID != nil {
+			sup = suppliers[*c.Item.SupplierID]
+		}
+
+		qty := standardQuantity(c)
+		if mode == ReorderForecast && sup != nil && c.DailyUsage > 0 {
+			next := sup.NextDelivery(now)
+			following := sup.NextDelivery(next)
+			qty = forecastQuantity(c, following.Sub(now).Hours()/24)
+		}
+		qty = roundUp(qty, c.Item.Unit)
+		if qty <= 0 {
+			continue
+		}
+
+		line := &ReorderLine{ReorderCandidate: c, Quantity: qty, UnitPrice: lastPrice(c.Item)}
+		line.Subtotal = money.Round(qty * line.UnitPrice)
+		if sup == nil || !sup.IsActive {
+			plan.Unassigned = append(plan.Unassigned, line)
+			continue
+		}
+		p, ok := bySupplier[sup.ID]
+		if !ok {
+			p = &ReorderProposal{Supplier: sup, NextDelivery: sup.NextDelivery(now)}
+			bySupplier[sup.ID] = p
+			plan.Proposals = append(plan.Proposals, p)
+		}
+		p.Lines = append(p.Lines, line)
+		p.Subtotal = money.Sum(p.Subtotal, line.Subtotal)
+	}
+
+	for _, p := range plan.Proposals {
+		p.MeetsMinimum = p.Supplier.MinimumOrderAmount == nil || p.Subtotal >= *p.Supplier.MinimumOrderAmount
+	}
+	sort.SliceStable(plan.Proposals, func(i, j int) bool {
+		return plan.Proposals[i].Supplier.Name < plan.Proposals[j].Supplier.Name
+	})
+	return plan
+}
+
+// Draft turns the proposal into a draft purchase order.
+func (p *ReorderProposal) Draft(restaurantID uuid.UUID, createdBy *uuid.UUID, at time.Time) (*PurchaseOrder, error) {
+	po := NewPurchaseOrder(restaurantID, p.Supplier.ID, createdBy, at)
+	next := p.NextDelivery
+	po.ExpectedDeliveryDate = &next
+	po.Notes = "Suggested from low stock"
+	lines := make([]*Line, 0, len(p.Lines))
+	for _, l := range p.Lines {
+		lines = append(lines, NewLine(l.Item, l.Quantity, l.UnitPrice, 0))
+	}
+	if err := po.SetLines(lines, 0, 0); err != nil {
+		return nil, err
+	}
+	return po, nil
+}
+
+// position is stock in hand plus stock on its way
+func position(c ReorderCandidate) float64 {
+	return c.Item.CurrentStock + c.OnOrder
+}
+
+func reorderPoint(item *inventory.Item) float64 {
+	if item.ReorderPoint != nil {
+		return *item.ReorderPoint
+	}
+	return item.MinimumStock
+}
+
+func standardQuantity(c ReorderCandidate) float64 {
+	switch {
+	case c.Item.ReorderQuantity != nil:
+		return *c.Item.ReorderQuantity
+	case c.Item.MaximumStock != nil:
+		return *c.Item.MaximumStock - position(c)
+	default:
+		return 2*reorderPoint(c.Item) - position(c)
+	}
+}
+
+func forecastQuantity(c ReorderCandidate, coverDays float64) float64 {
+	need := c.DailyUsage*coverDays + c.Item.MinimumStock - position(c)
+	if c.Item.MaximumStock != nil && position(c)+need > *c.Item.MaximumStock {
+		need = *c.Item.MaximumStock - position(c)
+	}
+	return need
+}
+
+// roundUp rounds to whole units for counted items and to stock precision
+// otherwise, never down
+func roundUp(qty float64, unit *inventory.Unit) float64 {
+	if unit != nil && unit.Type == inventory.UnitCount {
+		return math.Ceil(qty - 1e-9)
+	}
+	return math.Ceil(qty*1000-1e-6) / 1000
+}
+
+// lastPrice is the best guess at what the supplier will charge
+func lastPrice(item *inventory.Item) float64 {
+	if item.LastPurchaseCost != nil {
+		return *item.LastPurchaseCost
+	}
+	if item.UnitCost > 0 {
+		return item.UnitCost
+	}
+	return item.AverageCost
+}
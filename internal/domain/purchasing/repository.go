@@ -0,0 +1,37 @@
+// Package purchasing defines the domain model and repository interface for managing purchase orders.
+package purchasing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Filter narrows List. Zero values match everything.
+type Filter struct {
+	SupplierID *uuid.UUID
+	Status     Status
+}
+
+// Purchase orders are loaded with their lines, each carrying its inventory
+// item and unit.
+type Repository interface {
+	// Create persists the purchase order with its lines and reads back the
+	// generated PO number.
+	Create(ctx context.Context, po *PurchaseOrder) error
+	GetByID(ctx context.Context, id uuid.UUID) (*PurchaseOrder, error)
+	// LockByID locks the purchase order until the surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*PurchaseOrder, error)
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, filter Filter) ([]*PurchaseOrder, error)
+	// Update saves the purchase order and the current state of its lines.
+	Update(ctx context.Context, po *PurchaseOrder) error
+	// ReplaceLines saves a draft, replacing all its lines.
+	ReplaceLines(ctx context.Context, po *PurchaseOrder) error
+	// OnOrder sums, per inventory item, what the restaurant's open purchase
+	// orders have yet to deliver.
+	OnOrder(ctx context.Context, restaurantID uuid.UUID) (map[uuid.UUID]float64, error)
+	// TryLockReorders takes a transaction-scoped lock on the restaurant's
+	// reorder run and reports whether it got it. Replicas that don't get it
+	// skip the run.
+	TryLockReorders(ctx context.Context, restaurantID uuid.UUID) (bool, error)
+}
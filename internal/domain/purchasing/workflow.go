@@ -0,0 +1,118 @@
+package purchasing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// transitions lists the statuses a purchase order may move to by hand.
+// Received is reached only by receiving every line in full.
+var transitions = map[Status][]Status{
+	StatusDraft:     {StatusSubmitted, StatusCancelled},
+	StatusSubmitted: {StatusApproved, StatusDraft, StatusCancelled},
+	StatusApproved:  {StatusOrdered, StatusCancelled},
+	StatusOrdered:   {StatusCancelled},
+}
+
+// SetLines replaces the lines of a draft and recomputes its totals.
+func (po *PurchaseOrder) SetLines(lines []*Line, shipping, discount float64) error {
+	if po.Status != StatusDraft {
+		return ErrNotDraft
+	}
+	for _, l := range lines {
+		l.PurchaseOrderID = po.ID
+	}
+	po.Lines = lines
+	po.ShippingCost = money.Round(shipping)
+	po.Discount = money.Round(discount)
+
+	subtotals := make([]float64, 0, len(lines))
+	taxes := make([]float64, 0, len(lines))
+	for _, l := range lines {
+		subtotals = append(subtotals, l.Subtotal)
+		taxes = append(taxes, l.Tax)
+	}
+	po.Subtotal = money.Sum(subtotals...)
+	po.Tax = money.Sum(taxes...)
+	po.Total = money.Sum(po.Subtotal, po.Tax, po.ShippingCost, -po.Discount)
+	return nil
+}
+
+// TransitionTo moves the purchase order to status on behalf of by. A
+// submitted order may be sent back to draft for changes, and must be
+// approved by someone other than its creator.
+func (po *PurchaseOrder) TransitionTo(status Status, by uuid.UUID, at time.Time) error {
+	allowed := false
+	for _, s := range transitions[po.Status] {
+		if s == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrInvalidTransition
+	}
+
+	switch status {
+	case StatusSubmitted:
+		if len(po.Lines) == 0 {
+			return ErrEmptyPurchaseOrder
+		}
+	case StatusDraft:
+		po.ApprovedBy = nil
+	case StatusApproved:
+		if po.CreatedBy != nil && *po.CreatedBy == by {
+			return ErrSelfApproval
+		}
+		po.ApprovedBy = &by
+	case StatusOrdered:
+		po.OrderDate = at.Truncate(24 * time.Hour)
+	case StatusCancelled:
+		for _, l := range po.Lines {
+			if l.QuantityReceived > 0 {
+				return ErrAlreadyReceived
+			}
+		}
+	}
+	po.Status = status
+	return nil
+}
+
+// Receive books qty delivered against a line. Deliveries may be split over
+// several receipts; the order becomes received once every line is complete.
+func (po *PurchaseOrder) Receive(lineID uuid.UUID, qty float64, by uuid.UUID, at time.Time) (*Line, error) {
+	if po.Status != StatusOrdered {
+		return nil, ErrNotOrdered
+	}
+	if qty <= 0 {
+		return nil, inventory.ErrNonPositiveQuantity
+	}
+	var line *Line
+	for _, l := range po.Lines {
+		if l.ID == lineID {
+			line = l
+			break
+		}
+	}
+	if line == nil {
+		return nil, ErrLineNotFound
+	}
+	if inventory.RoundQty(qty) > line.Outstanding() {
+		return nil, ErrOverReceipt
+	}
+
+	line.QuantityReceived = inventory.RoundQty(line.QuantityReceived + qty)
+	po.ReceivedBy = &by
+	for _, l := range po.Lines {
+		if l.Outstanding() > 0 {
+			return line, nil
+		}
+	}
+	po.Status = StatusReceived
+	day := at.Truncate(24 * time.Hour)
+	po.ActualDeliveryDate = &day
+	return line, nil
+}
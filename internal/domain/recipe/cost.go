@@ -0,0 +1,83 @@
+package recipe
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// LineCost is what one ingredient adds to a single portion.
+type LineCost struct {
+	Ingredient *Ingredient
+	// Quantity per portion, in the inventory item's unit
+	Quantity float64
+	UnitCost float64
+	Cost     float64
+}
+
+// PlateCost is the theoretical cost of one portion, valued at each
+// ingredient's average cost. Optional ingredients are listed but not
+// counted, since the plate is normally served without them.
+type PlateCost struct {
+	Recipe    *Recipe
+	MenuPrice float64
+	Lines     []LineCost
+	Cost      float64
+	// FoodCostPercent is Cost as a share of MenuPrice; nil for free items
+	FoodCostPercent *float64
+}
+
+// PlateCost values the recipe. Ingredients must be loaded with their
+// inventory items, and the recipe with its menu item.
+func (r *Recipe) PlateCost() (*PlateCost, error) {
+	servings := float64(r.ServingSize)
+	if servings <= 0 {
+		servings = 1
+	}
+
+	pc := &PlateCost{Recipe: r}
+	if r.MenuItem != nil {
+		pc.MenuPrice = r.MenuItem.EffectivePrice()
+	}
+	total := 0.0
+	for _, ing := range r.Ingredients {
+		if ing.Item == nil {
+			return nil, ErrIngredientNotCosted
+		}
+		qty := ing.Quantity / servings
+		if ing.Unit != nil && ing.Item.Unit != nil {
+			converted, err := inventory.Convert(qty, ing.Unit, ing.Item.Unit)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", err, ing.Item.Name)
+			}
+			qty = converted
+		}
+		cost := qty * ing.Item.AverageCost
+		if !ing.IsOptional {
+			total += cost
+		}
+		pc.Lines = append(pc.Lines, LineCost{
+			Ingredient: ing,
+			Quantity:   inventory.RoundQty(qty),
+			UnitCost:   ing.Item.AverageCost,
+			Cost:       money.Round(cost),
+		})
+	}
+	pc.Cost = money.Round(total)
+	if pc.MenuPrice > 0 {
+		pct := math.Round(pc.Cost/pc.MenuPrice*10000) / 100
+		pc.FoodCostPercent = &pct
+	}
+	return pc, nil
+}
+
+// Exceeds reports whether food cost is above threshold percent of the price.
+// A free item with any cost always exceeds it.
+func (p *PlateCost) Exceeds(threshold float64) bool {
+	if p.FoodCostPercent == nil {
+		return p.Cost > 0
+	}
+	return *p.FoodCostPercent > threshold
+}
@@ -0,0 +1,78 @@
+// Package recipe defines the Recipe entity: the inventory a menu item uses.
+package recipe
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+)
+
+type Recipe struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MenuItemID      uuid.UUID `gorm:"type:uuid;not null"`
+	Name            string    `gorm:"size:255;not null"`
+	Description     string    `gorm:"type:text"`
+	Instructions    string    `gorm:"type:text"`
+	PreparationTime *int      `gorm:""`
+	CookingTime     *int      `gorm:""`
+	ServingSize     int       `gorm:"default:1"`
+	YieldQuantity   *float64  `gorm:"type:decimal(10,2)"`
+	YieldUnit       string    `gorm:"size:50"`
+	Notes           string    `gorm:"type:text"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+
+	MenuItem    *menu.MenuItem `gorm:"foreignKey:MenuItemID"`
+	Ingredients []*Ingredient  `gorm:"foreignKey:RecipeID"`
+}
+
+// Ingredient quantities are for the whole recipe, i.e. ServingSize portions.
+type Ingredient struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RecipeID        uuid.UUID  `gorm:"type:uuid;not null"`
+	InventoryItemID uuid.UUID  `gorm:"type:uuid;not null"`
+	Quantity        float64    `gorm:"type:decimal(12,3);not null"`
+	UnitOfMeasureID *uuid.UUID `gorm:"type:uuid"`
+	IngredientOrder int        `gorm:"default:0"`
+	IsOptional      bool       `gorm:"default:false"`
+	Notes           string     `gorm:"type:text"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+
+	Item *inventory.Item `gorm:"foreignKey:InventoryItemID"`
+	Unit *inventory.Unit `gorm:"foreignKey:UnitOfMeasureID"`
+}
+
+// NewRecipe is a Factory Function that ensures a Recipe
+// is always created with a valid ID and default business state.
+func NewRecipe(menuItemID uuid.UUID, name string) *Recipe {
+	return &Recipe{
+		ID:          uuid.New(),
+		MenuItemID:  menuItemID,
+		Name:        name,
+		ServingSize: 1,
+	}
+}
+
+func (Ingredient) TableName() string {
+	return "recipe_ingredients"
+}
+
+// Components returns what a single portion takes out of stock.
+func (r *Recipe) Components() []inventory.Component {
+	servings := float64(r.ServingSize)
+	if servings <= 0 {
+		servings = 1
+	}
+	components := make([]inventory.Component, 0, len(r.Ingredients))
+	for _, ing := range r.Ingredients {
+		components = append(components, inventory.Component{
+			InventoryItemID: ing.InventoryItemID,
+			Quantity:        ing.Quantity / servings,
+			UnitID:          ing.UnitOfMeasureID,
+			Optional:        ing.IsOptional,
+		})
+	}
+	return components
+}
@@ -0,0 +1,64 @@
+package recipe
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+func TestRecipeComponents(t *testing.T) {
+	flour, salt := uuid.New(), uuid.New()
+	gram := uuid.New()
+	ingredients := []*Ingredient{
+		{InventoryItemID: flour, Quantity: 1, UnitOfMeasureID: &gram},
+		{InventoryItemID: salt, Quantity: 0.02, IsOptional: true},
+	}
+
+	tests := []struct {
+		name        string
+		servingSize int
+		want        []inventory.Component
+	}{
+		{
+			name:        "quantities are divided by the serving size",
+			servingSize: 4,
+			want: []inventory.Component{
+				{InventoryItemID: flour, Quantity: 0.25, UnitID: &gram},
+				{InventoryItemID: salt, Quantity: 0.005, Optional: true},
+			},
+		},
+		{
+			name:        "single serving",
+			servingSize: 1,
+			want: []inventory.Component{
+				{InventoryItemID: flour, Quantity: 1, UnitID: &gram},
+				{InventoryItemID: salt, Quantity: 0.02, Optional: true},
+			},
+		},
+		{
+			name:        "missing serving size counts as one",
+			servingSize: 0,
+			want: []inventory.Component{
+				{InventoryItemID: flour, Quantity: 1, UnitID: &gram},
+				{InventoryItemID: salt, Quantity: 0.02, Optional: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Recipe{ServingSize: tt.servingSize, Ingredients: ingredients}
+			got := r.Components()
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d components, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				c := got[i]
+				if c.InventoryItemID != want.InventoryItemID || c.Quantity != want.Quantity ||
+					c.Optional != want.Optional || c.UnitID != want.UnitID {
+					t.Errorf("component %d = %+v, want %+v", i, c, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package recipe
+
+import "errors"
+
+var (
+	ErrRecipeNotFound       = errors.New("recipe not found")
+	ErrDuplicateIngredient  = errors.New("an inventory item can only appear once in a recipe")
+	ErrIngredientWrongVenue = errors.New("ingredient belongs to another restaurant")
+	ErrIngredientNotCosted  = errors.New("ingredient is not loaded with its inventory item")
+)
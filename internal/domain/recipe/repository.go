@@ -0,0 +1,22 @@
+// Package recipe defines the domain model and repository interface for managing recipes.
+package recipe
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Recipes are loaded with their menu item and with ingredients ordered by
+// IngredientOrder, each carrying its inventory item and unit.
+type Repository interface {
+	// GetByMenuItem returns the menu item's recipe, or nil if it has none.
+	GetByMenuItem(ctx context.Context, menuItemID uuid.UUID) (*Recipe, error)
+	// ListByMenuItems returns the recipes of the given menu items. Menu items
+	// without a recipe are simply absent.
+	ListByMenuItems(ctx context.Context, menuItemIDs []uuid.UUID) ([]*Recipe, error)
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*Recipe, error)
+	// Save creates the recipe or updates it, replacing all its ingredients.
+	Save(ctx context.Context, recipe *Recipe) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
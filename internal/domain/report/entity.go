@@ -0,0 +1,166 @@
+// Package report defines the read-only reports restaurant managers pull:
+// sales over a period cut by day, hour, item, category and order type,
+// cancellations, the value and usage of inventory, and menu engineering.
+// Rows come from the reporting views and are never written back.
+package report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Period is the range a report covers, [From, To).
+type Period struct {
+	RestaurantID uuid.UUID
+	From         time.Time
+	To           time.Time
+}
+
+// Sales sums orders that weren't cancelled. Items counts units ordered;
+// Subtotal is before tax and fees and Discount is taken off it.
+type Sales struct {
+	Orders   int
+	Items    int
+	Subtotal float64
+	Discount float64
+	Total    float64
+}
+
+// AverageOrderValue is the mean order total, zero without orders.
+func (s Sales) AverageOrderValue() float64 {
+	if s.Orders == 0 {
+		return 0
+	}
+	return money.Round(s.Total / float64(s.Orders))
+}
+
+func (s *Sales) Add(o Sales) {
+	s.Orders += o.Orders
+	s.Items += o.Items
+	s.Subtotal = money.Sum(s.Subtotal, o.Subtotal)
+	s.Discount = money.Sum(s.Discount, o.Discount)
+	s.Total = money.Sum(s.Total, o.Total)
+}
+
+// DailySales is one day of sales, by the date orders were placed.
+type DailySales struct {
+	Day time.Time
+	Sales
+}
+
+// HourlySales is the sales placed in one hour of the day (0-23), summed
+// over every day of the period.
+type HourlySales struct {
+	Hour int
+	Sales
+}
+
+// ItemSales is what a menu item sold. Revenue sums the order lines before
+// order-level discounts, which can't be split across items.
+type ItemSales struct {
+	MenuItemID   uuid.UUID
+	ItemName     string
+	CategoryID   *uuid.UUID
+	CategoryName string
+	Quantity     int
+	Orders       int
+	Revenue      float64
+}
+
+// CategorySales is ItemSales summed over a menu category. Items without a
+// category share a row with a nil CategoryID.
+type CategorySales struct {
+	CategoryID   *uuid.UUID
+	CategoryName string
+	Quantity     int
+	Orders       int
+	Revenue      float64
+}
+
+// OrderTypeSales is the sales of one order type.
+type OrderTypeSales struct {
+	OrderType string
+	Sales
+}
+
+// OrderSummary is the period's sales overall and per order type.
+type OrderSummary struct {
+	Period
+	Totals Sales
+	Types  []*OrderTypeSales
+}
+
+// Share is the fraction of the period's orders of the type.
+func (s *OrderSummary) Share(t *OrderTypeSales) float64 {
+	if s.Totals.Orders == 0 {
+		return 0
+	}
+	return float64(t.Orders) / float64(s.Totals.Orders)
+}
+
+// CancellationReason counts orders cancelled for one reason; orders
+// cancelled without one share a row with an empty Reason. Value is the
+// total of the orders lost.
+type CancellationReason struct {
+	Reason string
+	Orders int
+	Value  float64
+}
+
+// Cancellations compares the orders placed in the period with those of
+// them that were cancelled.
+type Cancellations struct {
+	Period
+	Placed    int
+	Cancelled int
+	Value     float64
+	Reasons   []*CancellationReason
+}
+
+// Rate is the fraction of orders placed that were cancelled.
+func (c *Cancellations) Rate() float64 {
+	if c.Placed == 0 {
+		return 0
+	}
+	return float64(c.Cancelled) / float64(c.Placed)
+}
+
+// StockValue is an active item's stock at average cost, as it is now.
+// LowStock is set when it is at or below its minimum.
+type StockValue struct {
+	InventoryItemID uuid.UUID
+	Name            string
+	CurrentStock    float64
+	AverageCost     float64
+	TotalValue      float64
+	Unit            string
+	CategoryName    string
+	SupplierName    string
+	LowStock        bool
+}
+
+// LowStockItem is an active item at or below its minimum stock.
+type LowStockItem struct {
+	InventoryItemID uuid.UUID
+	Name            string
+	CurrentStock    float64
+	MinimumStock    float64
+	ReorderPoint    *float64
+	ReorderQuantity *float64
+	Unit            string
+	SupplierName    string
+	SupplierPhone   string
+}
+
+// Usage is what the kitchen used of an item on a day, in the unit it was
+// recorded in.
+type Usage struct {
+	Day             time.Time
+	InventoryItemID uuid.UUID
+	ItemName        string
+	Unit            string
+	Used            float64
+	Cost            float64
+}
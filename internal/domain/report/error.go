@@ -0,0 +1,8 @@
+package report
+
+import "errors"
+
+var (
+	ErrInvalidPeriod = errors.New("report period must end after it starts")
+	ErrPeriodTooLong = errors.New("report period cannot be longer than a year")
+)
@@ -0,0 +1,175 @@
+package report
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Quadrant is where menu engineering places an item by its popularity and
+// contribution margin.
+type Quadrant string
+
+const (
+	// QuadrantStar sells well and earns well
+	QuadrantStar Quadrant = "star"
+	// QuadrantPlowhorse sells well but earns little per plate
+	QuadrantPlowhorse Quadrant = "plowhorse"
+	// QuadrantPuzzle earns well per plate but doesn't sell
+	QuadrantPuzzle Quadrant = "puzzle"
+	// QuadrantDog neither sells nor earns
+	QuadrantDog Quadrant = "dog"
+	// QuadrantUncosted has no recipe, so its margin is unknown
+	QuadrantUncosted Quadrant = "uncosted"
+)
+
+// PopularityFactor is the 70% rule: an item is popular when it sells at
+// least 70% of an equal share of the units sold.
+const PopularityFactor = 0.7
+
+// actions are what the ops team is advised to try for each quadrant
+var actions = map[Quadrant][]string{
+	QuadrantStar: {
+		"Keep the recipe, portion and presentation consistent",
+		"Give it a prominent spot on the menu",
+		"Test a small price increase",
+	},
+	QuadrantPlowhorse: {
+		"Raise the price gradually",
+		"Lower the plate cost by adjusting portions or ingredients",
+		"Pair it with high-margin sides or drinks",
+		"Move it to a less prominent spot on the menu",
+	},
+	QuadrantPuzzle: {
+		"Move it to a more prominent spot on the menu",
+		"Rename it or rewrite its description",
+		"Have staff recommend it",
+		"Consider lowering the price",
+	},
+	QuadrantDog: {
+		"Remove it from the menu",
+		"Rework it into a new dish with a better margin",
+		"Keep it only if it fills a gap, such as a dietary option",
+	},
+	QuadrantUncosted: {
+		"Add a recipe so its plate cost and margin can be worked out",
+	},
+}
+
+// EngineeredItem is one menu item's sales and margin over the period.
+// SellingPrice is the average it sold for before order-level discounts,
+// or its current price when it didn't sell. PlateCost and
+// ContributionMargin, per plate, are nil without a recipe.
+type EngineeredItem struct {
+	MenuItem           *menu.MenuItem
+	Quantity           int
+	Revenue            float64
+	SellingPrice       float64
+	PlateCost          *float64
+	ContributionMargin *float64
+	// TotalMargin is ContributionMargin times Quantity
+	TotalMargin float64
+	// MenuMix is the item's share of units sold
+	MenuMix float64
+	// PopularityIndex is MenuMix over an equal share; 1 means the item
+	// sold exactly its share.
+	PopularityIndex float64
+	Quadrant        Quadrant
+	Actions         []string
+}
+
+// MenuEngineering classifies a restaurant's menu over a period. Items are
+// popular at a PopularityIndex of PopularityFactor or more, and earn well
+// at a ContributionMargin of AverageMargin or more.
+type MenuEngineering struct {
+	Period
+	Items         []*EngineeredItem
+	Quantity      int
+	Revenue       float64
+	TotalMargin   float64
+	AverageMargin float64
+}
+
+// EngineerMenu classifies every item on the menu, sold or not. sales may
+// come in any order; plateCosts holds the cost of one plate of each item
+// with a recipe. Plate costs are today's, so margins of a long period are
+// only as good as costs were steady. Items are returned by total margin,
+// highest first.
+func EngineerMenu(
+	p Period, items []*menu.MenuItem, sales []*ItemSales, plateCosts map[uuid.UUID]float64,
+) *MenuEngineering {
+	sold := make(map[uuid.UUID]*ItemSales, len(sales))
+	for _, s := range sales {
+		sold[s.MenuItemID] = s
+	}
+
+	me := &MenuEngineering{Period: p, Items: make([]*EngineeredItem, 0, len(items))}
+	var costedQty, costedCount int
+	var marginSum float64
+	for _, mi := range items {
+		e := &EngineeredItem{MenuItem: mi, SellingPrice: mi.EffectivePrice()}
+		if s := sold[mi.ID]; s != nil && s.Quantity > 0 {
+			e.Quantity = s.Quantity
+			e.Revenue = s.Revenue
+			e.SellingPrice = money.Round(s.Revenue / float64(s.Quantity))
+		}
+		if cost, ok := plateCosts[mi.ID]; ok {
+			margin := money.Round(e.SellingPrice - cost)
+			e.PlateCost, e.ContributionMargin = &cost, &margin
+			e.TotalMargin = money.Round(margin * float64(e.Quantity))
+			costedQty += e.Quantity
+			costedCount++
+			marginSum += margin
+		}
+		me.Quantity += e.Quantity
+		me.Revenue = money.Sum(me.Revenue, e.Revenue)
+		me.TotalMargin = money.Sum(me.TotalMargin, e.TotalMargin)
+		me.Items = append(me.Items, e)
+	}
+
+	// The average is weighted by units sold; with nothing sold every costed
+	// item counts once
+	switch {
+	case costedQty > 0:
+		me.AverageMargin = money.Round(me.TotalMargin / float64(costedQty))
+	case costedCount > 0:
+		me.AverageMargin = money.Round(marginSum / float64(costedCount))
+	}
+
+	for _, e := range me.Items {
+		if me.Quantity > 0 {
+			e.MenuMix = float64(e.Quantity) / float64(me.Quantity)
+			e.PopularityIndex = e.MenuMix * float64(len(me.Items))
+		}
+		e.Quadrant = me.classify(e)
+		e.Actions = actions[e.Quadrant]
+	}
+
+	sort.SliceStable(me.Items, func(a, b int) bool {
+		if me.Items[a].TotalMargin != me.Items[b].TotalMargin {
+			return me.Items[a].TotalMargin > me.Items[b].TotalMargin
+		}
+		return me.Items[a].MenuItem.Name < me.Items[b].MenuItem.Name
+	})
+	return me
+}
+
+func (me *MenuEngineering) classify(e *EngineeredItem) Quadrant {
+	if e.ContributionMargin == nil {
+		return QuadrantUncosted
+	}
+	popular := e.PopularityIndex >= PopularityFactor
+	earns := *e.ContributionMargin >= me.AverageMargin
+	switch {
+	case popular && earns:
+		return QuadrantStar
+	case popular:
+		return QuadrantPlowhorse
+	case earns:
+		return QuadrantPuzzle
+	default:
+		return QuadrantDog
+	}
+}
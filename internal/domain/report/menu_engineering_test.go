@@ -0,0 +1,140 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+)
+
+// dish is a menu item with what it sold and, when it has a recipe, its
+// plate cost.
+type dish struct {
+	name     string
+	price    float64
+	discount float64
+	sold     int
+	revenue  float64
+	cost     *float64
+}
+
+func cost(c float64) *float64 {
+	return &c
+}
+
+func TestEngineerMenu(t *testing.T) {
+	tests := []struct {
+		name          string
+		dishes        []dish
+		wantQuadrants map[string]Quadrant
+		// wantOrder is by total margin, highest first
+		wantOrder         []string
+		wantAverageMargin float64
+	}{
+		{
+			name: "one item in each quadrant",
+			dishes: []dish{
+				{name: "Salad", price: 8, sold: 5, revenue: 40, cost: cost(6)},
+				{name: "Burger", price: 10, sold: 40, revenue: 400, cost: cost(3)},
+				{name: "Soup", price: 6, sold: 10, revenue: 60},
+				{name: "Fries", price: 4, sold: 40, revenue: 160, cost: cost(2)},
+				{name: "Steak", price: 30, sold: 5, revenue: 150, cost: cost(18)},
+			},
+			wantQuadrants: map[string]Quadrant{
+				"Burger": QuadrantStar,
+				"Fries":  QuadrantPlowhorse,
+				"Steak":  QuadrantPuzzle,
+				"Salad":  QuadrantDog,
+				"Soup":   QuadrantUncosted,
+			},
+			wantOrder: []string{"Burger", "Fries", "Steak", "Salad", "Soup"},
+			// 430 of margin over the 90 costed units sold
+			wantAverageMargin: 4.78,
+		},
+		{
+			name: "selling exactly 70% of an equal share is popular",
+			dishes: []dish{
+				{name: "Tea", price: 3, sold: 13, revenue: 39, cost: cost(1)},
+				{name: "Coffee", price: 3, sold: 7, revenue: 21, cost: cost(1)},
+			},
+			wantQuadrants: map[string]Quadrant{
+				"Tea":    QuadrantStar,
+				"Coffee": QuadrantStar,
+			},
+			wantOrder:         []string{"Tea", "Coffee"},
+			wantAverageMargin: 2,
+		},
+		{
+			name: "margin is taken from the average selling price",
+			dishes: []dish{
+				// Sold at 12 on average, so a margin of 7 against 5 for Wrap
+				{name: "Pizza", price: 10, sold: 10, revenue: 120, cost: cost(5)},
+				{name: "Wrap", price: 10, sold: 10, revenue: 100, cost: cost(5)},
+			},
+			wantQuadrants: map[string]Quadrant{
+				"Pizza": QuadrantStar,
+				"Wrap":  QuadrantPlowhorse,
+			},
+			wantOrder:         []string{"Pizza", "Wrap"},
+			wantAverageMargin: 6,
+		},
+		{
+			name: "nothing sold weighs every costed item once at its current price",
+			dishes: []dish{
+				// Discounted to 7, so a margin of 3 against 6 for Curry
+				{name: "Pie", price: 9, discount: 7, cost: cost(4)},
+				{name: "Curry", price: 10, cost: cost(4)},
+				{name: "Bread", price: 2},
+			},
+			wantQuadrants: map[string]Quadrant{
+				"Curry": QuadrantPuzzle,
+				"Pie":   QuadrantDog,
+				"Bread": QuadrantUncosted,
+			},
+			wantOrder:         []string{"Bread", "Curry", "Pie"},
+			wantAverageMargin: 4.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				items []*menu.MenuItem
+				sales []*ItemSales
+				costs = make(map[uuid.UUID]float64)
+			)
+			for _, d := range tt.dishes {
+				mi := &menu.MenuItem{ID: uuid.New(), Name: d.name, Price: d.price}
+				if d.discount > 0 {
+					mi.DiscountPrice = &d.discount
+				}
+				items = append(items, mi)
+				if d.sold > 0 {
+					sales = append(sales, &ItemSales{MenuItemID: mi.ID, ItemName: d.name, Quantity: d.sold, Revenue: d.revenue})
+				}
+				if d.cost != nil {
+					costs[mi.ID] = *d.cost
+				}
+			}
+
+			me := EngineerMenu(Period{}, items, sales, costs)
+
+			if me.AverageMargin != tt.wantAverageMargin {
+				t.Errorf("AverageMargin = %v, want %v", me.AverageMargin, tt.wantAverageMargin)
+			}
+			var order []string
+			for _, e := range me.Items {
+				order = append(order, e.MenuItem.Name)
+				if want := tt.wantQuadrants[e.MenuItem.Name]; e.Quadrant != want {
+					t.Errorf("%s is a %s, want %s", e.MenuItem.Name, e.Quadrant, want)
+				}
+				if !reflect.DeepEqual(e.Actions, actions[e.Quadrant]) {
+					t.Errorf("%s has the actions of another quadrant", e.MenuItem.Name)
+				}
+			}
+			if !reflect.DeepEqual(order, tt.wantOrder) {
+				t.Errorf("order = %v, want %v", order, tt.wantOrder)
+			}
+		})
+	}
+}
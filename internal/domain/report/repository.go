@@ -0,0 +1,32 @@
+package report
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository reads the reporting views. Sales leave out cancelled orders
+// and are dated by when orders were placed.
+type Repository interface {
+	// SalesByDay returns a row per day with orders, oldest first.
+	SalesByDay(ctx context.Context, p Period) ([]*DailySales, error)
+	// SalesByHour returns a row per hour of the day with orders.
+	SalesByHour(ctx context.Context, p Period) ([]*HourlySales, error)
+	// SalesByItem returns the menu items sold, best selling first.
+	SalesByItem(ctx context.Context, p Period) ([]*ItemSales, error)
+	// SalesByCategory returns the menu categories sold, best selling first.
+	SalesByCategory(ctx context.Context, p Period) ([]*CategorySales, error)
+	// SalesByOrderType returns a row per order type with orders.
+	SalesByOrderType(ctx context.Context, p Period) ([]*OrderTypeSales, error)
+	// Cancellations counts the orders placed in the period and those of
+	// them cancelled, by reason, most frequent first.
+	Cancellations(ctx context.Context, p Period) (*Cancellations, error)
+
+	// InventoryValue returns the restaurant's active items by name.
+	InventoryValue(ctx context.Context, restaurantID uuid.UUID) ([]*StockValue, error)
+	// LowStock returns the restaurant's items at or below minimum by name.
+	LowStock(ctx context.Context, restaurantID uuid.UUID) ([]*LowStockItem, error)
+	// Usage returns usage per item and day, oldest day first.
+	Usage(ctx context.Context, p Period) ([]*Usage, error)
+}
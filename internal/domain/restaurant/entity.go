@@ -28,12 +28,19 @@ type Restaurant struct {
 	DeliveryFee           float64   `gorm:"type:decimal(10,2);default:0.00"`
 	MinimumOrder          float64   `gorm:"type:decimal(10,2);default:0.00"`
 	EstimatedDeliveryTime int       `gorm:"default:30"`
-	LogoURL               string    `gorm:"type:text"`
-	BannerURL             string    `gorm:"type:text"`
-	Rating                float64   `gorm:"type:decimal(3,2);default:0.00"`
-	TotalReviews          int       `gorm:"default:0"`
-	CreatedAt             time.Time `gorm:"autoCreateTime"`
-	UpdatedAt             time.Time `gorm:"autoUpdateTime"`
+	PricesIncludeTax      bool      `gorm:"default:false"`
+	Timezone              string    `gorm:"size:64;default:'UTC'"`
+	PreorderSlotMinutes   int       `gorm:"default:15"`
+	PreorderSlotCapacity  int       `gorm:"default:10"`
+	PreorderMaxDays       int       `gorm:"default:7"`
+	// ValuationMethod is how inventory is costed, fifo or weighted_average
+	ValuationMethod string    `gorm:"type:valuation_method_enum;default:'weighted_average'"`
+	LogoURL         string    `gorm:"type:text"`
+	BannerURL       string    `gorm:"type:text"`
+	Rating          float64   `gorm:"type:decimal(3,2);default:0.00"`
+	TotalReviews    int       `gorm:"default:0"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
 }
 
 // NewRestaurant is a Factory Function that ensures a Restaurant
@@ -50,5 +57,50 @@ func NewRestaurant(name, slug string) *Restaurant {
 		DeliveryFee:           0.00,
 		MinimumOrder:          0.00,
 		EstimatedDeliveryTime: 30,
+		Timezone:              "UTC",
+		PreorderSlotMinutes:   15,
+		PreorderSlotCapacity:  10,
+		PreorderMaxDays:       7,
+		ValuationMethod:       "weighted_average",
 	}
 }
+
+// Location returns the restaurant's time zone, falling back to UTC.
+func (r *Restaurant) Location() *time.Location {
+	if r.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// IsOpenAt reports whether t falls within the opening hours, evaluated in the
+// restaurant's time zone. Hours that wrap past midnight (e.g. 18:00-02:00)
+// are supported.
+func (r *Restaurant) IsOpenAt(t time.Time) bool {
+	opening, okOpen := clockMinutes(r.OpeningTime)
+	closing, okClose := clockMinutes(r.ClosingTime)
+	if !okOpen || !okClose {
+		return false
+	}
+
+	local := t.In(r.Location())
+	now := local.Hour()*60 + local.Minute()
+	if closing > opening {
+		return now >= opening && now < closing
+	}
+	return now >= opening || now < closing
+}
+
+// clockMinutes parses "15:04" or "15:04:05" into minutes since midnight.
+func clockMinutes(clock string) (int, bool) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, clock); err == nil {
+			return t.Hour()*60 + t.Minute(), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,71 @@
+// Package review defines customer reviews of delivered orders: a rating of
+// the restaurant with optional ratings of the dishes, the restaurant's
+// reply, and moderation. Visible reviews make up the restaurant's rating.
+package review
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+)
+
+// Status mirrors review_status_enum.
+type Status string
+
+const (
+	StatusVisible Status = "visible"
+	StatusHidden  Status = "hidden"
+)
+
+// Ratings are whole stars from MinRating to MaxRating.
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+type Review struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID uuid.UUID  `gorm:"type:uuid;not null"`
+	OrderID      uuid.UUID  `gorm:"type:uuid;not null;unique"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null"`
+	Rating       int        `gorm:"not null"`
+	Comment      string     `gorm:"type:text"`
+	Status       Status     `gorm:"type:review_status_enum;default:'visible'"`
+	Reply        string     `gorm:"type:text"`
+	RepliedBy    *uuid.UUID `gorm:"type:uuid"`
+	RepliedAt    *time.Time
+	// ModerationReason says why an admin hid the review
+	ModerationReason string     `gorm:"type:text"`
+	ModeratedBy      *uuid.UUID `gorm:"type:uuid"`
+	ModeratedAt      *time.Time
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime"`
+
+	Items []*ItemRating `gorm:"foreignKey:ReviewID"`
+}
+
+// ItemRating rates one dish of the reviewed order.
+type ItemRating struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ReviewID    uuid.UUID `gorm:"type:uuid;not null"`
+	OrderItemID uuid.UUID `gorm:"type:uuid;not null"`
+	MenuItemID  uuid.UUID `gorm:"type:uuid;not null"`
+	Rating      int       `gorm:"not null"`
+	Comment     string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+
+	OrderItem *order.Item `gorm:"foreignKey:OrderItemID"`
+}
+
+func (ItemRating) TableName() string {
+	return "review_item_ratings"
+}
+
+// Summary is a restaurant's visible reviews: the average rating, how many
+// there are and how many gave each number of stars.
+type Summary struct {
+	Rating       float64
+	TotalReviews int
+	Stars        map[int]int
+}
@@ -0,0 +1,16 @@
+package review
+
+import "errors"
+
+var (
+	ErrReviewNotFound          = errors.New("review not found")
+	ErrAlreadyReviewed         = errors.New("order has already been reviewed")
+	ErrOrderNotDelivered       = errors.New("only delivered orders can be reviewed")
+	ErrInvalidRating           = errors.New("rating must be a whole number from 1 to 5")
+	ErrItemNotInOrder          = errors.New("rated item is not on the order")
+	ErrItemRatedTwice          = errors.New("each order item can only be rated once")
+	ErrEmptyReply              = errors.New("reply cannot be empty")
+	ErrAlreadyHidden           = errors.New("review is already hidden")
+	ErrNotHidden               = errors.New("review is not hidden")
+	ErrModerationReasonMissing = errors.New("give a reason for hiding the review")
+)
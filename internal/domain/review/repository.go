@@ -0,0 +1,38 @@
+package review
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Filter narrows ListByRestaurant. Zero values match everything.
+type Filter struct {
+	Status Status
+	Rating int
+	Limit  int
+	Offset int
+}
+
+// Reviews are loaded with their item ratings, each carrying its order item.
+type Repository interface {
+	// Create persists the review together with its item ratings.
+	Create(ctx context.Context, r *Review) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Review, error)
+	// LockByID locks the review until the surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Review, error)
+	// GetByOrder returns the order's review, or nil if it has none.
+	GetByOrder(ctx context.Context, orderID uuid.UUID) (*Review, error)
+	// ListByRestaurant returns the restaurant's reviews, newest first.
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, filter Filter) ([]*Review, error)
+	// Update saves the review; item ratings don't change once given.
+	Update(ctx context.Context, r *Review) error
+
+	// RefreshRestaurantRating recomputes the restaurant's rating and
+	// total_reviews from its visible reviews. It locks the restaurant first,
+	// so it must run in the transaction that changed the reviews: two
+	// transactions then can't each miss the other's review.
+	RefreshRestaurantRating(ctx context.Context, restaurantID uuid.UUID) error
+	// Summarize counts the restaurant's visible reviews by stars.
+	Summarize(ctx context.Context, restaurantID uuid.UUID) (*Summary, error)
+}
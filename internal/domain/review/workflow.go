@@ -0,0 +1,108 @@
+package review
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+)
+
+// ItemInput rates one line of the order being reviewed.
+type ItemInput struct {
+	OrderItemID uuid.UUID
+	Rating      int
+	Comment     string
+}
+
+// NewReview reviews a delivered order. Checking the order is the
+// reviewer's, and not yet reviewed, is left to the caller.
+func NewReview(o *order.Order, rating int, comment string, items []ItemInput) (*Review, error) {
+	if o.Status != order.StatusDelivered {
+		return nil, ErrOrderNotDelivered
+	}
+	if !validRating(rating) {
+		return nil, ErrInvalidRating
+	}
+
+	r := &Review{
+		ID:           uuid.New(),
+		RestaurantID: o.RestaurantID,
+		OrderID:      o.ID,
+		UserID:       *o.UserID,
+		Rating:       rating,
+		Comment:      strings.TrimSpace(comment),
+		Status:       StatusVisible,
+	}
+	lines := make(map[uuid.UUID]*order.Item, len(o.Items))
+	for _, line := range o.Items {
+		lines[line.ID] = line
+	}
+	rated := make(map[uuid.UUID]bool, len(items))
+	for _, in := range items {
+		line := lines[in.OrderItemID]
+		if line == nil {
+			return nil, ErrItemNotInOrder
+		}
+		if rated[line.ID] {
+			return nil, ErrItemRatedTwice
+		}
+		if !validRating(in.Rating) {
+			return nil, ErrInvalidRating
+		}
+		rated[line.ID] = true
+		r.Items = append(r.Items, &ItemRating{
+			ID:          uuid.New(),
+			ReviewID:    r.ID,
+			OrderItemID: line.ID,
+			MenuItemID:  line.MenuItemID,
+			Rating:      in.Rating,
+			Comment:     strings.TrimSpace(in.Comment),
+			OrderItem:   line,
+		})
+	}
+	return r, nil
+}
+
+// Respond sets the restaurant's public reply, replacing an earlier one.
+func (r *Review) Respond(reply string, by uuid.UUID, at time.Time) error {
+	reply = strings.TrimSpace(reply)
+	if reply == "" {
+		return ErrEmptyReply
+	}
+	r.Reply = reply
+	r.RepliedBy = &by
+	r.RepliedAt = &at
+	return nil
+}
+
+// Hide takes the review out of listings and the restaurant's rating.
+func (r *Review) Hide(reason string, by uuid.UUID, at time.Time) error {
+	if r.Status == StatusHidden {
+		return ErrAlreadyHidden
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrModerationReasonMissing
+	}
+	r.Status = StatusHidden
+	r.ModerationReason = reason
+	r.ModeratedBy = &by
+	r.ModeratedAt = &at
+	return nil
+}
+
+// Restore shows a hidden review again. The moderation reason is kept.
+func (r *Review) Restore(by uuid.UUID, at time.Time) error {
+	if r.Status != StatusHidden {
+		return ErrNotHidden
+	}
+	r.Status = StatusVisible
+	r.ModeratedBy = &by
+	r.ModeratedAt = &at
+	return nil
+}
+
+func validRating(rating int) bool {
+	return rating >= MinRating && rating <= MaxRating
+}
@@ -0,0 +1,115 @@
+// Package scan defines barcode scan sessions: staff scan what arrives
+// against a purchase order, or what is on the shelf for a stocktake, and
+// commit the session to book it. Barcodes no item carries are queued for
+// someone to map.
+package scan
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+// Type mirrors scan_session_type_enum.
+type Type string
+
+const (
+	TypeReceiving Type = "receiving"
+	TypeCount     Type = "count"
+)
+
+// Status mirrors scan_session_status_enum.
+type Status string
+
+const (
+	StatusOpen      Status = "open"
+	StatusCommitted Status = "committed"
+	StatusCancelled Status = "cancelled"
+)
+
+// UnknownStatus mirrors unknown_barcode_status_enum.
+type UnknownStatus string
+
+const (
+	UnknownPending   UnknownStatus = "pending"
+	UnknownMapped    UnknownStatus = "mapped"
+	UnknownDismissed UnknownStatus = "dismissed"
+)
+
+// Session collects scans for a purchase order (receiving) or a stocktake
+// (count). Scans only add up lines; committing books them.
+type Session struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID    uuid.UUID  `gorm:"type:uuid;not null"`
+	Type            Type       `gorm:"type:scan_session_type_enum;not null"`
+	PurchaseOrderID *uuid.UUID `gorm:"type:uuid"`
+	StocktakeID     *uuid.UUID `gorm:"type:uuid"`
+	Status          Status     `gorm:"type:scan_session_status_enum;default:'open'"`
+	Notes           string     `gorm:"type:text"`
+	StartedBy       *uuid.UUID `gorm:"type:uuid"`
+	CommittedBy     *uuid.UUID `gorm:"type:uuid"`
+	CommittedAt     *time.Time
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+
+	Lines []*Line `gorm:"foreignKey:ScanSessionID"`
+	// Unknown holds the session's scans still waiting to be mapped
+	Unknown []*UnknownBarcode `gorm:"foreignKey:ScanSessionID"`
+}
+
+func (Session) TableName() string {
+	return "scan_sessions"
+}
+
+// Line is what has been scanned of an item, in the item's unit, for one
+// batch and expiry date.
+type Line struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ScanSessionID   uuid.UUID  `gorm:"type:uuid;not null"`
+	InventoryItemID uuid.UUID  `gorm:"type:uuid;not null"`
+	BatchNumber     string     `gorm:"size:100"`
+	ExpiryDate      *time.Time `gorm:"type:date"`
+	Quantity        float64    `gorm:"type:decimal(12,3);not null"`
+	Scans           int        `gorm:"not null"`
+	LastScannedBy   *uuid.UUID `gorm:"type:uuid"`
+	LastScannedAt   *time.Time
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+
+	Item *inventory.Item `gorm:"foreignKey:InventoryItemID"`
+}
+
+func (Line) TableName() string {
+	return "scan_session_lines"
+}
+
+// UnknownBarcode is a scan no item matched, kept with what the barcode said
+// so it can be booked once the barcode is mapped to an item.
+type UnknownBarcode struct {
+	ID              uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID    uuid.UUID     `gorm:"type:uuid;not null"`
+	ScanSessionID   *uuid.UUID    `gorm:"type:uuid"`
+	Barcode         string        `gorm:"size:100;not null"`
+	RawCode         string        `gorm:"type:text;not null"`
+	BatchNumber     string        `gorm:"size:100"`
+	ExpiryDate      *time.Time    `gorm:"type:date"`
+	Quantity        *float64      `gorm:"type:decimal(12,3)"`
+	Status          UnknownStatus `gorm:"type:unknown_barcode_status_enum;default:'pending'"`
+	InventoryItemID *uuid.UUID    `gorm:"type:uuid"`
+	ScannedBy       *uuid.UUID    `gorm:"type:uuid"`
+	ResolvedBy      *uuid.UUID    `gorm:"type:uuid"`
+	ResolvedAt      *time.Time
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+func (UnknownBarcode) TableName() string {
+	return "unknown_barcodes"
+}
+
+// Outcome is what a scan did: added to Line, or queued as Unknown.
+type Outcome struct {
+	Code    gs1.Code
+	Line    *Line
+	Unknown *UnknownBarcode
+}
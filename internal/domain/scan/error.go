@@ -0,0 +1,21 @@
+package scan
+
+import "errors"
+
+var (
+	ErrSessionNotFound    = errors.New("scan session not found")
+	ErrInvalidType        = errors.New("scan session type must be receiving or count")
+	ErrMissingReference   = errors.New("receiving sessions need a purchase_order_id and count sessions a stocktake_id")
+	ErrNotOpen            = errors.New("only open scan sessions can be scanned into")
+	ErrNothingScanned     = errors.New("scan at least one item before committing")
+	ErrUnmappedScans      = errors.New("map or dismiss the unknown barcodes scanned in this session before committing")
+	ErrItemWrongVenue     = errors.New("inventory item belongs to another restaurant")
+	ErrItemHasBarcode     = errors.New("inventory item already has another barcode; set replace to overwrite it")
+	ErrItemNotExpected    = errors.New("inventory item is not on the purchase order or stocktake being scanned")
+	ErrBelowZero          = errors.New("cannot take back more than has been scanned")
+	ErrBarcodeNotFound    = errors.New("no inventory item has this barcode")
+	ErrUnknownNotFound    = errors.New("unknown barcode not found")
+	ErrUnknownResolved    = errors.New("unknown barcode has already been mapped or dismissed")
+	ErrPurchaseOrderVenue = errors.New("purchase order belongs to another restaurant")
+	ErrStocktakeVenue     = errors.New("stocktake belongs to another restaurant")
+)
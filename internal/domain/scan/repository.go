@@ -0,0 +1,37 @@
+package scan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Filter narrows ListByRestaurant. Zero values match everything.
+type Filter struct {
+	Status Status
+	Type   Type
+}
+
+// Sessions are loaded with their lines, each carrying its inventory item
+// and unit.
+type Repository interface {
+	Create(ctx context.Context, s *Session) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Session, error)
+	// LockByID locks the session until the surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Session, error)
+	// ListByRestaurant returns the restaurant's sessions, newest first.
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, filter Filter) ([]*Session, error)
+	// Update saves the session and the current state of its lines.
+	Update(ctx context.Context, s *Session) error
+
+	CreateUnknown(ctx context.Context, u *UnknownBarcode) error
+	GetUnknown(ctx context.Context, id uuid.UUID) (*UnknownBarcode, error)
+	// ListUnknown returns the restaurant's unknown barcodes in the status,
+	// or all of them when it is empty, newest first.
+	ListUnknown(ctx context.Context, restaurantID uuid.UUID, status UnknownStatus) ([]*UnknownBarcode, error)
+	// LockPendingUnknown returns every pending scan of the barcode at the
+	// restaurant, oldest first, locked until the surrounding transaction
+	// ends.
+	LockPendingUnknown(ctx context.Context, restaurantID uuid.UUID, barcode string) ([]*UnknownBarcode, error)
+	UpdateUnknown(ctx context.Context, u *UnknownBarcode) error
+}
@@ -0,0 +1,178 @@
+package scan
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+// NewSession opens a session scanning against a purchase order (receiving)
+// or a stocktake (count); referenceID is the one or the other.
+func NewSession(restaurantID uuid.UUID, t Type, referenceID uuid.UUID, notes string, by uuid.UUID) (*Session, error) {
+	s := &Session{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		Type:         t,
+		Status:       StatusOpen,
+		Notes:        notes,
+		StartedBy:    &by,
+	}
+	switch t {
+	case TypeReceiving:
+		s.PurchaseOrderID = &referenceID
+	case TypeCount:
+		s.StocktakeID = &referenceID
+	default:
+		return nil, ErrInvalidType
+	}
+	return s, nil
+}
+
+// Scan adds qty of item, in the item's unit, to the line for its batch and
+// expiry date. A negative qty takes back a scan made by mistake.
+func (s *Session) Scan(
+	item *inventory.Item, qty float64, batch string, expiry *time.Time, by uuid.UUID, at time.Time,
+) (*Line, error) {
+	if s.Status != StatusOpen {
+		return nil, ErrNotOpen
+	}
+	qty = inventory.RoundQty(qty)
+	if qty == 0 {
+		return nil, inventory.ErrNonPositiveQuantity
+	}
+
+	line := s.line(item.ID, batch, expiry)
+	if line == nil {
+		if qty < 0 {
+			return nil, ErrBelowZero
+		}
+		line = &Line{
+			ID:              uuid.New(),
+			ScanSessionID:   s.ID,
+			InventoryItemID: item.ID,
+			BatchNumber:     batch,
+			ExpiryDate:      expiry,
+		}
+		s.Lines = append(s.Lines, line)
+	}
+	total := inventory.RoundQty(line.Quantity + qty)
+	if total < 0 {
+		return nil, ErrBelowZero
+	}
+	line.Quantity = total
+	if qty > 0 {
+		line.Scans++
+	} else {
+		line.Scans--
+	}
+	line.LastScannedBy = &by
+	line.LastScannedAt = &at
+	line.Item = item
+	return line, nil
+}
+
+// Totals sums the scanned quantity per item over batches.
+func (s *Session) Totals() map[uuid.UUID]float64 {
+	totals := make(map[uuid.UUID]float64, len(s.Lines))
+	for _, l := range s.Lines {
+		totals[l.InventoryItemID] = inventory.RoundQty(totals[l.InventoryItemID] + l.Quantity)
+	}
+	return totals
+}
+
+// Commit closes the session on behalf of by. The caller books the lines.
+// Scans of unknown barcodes must be mapped or dismissed first, so nothing
+// that was scanned is left out unnoticed.
+func (s *Session) Commit(by uuid.UUID, at time.Time) error {
+	if s.Status != StatusOpen {
+		return ErrNotOpen
+	}
+	for _, u := range s.Unknown {
+		if u.Status == UnknownPending {
+			return ErrUnmappedScans
+		}
+	}
+	scanned := false
+	for _, l := range s.Lines {
+		if l.Quantity > 0 {
+			scanned = true
+			break
+		}
+	}
+	if !scanned {
+		return ErrNothingScanned
+	}
+	s.Status = StatusCommitted
+	s.CommittedBy = &by
+	s.CommittedAt = &at
+	return nil
+}
+
+// Cancel drops an open session; nothing it scanned is booked.
+func (s *Session) Cancel() error {
+	if s.Status != StatusOpen {
+		return ErrNotOpen
+	}
+	s.Status = StatusCancelled
+	return nil
+}
+
+func (s *Session) line(itemID uuid.UUID, batch string, expiry *time.Time) *Line {
+	for _, l := range s.Lines {
+		if l.InventoryItemID == itemID && l.BatchNumber == batch && sameDate(l.ExpiryDate, expiry) {
+			return l
+		}
+	}
+	return nil
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Format(time.DateOnly) == b.Format(time.DateOnly)
+}
+
+// NewUnknownBarcode queues a scan no item matched. qty is what the scan was
+// for, if the scanner said.
+func NewUnknownBarcode(
+	restaurantID uuid.UUID, sessionID *uuid.UUID, code gs1.Code, qty *float64, by uuid.UUID,
+) *UnknownBarcode {
+	return &UnknownBarcode{
+		ID:            uuid.New(),
+		RestaurantID:  restaurantID,
+		ScanSessionID: sessionID,
+		Barcode:       code.Key(),
+		RawCode:       code.Raw,
+		BatchNumber:   code.Batch,
+		ExpiryDate:    code.Expiry,
+		Quantity:      qty,
+		Status:        UnknownPending,
+		ScannedBy:     &by,
+	}
+}
+
+// Map records that the barcode is item's, on behalf of by.
+func (u *UnknownBarcode) Map(itemID, by uuid.UUID, at time.Time) error {
+	if u.Status != UnknownPending {
+		return ErrUnknownResolved
+	}
+	u.Status = UnknownMapped
+	u.InventoryItemID = &itemID
+	u.ResolvedBy = &by
+	u.ResolvedAt = &at
+	return nil
+}
+
+// Dismiss drops a scan that wasn't stock, such as a delivery note.
+func (u *UnknownBarcode) Dismiss(by uuid.UUID, at time.Time) error {
+	if u.Status != UnknownPending {
+		return ErrUnknownResolved
+	}
+	u.Status = UnknownDismissed
+	u.ResolvedBy = &by
+	u.ResolvedAt = &at
+	return nil
+}
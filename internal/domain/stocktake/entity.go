@@ -0,0 +1,130 @@
+// Package stocktake defines physical stock counts: a manager opens a count,
+// staff record what is on the shelf, and an approver posts the variances as
+// inventory adjustments.
+package stocktake
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Status mirrors stocktake_status_enum.
+type Status string
+
+const (
+	StatusOpen      Status = "open"
+	StatusSubmitted Status = "submitted"
+	StatusApproved  Status = "approved"
+	StatusCancelled Status = "cancelled"
+)
+
+// Stocktake counts the active items of a restaurant, or of one of its
+// storage locations or ingredient categories.
+type Stocktake struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID    uuid.UUID  `gorm:"type:uuid;not null"`
+	StorageLocation string     `gorm:"size:255"`
+	CategoryID      *uuid.UUID `gorm:"type:uuid"`
+	Status          Status     `gorm:"type:stocktake_status_enum;default:'open'"`
+	Notes           string     `gorm:"type:text"`
+	OpenedBy        *uuid.UUID `gorm:"type:uuid"`
+	SubmittedBy     *uuid.UUID `gorm:"type:uuid"`
+	SubmittedAt     *time.Time
+	ApprovedBy      *uuid.UUID `gorm:"type:uuid"`
+	ApprovedAt      *time.Time
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+
+	Lines []*Line `gorm:"foreignKey:StocktakeID"`
+}
+
+// Line is one item to count. ExpectedQuantity and UnitCost are taken from
+// the item each time it is counted, so stock used while the count is under
+// way doesn't show up as variance.
+type Line struct {
+	ID               uuid.UUID                  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StocktakeID      uuid.UUID                  `gorm:"type:uuid;not null"`
+	InventoryItemID  uuid.UUID                  `gorm:"type:uuid;not null"`
+	ExpectedQuantity float64                    `gorm:"type:decimal(12,3);not null"`
+	CountedQuantity  *float64                   `gorm:"type:decimal(12,3)"`
+	UnitCost         float64                    `gorm:"type:decimal(10,2);default:0.00"`
+	Reason           inventory.AdjustmentReason `gorm:"type:adjustment_reason_enum;default:'miscounted'"`
+	CountedBy        *uuid.UUID                 `gorm:"type:uuid"`
+	CountedAt        *time.Time
+	AdjustmentID     *uuid.UUID `gorm:"type:uuid"`
+	Notes            string     `gorm:"type:text"`
+	CreatedAt        time.Time  `gorm:"autoCreateTime"`
+
+	Item *inventory.Item `gorm:"foreignKey:InventoryItemID"`
+}
+
+func (Line) TableName() string {
+	return "stocktake_lines"
+}
+
+// NewStocktake opens a count with one line per item, expecting each item's
+// current stock.
+func NewStocktake(
+	restaurantID uuid.UUID, location string, categoryID *uuid.UUID, openedBy uuid.UUID, items []*inventory.Item,
+) *Stocktake {
+	s := &Stocktake{
+		ID:              uuid.New(),
+		RestaurantID:    restaurantID,
+		StorageLocation: location,
+		CategoryID:      categoryID,
+		Status:          StatusOpen,
+		OpenedBy:        &openedBy,
+	}
+	for _, item := range items {
+		s.Lines = append(s.Lines, &Line{
+			ID:               uuid.New(),
+			StocktakeID:      s.ID,
+			InventoryItemID:  item.ID,
+			ExpectedQuantity: item.CurrentStock,
+			UnitCost:         item.AverageCost,
+			Reason:           inventory.ReasonMiscounted,
+			Item:             item,
+		})
+	}
+	return s
+}
+
+// Counted reports whether a quantity has been recorded for the line.
+func (l *Line) Counted() bool {
+	return l.CountedQuantity != nil
+}
+
+// Variance is counted minus expected: negative when stock is missing. It is
+// zero until the line is counted.
+func (l *Line) Variance() float64 {
+	if l.CountedQuantity == nil {
+		return 0
+	}
+	return inventory.RoundQty(*l.CountedQuantity - l.ExpectedQuantity)
+}
+
+// ValueImpact is the variance valued at the item's average cost.
+func (l *Line) ValueImpact() float64 {
+	return money.Round(l.Variance() * l.UnitCost)
+}
+
+// ValueImpact totals the value impact of every counted line.
+func (s *Stocktake) ValueImpact() float64 {
+	impacts := make([]float64, 0, len(s.Lines))
+	for _, l := range s.Lines {
+		impacts = append(impacts, l.ValueImpact())
+	}
+	return money.Sum(impacts...)
+}
+
+// Label names the stocktake in ledger entries and adjustments.
+func (s *Stocktake) Label() string {
+	label := "Stocktake of " + s.CreatedAt.Format(time.DateOnly)
+	if s.StorageLocation != "" {
+		label += " (" + s.StorageLocation + ")"
+	}
+	return label
+}
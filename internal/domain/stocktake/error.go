@@ -0,0 +1,14 @@
+package stocktake
+
+import "errors"
+
+var (
+	ErrStocktakeNotFound = errors.New("stocktake not found")
+	ErrNotOpen           = errors.New("only open stocktakes can be counted")
+	ErrInvalidTransition = errors.New("stocktake cannot move to that status")
+	ErrItemNotInCount    = errors.New("inventory item is not part of this stocktake")
+	ErrNegativeCount     = errors.New("counted quantity cannot be negative")
+	ErrNothingToCount    = errors.New("no active inventory items match the stocktake's scope")
+	ErrNothingCounted    = errors.New("count at least one item before submitting")
+	ErrSelfApproval      = errors.New("a stocktake must be approved by someone other than who submitted it")
+)
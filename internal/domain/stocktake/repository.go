@@ -0,0 +1,25 @@
+package stocktake
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Filter narrows ListByRestaurant. Zero values match everything.
+type Filter struct {
+	Status Status
+}
+
+// Stocktakes are loaded with their lines, each carrying its inventory item
+// and unit.
+type Repository interface {
+	// Create persists the stocktake with its lines.
+	Create(ctx context.Context, s *Stocktake) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Stocktake, error)
+	// LockByID locks the stocktake until the surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Stocktake, error)
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID, filter Filter) ([]*Stocktake, error)
+	// Update saves the stocktake and the current state of its lines.
+	Update(ctx context.Context, s *Stocktake) error
+}
@@ -0,0 +1,114 @@
+package stocktake
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// transitions lists the statuses a stocktake may move to. A submitted count
+// can be reopened for a recount. Approved is reached through Approve.
+var transitions = map[Status][]Status{
+	StatusOpen:      {StatusSubmitted, StatusCancelled},
+	StatusSubmitted: {StatusOpen, StatusCancelled},
+}
+
+// Count records qty, in the item's unit, as what is on hand of item now. The
+// expected quantity and cost are refreshed from the item, so the variance
+// reflects only what the system got wrong. An empty reason keeps the
+// line's current one.
+func (s *Stocktake) Count(
+	item *inventory.Item, qty float64, reason inventory.AdjustmentReason, notes string, by uuid.UUID, at time.Time,
+) (*Line, error) {
+	if s.Status != StatusOpen {
+		return nil, ErrNotOpen
+	}
+	if qty < 0 {
+		return nil, ErrNegativeCount
+	}
+	if reason != "" && !reason.Valid() {
+		return nil, inventory.ErrInvalidAdjustmentReason
+	}
+	line := s.line(item.ID)
+	if line == nil {
+		return nil, ErrItemNotInCount
+	}
+
+	counted := inventory.RoundQty(qty)
+	line.CountedQuantity = &counted
+	line.ExpectedQuantity = item.CurrentStock
+	line.UnitCost = item.AverageCost
+	if reason != "" {
+		line.Reason = reason
+	}
+	line.Notes = notes
+	line.CountedBy = &by
+	line.CountedAt = &at
+	line.Item = item
+	return line, nil
+}
+
+// TransitionTo moves the stocktake to status on behalf of by.
+func (s *Stocktake) TransitionTo(status Status, by uuid.UUID, at time.Time) error {
+	allowed := false
+	for _, st := range transitions[s.Status] {
+		if st == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrInvalidTransition
+	}
+
+	switch status {
+	case StatusSubmitted:
+		if len(s.CountedLines()) == 0 {
+			return ErrNothingCounted
+		}
+		s.SubmittedBy = &by
+		s.SubmittedAt = &at
+	case StatusOpen:
+		s.SubmittedBy = nil
+		s.SubmittedAt = nil
+	}
+	s.Status = status
+	return nil
+}
+
+// Approve accepts a submitted count on behalf of by, who must not be the
+// one who submitted it. The caller posts the variances.
+func (s *Stocktake) Approve(by uuid.UUID, at time.Time) error {
+	if s.Status != StatusSubmitted {
+		return ErrInvalidTransition
+	}
+	if s.SubmittedBy != nil && *s.SubmittedBy == by {
+		return ErrSelfApproval
+	}
+	s.Status = StatusApproved
+	s.ApprovedBy = &by
+	s.ApprovedAt = &at
+	return nil
+}
+
+// CountedLines returns the lines that have been counted. Uncounted lines are
+// left out of the variance.
+func (s *Stocktake) CountedLines() []*Line {
+	var lines []*Line
+	for _, l := range s.Lines {
+		if l.Counted() {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func (s *Stocktake) line(itemID uuid.UUID) *Line {
+	for _, l := range s.Lines {
+		if l.InventoryItemID == itemID {
+			return l
+		}
+	}
+	return nil
+}
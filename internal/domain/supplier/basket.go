@@ -0,0 +1,183 @@
+package supplier
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// BasketLine is Quantity of an inventory item, in its unit.
+type BasketLine struct {
+	InventoryItemID uuid.UUID
+	Quantity        float64
+}
+
+// QuoteLine is one basket line priced by one supplier.
+type QuoteLine struct {
+	InventoryItemID uuid.UUID
+	Quantity        float64
+	UnitPrice       float64
+	Total           float64
+	// BelowMinimum means the quantity is under the supplier's minimum for
+	// the item
+	BelowMinimum bool
+}
+
+// Quote is what one supplier would charge for a basket. Missing lists the
+// items the supplier doesn't price. BelowMinimum means the order falls short
+// of the supplier's minimum order amount or of a line's minimum quantity.
+type Quote struct {
+	Supplier     *Supplier
+	Lines        []QuoteLine
+	Missing      []uuid.UUID
+	Total        float64
+	BelowMinimum bool
+}
+
+// Complete reports whether the supplier prices every item in the basket.
+func (q *Quote) Complete() bool {
+	return len(q.Missing) == 0
+}
+
+// Recommendation ranks the suppliers for a basket. Cheapest is the best
+// single supplier that prices every item and meets its minimum order, if
+// any does. Split is what buying each item from its cheapest supplier
+// would cost, for comparison.
+type Recommendation struct {
+	Cheapest *Quote
+	Quotes   []*Quote
+	Split    []*Quote
+}
+
+// Recommend quotes the basket from every active supplier with a price for
+// at least one of its items. Quotes are ranked complete first, then those
+// that meet the supplier's minimum order, then cheapest.
+func Recommend(basket []BasketLine, prices []*Price) (*Recommendation, error) {
+	seen := make(map[uuid.UUID]bool, len(basket))
+	for _, b := range basket {
+		if seen[b.InventoryItemID] {
+			return nil, ErrDuplicateBasketItem
+		}
+		seen[b.InventoryItemID] = true
+	}
+
+	bySupplier := make(map[uuid.UUID]map[uuid.UUID]*Price)
+	suppliers := make(map[uuid.UUID]*Supplier)
+	for _, p := range prices {
+		if p.Supplier == nil || !p.Supplier.IsActive {
+			continue
+		}
+		if bySupplier[p.SupplierID] == nil {
+			bySupplier[p.SupplierID] = make(map[uuid.UUID]*Price)
+			suppliers[p.SupplierID] = p.Supplier
+		}
+		bySupplier[p.SupplierID][p.InventoryItemID] = p
+	}
+
+	rec := &Recommendation{}
+	for id, s := range suppliers {
+		q := &Quote{Supplier: s}
+		totals := make([]float64, 0, len(basket))
+		for _, b := range basket {
+			p, ok := bySupplier[id][b.InventoryItemID]
+			if !ok {
+				q.Missing = append(q.Missing, b.InventoryItemID)
+				continue
+			}
+			line := quoteLine(b, p)
+			q.Lines = append(q.Lines, line)
+			totals = append(totals, line.Total)
+		}
+		q.Total = money.Sum(totals...)
+		q.checkMinimums()
+		rec.Quotes = append(rec.Quotes, q)
+	}
+	sort.Slice(rec.Quotes, func(a, b int) bool {
+		qa, qb := rec.Quotes[a], rec.Quotes[b]
+		switch {
+		case qa.Complete() != qb.Complete():
+			return qa.Complete()
+		case len(qa.Missing) != len(qb.Missing):
+			return len(qa.Missing) < len(qb.Missing)
+		case qa.BelowMinimum != qb.BelowMinimum:
+			return !qa.BelowMinimum
+		case qa.Total != qb.Total:
+			return qa.Total < qb.Total
+		}
+		return qa.Supplier.Name < qb.Supplier.Name
+	})
+	if len(rec.Quotes) > 0 && rec.Quotes[0].Complete() && !rec.Quotes[0].BelowMinimum {
+		rec.Cheapest = rec.Quotes[0]
+	}
+	rec.Split = split(basket, bySupplier, suppliers)
+	return rec, nil
+}
+
+// split buys each item from the supplier with the lowest unit price
+func split(basket []BasketLine, bySupplier map[uuid.UUID]map[uuid.UUID]*Price, suppliers map[uuid.UUID]*Supplier) []*Quote {
+	quotes := make(map[uuid.UUID]*Quote)
+	var order []uuid.UUID
+	for _, b := range basket {
+		var best *Price
+		for _, items := range bySupplier {
+			p, ok := items[b.InventoryItemID]
+			if ok && (best == nil || p.UnitPrice < best.UnitPrice ||
+				(p.UnitPrice == best.UnitPrice && p.Supplier.Name < best.Supplier.Name)) {
+				best = p
+			}
+		}
+		if best == nil {
+			continue
+		}
+		q, ok := quotes[best.SupplierID]
+		if !ok {
+			q = &Quote{Supplier: suppliers[best.SupplierID]}
+			quotes[best.SupplierID] = q
+			order = append(order, best.SupplierID)
+		}
+		line := quoteLine(b, best)
+		q.Lines = append(q.Lines, line)
+		q.Total = money.Sum(q.Total, line.Total)
+	}
+	result := make([]*Quote, 0, len(order))
+	for _, id := range order {
+		q := quotes[id]
+		q.checkMinimums()
+		result = append(result, q)
+	}
+	return result
+}
+
+func (q *Quote) checkMinimums() {
+	if m := q.Supplier.MinimumOrderAmount; m != nil && q.Total < *m {
+		q.BelowMinimum = true
+	}
+	for _, l := range q.Lines {
+		if l.BelowMinimum {
+			q.BelowMinimum = true
+		}
+	}
+}
+
+func quoteLine(b BasketLine, p *Price) QuoteLine {
+	qty := inventory.RoundQty(b.Quantity)
+	return QuoteLine{
+		InventoryItemID: b.InventoryItemID,
+		Quantity:        qty,
+		UnitPrice:       p.UnitPrice,
+		Total:           money.Round(qty * p.UnitPrice),
+		BelowMinimum:    qty < p.MinimumQuantity,
+	}
+}
+
+// SplitTotal is what the basket costs bought item by item from the
+// cheapest supplier.
+func (r *Recommendation) SplitTotal() float64 {
+	totals := make([]float64, 0, len(r.Split))
+	for _, q := range r.Split {
+		totals = append(totals, q.Total)
+	}
+	return money.Sum(totals...)
+}
@@ -0,0 +1,39 @@
+// Package supplier defines the Supplier entity: who restaurants buy stock from.
+package supplier
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Supplier struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name               string    `gorm:"size:255;not null"`
+	ContactPerson      string    `gorm:"size:255"`
+	Email              string    `gorm:"size:255"`
+	Phone              string    `gorm:"size:20"`
+	Address            string    `gorm:"type:text"`
+	City               string    `gorm:"size:100"`
+	State              string    `gorm:"size:100"`
+	PostalCode         string    `gorm:"size:20"`
+	Country            string    `gorm:"size:100;default:'USA'"`
+	PaymentTerms       string    `gorm:"size:255"`
+	DeliveryDays       string    `gorm:"size:100"`
+	MinimumOrderAmount *float64  `gorm:"type:decimal(10,2)"`
+	IsActive           bool      `gorm:"default:true"`
+	Rating             float64   `gorm:"type:decimal(3,2);default:0.00"`
+	Notes              string    `gorm:"type:text"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime"`
+}
+
+// NewSupplier creates an active supplier.
+func NewSupplier(name string) *Supplier {
+	return &Supplier{
+		ID:       uuid.New(),
+		Name:     name,
+		Country:  "USA",
+		IsActive: true,
+	}
+}
@@ -0,0 +1,10 @@
+package supplier
+
+import "errors"
+
+var (
+	ErrSupplierNotFound    = errors.New("supplier not found")
+	ErrSupplierInactive    = errors.New("supplier is inactive")
+	ErrPriceNotFound       = errors.New("supplier has no price for this item")
+	ErrDuplicateBasketItem = errors.New("basket lists the same item more than once")
+)
@@ -0,0 +1,106 @@
+package supplier
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Price sources recorded in the price history.
+const (
+	SourcePriceList     = "price_list"
+	SourcePurchaseOrder = "purchase_order"
+)
+
+// Price is what a supplier charges for an inventory item (supplier_prices),
+// in the item's unit. LastPaidPrice is what the latest received purchase
+// order actually cost.
+type Price struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	SupplierID      uuid.UUID `gorm:"type:uuid;not null"`
+	InventoryItemID uuid.UUID `gorm:"type:uuid;not null"`
+	SupplierSKU     string    `gorm:"column:supplier_sku;size:100"`
+	UnitPrice       float64   `gorm:"type:decimal(10,2);not null"`
+	MinimumQuantity float64   `gorm:"type:decimal(12,3);not null;default:0"`
+	LastPaidPrice   *float64  `gorm:"type:decimal(10,2)"`
+	LastPaidAt      *time.Time
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+
+	Supplier *Supplier       `gorm:"foreignKey:SupplierID"`
+	Item     *inventory.Item `gorm:"foreignKey:InventoryItemID"`
+}
+
+func (Price) TableName() string {
+	return "supplier_prices"
+}
+
+// PriceChange is one entry of the price history (supplier_price_history).
+type PriceChange struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	SupplierID      uuid.UUID  `gorm:"type:uuid;not null"`
+	InventoryItemID uuid.UUID  `gorm:"type:uuid;not null"`
+	UnitPrice       float64    `gorm:"type:decimal(10,2);not null"`
+	Source          string     `gorm:"size:50;not null"`
+	ReferenceID     *uuid.UUID `gorm:"type:uuid"`
+	RecordedBy      *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+
+	Supplier *Supplier `gorm:"foreignKey:SupplierID"`
+}
+
+func (PriceChange) TableName() string {
+	return "supplier_price_history"
+}
+
+// NewPrice lists a supplier's price for an item and returns the history
+// entry recording it.
+func NewPrice(supplierID, itemID uuid.UUID, unitPrice float64, by uuid.UUID) (*Price, *PriceChange, error) {
+	if unitPrice < 0 {
+		return nil, nil, inventory.ErrNegativeCost
+	}
+	p := &Price{
+		ID:              uuid.New(),
+		SupplierID:      supplierID,
+		InventoryItemID: itemID,
+		UnitPrice:       money.Round(unitPrice),
+	}
+	return p, p.change(p.UnitPrice, SourcePriceList, nil, by), nil
+}
+
+// SetPrice changes the list price and returns the history entry to record,
+// or nil when the price hasn't changed.
+func (p *Price) SetPrice(unitPrice float64, by uuid.UUID) (*PriceChange, error) {
+	if unitPrice < 0 {
+		return nil, inventory.ErrNegativeCost
+	}
+	unitPrice = money.Round(unitPrice)
+	if unitPrice == p.UnitPrice {
+		return nil, nil
+	}
+	p.UnitPrice = unitPrice
+	return p.change(unitPrice, SourcePriceList, nil, by), nil
+}
+
+// Paid records what a received purchase order cost and returns its history
+// entry. The list price is left alone.
+func (p *Price) Paid(unitCost float64, purchaseOrderID uuid.UUID, by uuid.UUID, at time.Time) *PriceChange {
+	cost := money.Round(unitCost)
+	p.LastPaidPrice = &cost
+	p.LastPaidAt = &at
+	return p.change(cost, SourcePurchaseOrder, &purchaseOrderID, by)
+}
+
+func (p *Price) change(unitPrice float64, source string, ref *uuid.UUID, by uuid.UUID) *PriceChange {
+	return &PriceChange{
+		ID:              uuid.New(),
+		SupplierID:      p.SupplierID,
+		InventoryItemID: p.InventoryItemID,
+		UnitPrice:       unitPrice,
+		Source:          source,
+		ReferenceID:     ref,
+		RecordedBy:      &by,
+	}
+}
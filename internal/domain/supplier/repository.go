@@ -0,0 +1,42 @@
+// Package supplier defines the domain model and repository interface for managing suppliers.
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Filter narrows List. Zero values match everything.
+type Filter struct {
+	Search          string
+	IncludeInactive bool
+}
+
+type Repository interface {
+	Create(ctx context.Context, s *Supplier) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Supplier, error)
+	// ListByIDs returns the suppliers found; missing IDs are simply absent.
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*Supplier, error)
+	List(ctx context.Context, filter Filter) ([]*Supplier, error)
+	Update(ctx context.Context, s *Supplier) error
+}
+
+type PriceRepository interface {
+	CreatePrice(ctx context.Context, p *Price) error
+	// GetPrice returns the supplier's price for the item, nil if it has none.
+	GetPrice(ctx context.Context, supplierID, itemID uuid.UUID) (*Price, error)
+	// ListPrices returns the supplier's price list with items and their
+	// units loaded, by item name.
+	ListPrices(ctx context.Context, supplierID uuid.UUID) ([]*Price, error)
+	// ListItemPrices returns the prices of active suppliers for the items,
+	// with suppliers loaded, cheapest first.
+	ListItemPrices(ctx context.Context, itemIDs []uuid.UUID) ([]*Price, error)
+	UpdatePrice(ctx context.Context, p *Price) error
+	DeletePrice(ctx context.Context, p *Price) error
+
+	CreatePriceChange(ctx context.Context, c *PriceChange) error
+	// ListPriceHistory returns the item's price history, newest first, with
+	// suppliers loaded. A nil supplierID covers every supplier.
+	ListPriceHistory(ctx context.Context, itemID uuid.UUID, supplierID *uuid.UUID, limit int) ([]*PriceChange, error)
+}
@@ -0,0 +1,56 @@
+package supplier
+
+import (
+	"strings"
+	"time"
+)
+
+var weekdayNames = map[string][]time.Weekday{
+	"sun": {time.Sunday}, "mon": {time.Monday}, "tue": {time.Tuesday}, "wed": {time.Wednesday},
+	"thu": {time.Thursday}, "fri": {time.Friday}, "sat": {time.Saturday},
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends": {time.Saturday, time.Sunday},
+}
+
+// DeliveryWeekdays parses DeliveryDays, a free-text list such as
+// "Mon, Wed, Fri", "monday/thursday" or "weekdays". Unrecognised words are
+// ignored. An empty result means the supplier delivers any day.
+func (s *Supplier) DeliveryWeekdays() []time.Weekday {
+	fields := strings.FieldsFunc(strings.ToLower(s.DeliveryDays), func(r rune) bool {
+		return r < 'a' || r > 'z'
+	})
+	seen := make(map[time.Weekday]bool)
+	var days []time.Weekday
+	for _, f := range fields {
+		key := f
+		if _, ok := weekdayNames[key]; !ok && len(f) >= 3 {
+			key = f[:3]
+		}
+		for _, d := range weekdayNames[key] {
+			if !seen[d] {
+				seen[d] = true
+				days = append(days, d)
+			}
+		}
+	}
+	return days
+}
+
+// NextDelivery returns the first delivery day after the day of t, at
+// midnight in t's location. Nothing ordered today arrives today.
+func (s *Supplier) NextDelivery(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	days := s.DeliveryWeekdays()
+	if len(days) == 0 {
+		return day.AddDate(0, 0, 1)
+	}
+	for i := 1; i <= 7; i++ {
+		next := day.AddDate(0, 0, i)
+		for _, d := range days {
+			if next.Weekday() == d {
+				return next
+			}
+		}
+	}
+	return day.AddDate(0, 0, 7) // unreachable
+}
@@ -0,0 +1,155 @@
+// Package table defines dine-in tables and their QR codes, and table
+// sessions: the shared tab guests at a table order onto without an account.
+package table
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+)
+
+// SessionStatus mirrors table_session_status_enum.
+type SessionStatus string
+
+const (
+	SessionOpen   SessionStatus = "open"
+	SessionClosed SessionStatus = "closed"
+)
+
+// Table is a dine-in table. Its QR code carries a token signed over the
+// table and TokenVersion, so bumping the version retires printed codes.
+type Table struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID uuid.UUID `gorm:"type:uuid;not null"`
+	Number       string    `gorm:"size:20;not null"`
+	Capacity     int       `gorm:"not null"`
+	TokenVersion int       `gorm:"not null;default:1"`
+	IsActive     bool      `gorm:"not null;default:true"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Table) TableName() string {
+	return "restaurant_tables"
+}
+
+func NewTable(restaurantID uuid.UUID, number string, capacity int) (*Table, error) {
+	number = strings.TrimSpace(number)
+	if number == "" || capacity <= 0 {
+		return nil, ErrInvalidTable
+	}
+	return &Table{
+		ID:           uuid.New(),
+		RestaurantID: restaurantID,
+		Number:       number,
+		Capacity:     capacity,
+		TokenVersion: 1,
+		IsActive:     true,
+	}, nil
+}
+
+// RotateToken retires the table's current QR code.
+func (t *Table) RotateToken() {
+	t.TokenVersion++
+}
+
+// Session is a table's shared tab, from the first scan until staff close
+// it. Every guest who scans the table's code while it is open joins it.
+type Session struct {
+	ID            uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RestaurantID  uuid.UUID            `gorm:"type:uuid;not null"`
+	TableID       uuid.UUID            `gorm:"type:uuid;not null"`
+	Status        SessionStatus        `gorm:"type:table_session_status_enum;not null;default:'open'"`
+	GuestCount    int                  `gorm:"not null;default:0"`
+	PaymentMethod *order.PaymentMethod `gorm:"type:payment_method_enum"`
+	ClosedBy      *uuid.UUID           `gorm:"type:uuid"`
+	ClosedAt      *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+
+	Table *Table `gorm:"foreignKey:TableID"`
+}
+
+func (Session) TableName() string {
+	return "table_sessions"
+}
+
+// OpenSession starts a tab at the table.
+func OpenSession(t *Table) *Session {
+	return &Session{
+		ID:           uuid.New(),
+		RestaurantID: t.RestaurantID,
+		TableID:      t.ID,
+		Status:       SessionOpen,
+		Table:        t,
+	}
+}
+
+func (s *Session) IsOpen() bool {
+	return s.Status == SessionOpen
+}
+
+// Seat records how many guests are at the table. The count only grows, as
+// guests joining later give their own party's size.
+func (s *Session) Seat(guests int) error {
+	if s.Table != nil && guests > s.Table.Capacity {
+		return ErrOverCapacity
+	}
+	if guests > s.GuestCount {
+		s.GuestCount = guests
+	}
+	return nil
+}
+
+// MoveTo moves the tab to another table of the restaurant, which must be
+// free and seat everyone.
+func (s *Session) MoveTo(t *Table) error {
+	if !s.IsOpen() {
+		return ErrSessionClosed
+	}
+	if t.RestaurantID != s.RestaurantID {
+		return ErrTableNotFound
+	}
+	if !t.IsActive {
+		return ErrTableInactive
+	}
+	if s.GuestCount > t.Capacity {
+		return ErrOverCapacity
+	}
+	s.TableID = t.ID
+	s.Table = t
+	return nil
+}
+
+// Close settles the tab: every order on it must be served or cancelled,
+// and whatever is still unpaid is marked paid with method. method may be
+// empty when nothing is due.
+func (s *Session) Close(orders []*order.Order, method order.PaymentMethod, by uuid.UUID, at time.Time) error {
+	if !s.IsOpen() {
+		return ErrSessionClosed
+	}
+	tab := NewTab(s, orders)
+	for _, o := range orders {
+		if o.Status != order.StatusDelivered && o.Status != order.StatusCancelled {
+			return ErrOrdersInProgress
+		}
+	}
+	if tab.Due > 0 {
+		if method == "" {
+			return ErrPaymentMethodRequired
+		}
+		for _, o := range orders {
+			if o.Status == order.StatusDelivered && o.PaymentStatus == order.PaymentPending {
+				o.PaymentStatus = order.PaymentPaid
+				o.PaymentMethod = &method
+			}
+		}
+		s.PaymentMethod = &method
+	}
+	s.Status = SessionClosed
+	s.ClosedBy = &by
+	s.ClosedAt = &at
+	return nil
+}
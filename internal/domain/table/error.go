@@ -0,0 +1,17 @@
+package table
+
+import "errors"
+
+var (
+	ErrTableNotFound         = errors.New("table not found")
+	ErrInvalidTable          = errors.New("table needs a number and a capacity of at least one")
+	ErrTableNumberTaken      = errors.New("restaurant already has a table with this number")
+	ErrTableInactive         = errors.New("table is not in use")
+	ErrTableOccupied         = errors.New("table already has an open tab")
+	ErrInvalidToken          = errors.New("invalid or retired table code")
+	ErrSessionNotFound       = errors.New("table session not found")
+	ErrSessionClosed         = errors.New("table session is closed")
+	ErrOverCapacity          = errors.New("more guests than the table seats")
+	ErrOrdersInProgress      = errors.New("tab has orders that are not served or cancelled")
+	ErrPaymentMethodRequired = errors.New("payment method is required to close a tab with an amount due")
+)
@@ -0,0 +1,36 @@
+package table
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, t *Table) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Table, error)
+	// GetByNumber finds the restaurant's table with the number.
+	GetByNumber(ctx context.Context, restaurantID uuid.UUID, number string) (*Table, error)
+	// LockByID loads the table and locks it until the surrounding
+	// transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Table, error)
+	Update(ctx context.Context, t *Table) error
+	ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*Table, error)
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, s *Session) error
+	// GetByID loads the session with its table.
+	GetByID(ctx context.Context, id uuid.UUID) (*Session, error)
+	// LockByID loads the session with its table and locks it until the
+	// surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Session, error)
+	// GetOpenByTable returns the table's open session, or nil.
+	GetOpenByTable(ctx context.Context, tableID uuid.UUID) (*Session, error)
+	Update(ctx context.Context, s *Session) error
+	// ListByRestaurant returns the restaurant's sessions with their tables,
+	// newest first. An empty status lists all of them.
+	ListByRestaurant(
+		ctx context.Context, restaurantID uuid.UUID, status SessionStatus, limit, offset int,
+	) ([]*Session, error)
+}
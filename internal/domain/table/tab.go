@@ -0,0 +1,33 @@
+package table
+
+import (
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Tab is a session with its orders and what they add up to. Cancelled
+// orders are listed but not counted.
+type Tab struct {
+	Session *Session
+	Orders  []*order.Order
+	Total   float64
+	Paid    float64
+	Due     float64
+}
+
+func NewTab(s *Session, orders []*order.Order) *Tab {
+	tab := &Tab{Session: s, Orders: orders}
+	for _, o := range orders {
+		if o.Status == order.StatusCancelled {
+			continue
+		}
+		tab.Total += o.Total
+		if o.PaymentStatus == order.PaymentPaid {
+			tab.Paid += o.Total
+		}
+	}
+	tab.Total = money.Round(tab.Total)
+	tab.Paid = money.Round(tab.Paid)
+	tab.Due = money.Sum(tab.Total, -tab.Paid)
+	return tab
+}
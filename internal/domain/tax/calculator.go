@@ -0,0 +1,120 @@
+package tax
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Calculator computes the tax owed on a set of taxable lines.
+// It is the extension point for plugging in an external tax provider.
+type Calculator interface {
+	Calculate(ctx context.Context, req Request) (*Result, error)
+}
+
+// TableCalculator is the default Calculator backed by the tax_rates table.
+// Every active rate whose country/state/postal_code matches the jurisdiction
+// and whose category matches the line is applied; rates stack.
+type TableCalculator struct {
+	repo Repository
+}
+
+func NewTableCalculator(repo Repository) *TableCalculator {
+	return &TableCalculator{repo: repo}
+}
+
+type breakdownKey struct {
+	rateID    uuid.UUID
+	inclusive bool
+}
+
+type accumulator struct {
+	rate    *Rate
+	taxable float64
+	amount  float64
+}
+
+func (c *TableCalculator) Calculate(ctx context.Context, req Request) (*Result, error) {
+	rates, err := c.repo.ListActiveByCountry(ctx, req.Jurisdiction.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[Category][]*Rate)
+	for _, r := range rates {
+		if r.Matches(req.Jurisdiction) {
+			byCategory[r.Category] = append(byCategory[r.Category], r)
+		}
+	}
+
+	acc := make(map[breakdownKey]*accumulator)
+	for _, line := range req.Lines {
+		if line.Amount < 0 {
+			return nil, ErrNegativeAmount
+		}
+		switch line.Category {
+		case CategoryFood, CategoryAlcohol, CategoryDelivery:
+		default:
+			return nil, ErrUnknownCategory
+		}
+
+		applicable := byCategory[line.Category]
+		var combined float64
+		for _, r := range applicable {
+			combined += r.Rate
+		}
+
+		// For tax-inclusive prices the net amount is backed out of the gross
+		// using the combined rate, then each rate is applied to the net.
+		net := line.Amount
+		if line.Inclusive {
+			net = line.Amount / (1 + combined)
+		}
+		for _, r := range applicable {
+			key := breakdownKey{rateID: r.ID, inclusive: line.Inclusive}
+			a, ok := acc[key]
+			if !ok {
+				a = &accumulator{rate: r}
+				acc[key] = a
+			}
+			a.taxable += net
+			a.amount += net * r.Rate
+		}
+	}
+
+	// Tax is rounded once per rate rather than per line, using the rate's
+	// own rounding rule.
+	res := &Result{Breakdown: Breakdown{}}
+	for key, a := range acc {
+		amount := a.rate.RoundingMode.Round(a.amount)
+		res.Breakdown = append(res.Breakdown, BreakdownLine{
+			RateID:    a.rate.ID,
+			Name:      a.rate.Name,
+			Category:  a.rate.Category,
+			Rate:      a.rate.Rate,
+			Taxable:   money.Round(a.taxable),
+			Amount:    amount,
+			Inclusive: key.inclusive,
+		})
+		if key.inclusive {
+			res.Included += amount
+		} else {
+			res.Additional += amount
+		}
+	}
+	res.Included = money.Round(res.Included)
+	res.Additional = money.Round(res.Additional)
+	res.Total = money.Sum(res.Included, res.Additional)
+
+	sort.Slice(res.Breakdown, func(i, j int) bool {
+		bi, bj := res.Breakdown[i], res.Breakdown[j]
+		if bi.Category != bj.Category {
+			return bi.Category < bj.Category
+		}
+		return bi.Name < bj.Name
+	})
+
+	return res, nil
+}
@@ -0,0 +1,172 @@
+package tax
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type fakeRepository struct {
+	rates []*Rate
+}
+
+func (r *fakeRepository) Create(ctx context.Context, rate *Rate) error {
+	r.rates = append(r.rates, rate)
+	return nil
+}
+
+func (r *fakeRepository) ListActiveByCountry(ctx context.Context, country string) ([]*Rate, error) {
+	var res []*Rate
+	for _, rate := range r.rates {
+		if rate.IsActive && strings.EqualFold(rate.Country, country) {
+			res = append(res, rate)
+		}
+	}
+	return res, nil
+}
+
+func newRate(name, state string, category Category, rate float64, mode RoundingMode) *Rate {
+	return &Rate{
+		ID:           uuid.New(),
+		Name:         name,
+		Country:      "US",
+		State:        state,
+		Category:     category,
+		Rate:         rate,
+		RoundingMode: mode,
+		IsActive:     true,
+	}
+}
+
+func TestTableCalculatorCalculate(t *testing.T) {
+	stateFood := newRate("State", "CA", CategoryFood, 0.06, RoundHalfUp)
+	cityFood := newRate("City", "CA", CategoryFood, 0.0125, RoundHalfUp)
+	otherState := newRate("Other state", "NV", CategoryFood, 0.08, RoundHalfUp)
+	alcohol := newRate("Alcohol", "", CategoryAlcohol, 0.10, RoundHalfUp)
+	smallHalfUp := newRate("Small", "CA", CategoryFood, 0.05, RoundHalfUp)
+	smallDown := newRate("Small", "CA", CategoryFood, 0.05, RoundDown)
+	inactive := newRate("Retired", "CA", CategoryFood, 0.5, RoundHalfUp)
+	inactive.IsActive = false
+
+	ca := Jurisdiction{Country: "US", State: "CA", PostalCode: "94103"}
+
+	tests := []struct {
+		name           string
+		rates          []*Rate
+		lines          []Line
+		wantAmounts    map[string]float64
+		wantIncluded   float64
+		wantAdditional float64
+		wantErr        error
+	}{
+		{
+			name:           "exclusive rates stack",
+			rates:          []*Rate{stateFood, cityFood, otherState, inactive},
+			lines:          []Line{{Category: CategoryFood, Amount: 100}},
+			wantAmounts:    map[string]float64{"State": 6, "City": 1.25},
+			wantAdditional: 7.25,
+		},
+		{
+			name:         "inclusive price backs out net with the combined rate",
+			rates:        []*Rate{stateFood, cityFood},
+			lines:        []Line{{Category: CategoryFood, Amount: 107.25, Inclusive: true}},
+			wantAmounts:  map[string]float64{"State": 6, "City": 1.25},
+			wantIncluded: 7.25,
+		},
+		{
+			name:  "rates apply only to their category",
+			rates: []*Rate{stateFood, alcohol},
+			lines: []Line{
+				{Category: CategoryFood, Amount: 10},
+				{Category: CategoryAlcohol, Amount: 20},
+				{Category: CategoryDelivery, Amount: 5},
+			},
+			wantAmounts:    map[string]float64{"State": 0.6, "Alcohol": 2},
+			wantAdditional: 2.6,
+		},
+		{
+			// 3 x 0.005 rounds once to 0.02, not per line to 0.03
+			name:           "rounded once per rate",
+			rates:          []*Rate{smallHalfUp},
+			lines:          []Line{{Category: CategoryFood, Amount: 0.1}, {Category: CategoryFood, Amount: 0.1}, {Category: CategoryFood, Amount: 0.1}},
+			wantAmounts:    map[string]float64{"Small": 0.02},
+			wantAdditional: 0.02,
+		},
+		{
+			name:           "rate's own rounding mode",
+			rates:          []*Rate{smallDown},
+			lines:          []Line{{Category: CategoryFood, Amount: 0.1}, {Category: CategoryFood, Amount: 0.1}, {Category: CategoryFood, Amount: 0.1}},
+			wantAmounts:    map[string]float64{"Small": 0.01},
+			wantAdditional: 0.01,
+		},
+		{
+			name:    "negative amount",
+			rates:   []*Rate{stateFood},
+			lines:   []Line{{Category: CategoryFood, Amount: -1}},
+			wantErr: ErrNegativeAmount,
+		},
+		{
+			name:    "unknown category",
+			rates:   []*Rate{stateFood},
+			lines:   []Line{{Category: "tobacco", Amount: 1}},
+			wantErr: ErrUnknownCategory,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewTableCalculator(&fakeRepository{rates: tt.rates})
+			res, err := calc.Calculate(context.Background(), Request{Jurisdiction: ca, Lines: tt.lines})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got := make(map[string]float64, len(res.Breakdown))
+			for _, b := range res.Breakdown {
+				got[b.Name] = b.Amount
+			}
+			if len(got) != len(tt.wantAmounts) {
+				t.Fatalf("breakdown = %v, want %v", got, tt.wantAmounts)
+			}
+			for name, want := range tt.wantAmounts {
+				if got[name] != want {
+					t.Errorf("%s = %v, want %v", name, got[name], want)
+				}
+			}
+			if res.Included != tt.wantIncluded || res.Additional != tt.wantAdditional {
+				t.Errorf("included, additional = %v, %v, want %v, %v",
+					res.Included, res.Additional, tt.wantIncluded, tt.wantAdditional)
+			}
+			if want := tt.wantIncluded + tt.wantAdditional; res.Total != want {
+				t.Errorf("total = %v, want %v", res.Total, want)
+			}
+		})
+	}
+}
+
+func TestRoundingModeRound(t *testing.T) {
+	tests := []struct {
+		mode   RoundingMode
+		amount float64
+		want   float64
+	}{
+		{RoundHalfUp, 1.005, 1.01},
+		{RoundHalfUp, 0.124, 0.12},
+		{RoundHalfEven, 0.125, 0.12},
+		{RoundHalfEven, 0.135, 0.14},
+		{RoundUp, 0.121, 0.13},
+		{RoundUp, 0.12, 0.12},
+		{RoundDown, 0.129, 0.12},
+		{"", 0.125, 0.13},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.Round(tt.amount); got != tt.want {
+			t.Errorf("%q.Round(%v) = %v, want %v", tt.mode, tt.amount, got, tt.want)
+		}
+	}
+}
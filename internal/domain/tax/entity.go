@@ -0,0 +1,155 @@
+// Package tax defines tax rates per jurisdiction and the value objects used to
+// compute the tax owed on an order.
+package tax
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category groups taxable amounts that share the same set of rates.
+type Category string
+
+const (
+	CategoryFood     Category = "food"
+	CategoryAlcohol  Category = "alcohol"
+	CategoryDelivery Category = "delivery"
+)
+
+// RoundingMode decides how a computed tax amount is rounded to cents.
+type RoundingMode string
+
+const (
+	RoundHalfUp   RoundingMode = "half_up"
+	RoundHalfEven RoundingMode = "half_even"
+	RoundUp       RoundingMode = "up"
+	RoundDown     RoundingMode = "down"
+)
+
+// epsilon absorbs float noise such as 1.005*100 = 100.49999999999999
+const epsilon = 1e-9
+
+// Round rounds the amount to cents using the mode. Unknown modes round half up.
+func (m RoundingMode) Round(amount float64) float64 {
+	cents := amount * 100
+	switch m {
+	case RoundHalfEven:
+		cents = math.RoundToEven(cents)
+	case RoundUp:
+		cents = math.Ceil(cents - epsilon)
+	case RoundDown:
+		cents = math.Floor(cents + epsilon)
+	default:
+		cents = math.Round(cents + math.Copysign(epsilon, cents))
+	}
+	return cents / 100
+}
+
+// Rate is a single tax rate for one category in one jurisdiction.
+// Empty State or PostalCode act as wildcards, so a country-wide rate, a state
+// rate and a city rate for the same postal code all apply to the same order.
+type Rate struct {
+	ID           uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name         string       `gorm:"size:100;not null"`
+	Country      string       `gorm:"size:100;not null"`
+	State        string       `gorm:"size:100"`
+	PostalCode   string       `gorm:"size:20"`
+	Category     Category     `gorm:"type:tax_category_enum;not null"`
+	Rate         float64      `gorm:"type:decimal(7,5);not null"`
+	RoundingMode RoundingMode `gorm:"size:20;default:'half_up'"`
+	IsActive     bool         `gorm:"default:true"`
+	CreatedAt    time.Time    `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time    `gorm:"autoUpdateTime"`
+}
+
+func (Rate) TableName() string {
+	return "tax_rates"
+}
+
+// Matches reports whether the rate applies to the jurisdiction.
+func (r *Rate) Matches(j Jurisdiction) bool {
+	if !r.IsActive || !strings.EqualFold(r.Country, j.Country) {
+		return false
+	}
+	if r.State != "" && !strings.EqualFold(r.State, j.State) {
+		return false
+	}
+	if r.PostalCode != "" && r.PostalCode != j.PostalCode {
+		return false
+	}
+	return true
+}
+
+// Jurisdiction is the location whose rates apply, normally the restaurant address.
+type Jurisdiction struct {
+	Country    string
+	State      string
+	PostalCode string
+}
+
+// Line is a taxable amount. Inclusive lines already contain the tax in Amount.
+type Line struct {
+	Category  Category
+	Amount    float64
+	Inclusive bool
+}
+
+// Request is the input to a Calculator.
+type Request struct {
+	Jurisdiction Jurisdiction
+	Lines        []Line
+}
+
+// BreakdownLine is the tax charged by one rate.
+type BreakdownLine struct {
+	RateID    uuid.UUID `json:"rate_id"`
+	Name      string    `json:"name"`
+	Category  Category  `json:"category"`
+	Rate      float64   `json:"rate"`
+	Taxable   float64   `json:"taxable_amount"`
+	Amount    float64   `json:"tax_amount"`
+	Inclusive bool      `json:"inclusive"`
+}
+
+// Breakdown is stored as JSONB on the order so receipts show the rates
+// that applied at checkout time, even if the rate table changes later.
+type Breakdown []BreakdownLine
+
+func (b Breakdown) Value() (driver.Value, error) {
+	if b == nil {
+		return "[]", nil
+	}
+	raw, err := json.Marshal(b)
+	return string(raw), err
+}
+
+func (b *Breakdown) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*b = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, b)
+	case string:
+		return json.Unmarshal([]byte(v), b)
+	default:
+		return errors.New("tax: unsupported breakdown type")
+	}
+}
+
+// Result is the tax owed for a Request.
+type Result struct {
+	Breakdown Breakdown
+	// Total is all tax, whether included in prices or not.
+	Total float64
+	// Included is the part of Total already contained in inclusive prices.
+	Included float64
+	// Additional is the part of Total that must be added on top of the prices.
+	Additional float64
+}
@@ -0,0 +1,8 @@
+package tax
+
+import "errors"
+
+var (
+	ErrNegativeAmount  = errors.New("taxable amount cannot be negative")
+	ErrUnknownCategory = errors.New("unknown tax category")
+)
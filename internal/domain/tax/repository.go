@@ -0,0 +1,12 @@
+package tax
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, rate *Rate) error
+	// ListActiveByCountry returns every active rate of the country; callers
+	// narrow it down to the state and postal code with Rate.Matches.
+	ListActiveByCountry(ctx context.Context, country string) ([]*Rate, error)
+}
@@ -0,0 +1,175 @@
+// Package transfer defines stock transfers: stock shipped from one
+// restaurant to another, in transit until the destination receives it.
+package transfer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Status mirrors transfer_status_enum.
+type Status string
+
+const (
+	StatusRequested Status = "requested"
+	StatusShipped   Status = "shipped"
+	StatusReceived  Status = "received"
+	StatusCancelled Status = "cancelled"
+)
+
+type Transfer struct {
+	ID                      uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TransferNumber          string     `gorm:"size:50;unique;not null"`
+	SourceRestaurantID      uuid.UUID  `gorm:"type:uuid;not null"`
+	DestinationRestaurantID uuid.UUID  `gorm:"type:uuid;not null"`
+	Status                  Status     `gorm:"type:transfer_status_enum;default:'requested'"`
+	Notes                   string     `gorm:"type:text"`
+	RequestedBy             *uuid.UUID `gorm:"type:uuid"`
+	ShippedBy               *uuid.UUID `gorm:"type:uuid"`
+	ShippedAt               *time.Time
+	ReceivedBy              *uuid.UUID `gorm:"type:uuid"`
+	ReceivedAt              *time.Time
+	CreatedAt               time.Time `gorm:"autoCreateTime"`
+	UpdatedAt               time.Time `gorm:"autoUpdateTime"`
+
+	Lines []*Line `gorm:"foreignKey:TransferID"`
+}
+
+func (Transfer) TableName() string {
+	return "stock_transfers"
+}
+
+// Line moves one source item. Quantities are in the source item's unit; the
+// destination item, matched by SKU, is set when the line is received.
+type Line struct {
+	ID                uuid.UUID                   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TransferID        uuid.UUID                   `gorm:"type:uuid;not null"`
+	SourceItemID      uuid.UUID                   `gorm:"type:uuid;not null"`
+	DestinationItemID *uuid.UUID                  `gorm:"type:uuid"`
+	SKU               string                      `gorm:"column:sku;size:100;not null"`
+	QuantityRequested float64                     `gorm:"type:decimal(12,3);not null"`
+	QuantityShipped   *float64                    `gorm:"type:decimal(12,3)"`
+	QuantityReceived  *float64                    `gorm:"type:decimal(12,3)"`
+	UnitCost          *float64                    `gorm:"type:decimal(10,2)"`
+	ExpiryDate        *time.Time                  `gorm:"type:date"`
+	DiscrepancyReason *inventory.AdjustmentReason `gorm:"type:adjustment_reason_enum"`
+	Notes             string                      `gorm:"type:text"`
+	CreatedAt         time.Time                   `gorm:"autoCreateTime"`
+
+	SourceItem      *inventory.Item `gorm:"foreignKey:SourceItemID"`
+	DestinationItem *inventory.Item `gorm:"foreignKey:DestinationItemID"`
+}
+
+func (Line) TableName() string {
+	return "stock_transfer_lines"
+}
+
+// NewTransfer is a Factory Function that ensures a Transfer is always
+// created with a valid ID and default business state.
+func NewTransfer(sourceID, destinationID uuid.UUID, requestedBy uuid.UUID) (*Transfer, error) {
+	if sourceID == destinationID {
+		return nil, ErrSameRestaurant
+	}
+	return &Transfer{
+		ID:                      uuid.New(),
+		SourceRestaurantID:      sourceID,
+		DestinationRestaurantID: destinationID,
+		Status:                  StatusRequested,
+		RequestedBy:             &requestedBy,
+	}, nil
+}
+
+// AddLine requests qty of a source item, which must belong to the source
+// restaurant and carry a SKU for the destination to match.
+func (t *Transfer) AddLine(item *inventory.Item, qty float64) (*Line, error) {
+	if t.Status != StatusRequested {
+		return nil, ErrNotRequested
+	}
+	if item.RestaurantID != t.SourceRestaurantID {
+		return nil, ErrLineWrongVenue
+	}
+	if item.SKU == nil || *item.SKU == "" {
+		return nil, ErrItemWithoutSKU
+	}
+	if qty <= 0 {
+		return nil, inventory.ErrNonPositiveQuantity
+	}
+	for _, l := range t.Lines {
+		if l.SourceItemID == item.ID {
+			return nil, ErrDuplicateLine
+		}
+	}
+	line := &Line{
+		ID:                uuid.New(),
+		TransferID:        t.ID,
+		SourceItemID:      item.ID,
+		SKU:               *item.SKU,
+		QuantityRequested: inventory.RoundQty(qty),
+		SourceItem:        item,
+	}
+	t.Lines = append(t.Lines, line)
+	return line, nil
+}
+
+// Shipped is the quantity that left the source, zero before shipping.
+func (l *Line) Shipped() float64 {
+	if l.QuantityShipped == nil {
+		return 0
+	}
+	return *l.QuantityShipped
+}
+
+// Discrepancy is how much of what shipped never arrived. It is zero until
+// the line is received.
+func (l *Line) Discrepancy() float64 {
+	if l.QuantityReceived == nil {
+		return 0
+	}
+	return inventory.RoundQty(l.Shipped() - *l.QuantityReceived)
+}
+
+// ValueShipped is what left the source, at the source's average cost.
+func (l *Line) ValueShipped() float64 {
+	return l.value(l.Shipped())
+}
+
+// DiscrepancyValue is what was lost in transit.
+func (l *Line) DiscrepancyValue() float64 {
+	return l.value(l.Discrepancy())
+}
+
+func (l *Line) value(qty float64) float64 {
+	if l.UnitCost == nil {
+		return 0
+	}
+	return money.Round(qty * *l.UnitCost)
+}
+
+// ValueInTransit is what has left the source and not yet been received.
+func (t *Transfer) ValueInTransit() float64 {
+	if t.Status != StatusShipped {
+		return 0
+	}
+	return t.ValueShipped()
+}
+
+// ValueShipped totals the value of every shipped line.
+func (t *Transfer) ValueShipped() float64 {
+	values := make([]float64, 0, len(t.Lines))
+	for _, l := range t.Lines {
+		values = append(values, l.ValueShipped())
+	}
+	return money.Sum(values...)
+}
+
+// DiscrepancyValue totals what was lost in transit.
+func (t *Transfer) DiscrepancyValue() float64 {
+	values := make([]float64, 0, len(t.Lines))
+	for _, l := range t.Lines {
+		values = append(values, l.DiscrepancyValue())
+	}
+	return money.Sum(values...)
+}
@@ -0,0 +1,19 @@
+package transfer
+
+import "errors"
+
+var (
+	ErrTransferNotFound  = errors.New("stock transfer not found")
+	ErrLineNotFound      = errors.New("stock transfer line not found")
+	ErrSameRestaurant    = errors.New("stock can only be transferred to another restaurant")
+	ErrEmptyTransfer     = errors.New("stock transfer must contain at least one line")
+	ErrDuplicateLine     = errors.New("an inventory item can only appear once on a stock transfer")
+	ErrLineWrongVenue    = errors.New("inventory item belongs to another restaurant than the transfer's source")
+	ErrItemWithoutSKU    = errors.New("inventory item needs a SKU to be matched at the destination")
+	ErrNoMatchingItem    = errors.New("destination restaurant has no active inventory item with this SKU")
+	ErrNotRequested      = errors.New("only requested stock transfers can be changed or shipped")
+	ErrNotShipped        = errors.New("only shipped stock transfers can be received")
+	ErrInvalidTransition = errors.New("stock transfer cannot move to the requested status")
+	ErrOverShipment      = errors.New("quantity shipped exceeds the quantity requested")
+	ErrOverReceipt       = errors.New("quantity received exceeds the quantity shipped")
+)
@@ -0,0 +1,37 @@
+// Package transfer defines the domain model and repository interface for managing stock transfers.
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Direction narrows List to transfers leaving or arriving at the restaurant.
+type Direction string
+
+const (
+	DirectionOutgoing Direction = "outgoing"
+	DirectionIncoming Direction = "incoming"
+)
+
+// Filter narrows List. Zero values match everything.
+type Filter struct {
+	Status    Status
+	Direction Direction
+}
+
+// Transfers are loaded with their lines, each carrying its source and
+// destination item and their units.
+type Repository interface {
+	// Create persists the transfer with its lines and reads back the
+	// generated transfer number.
+	Create(ctx context.Context, t *Transfer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Transfer, error)
+	// LockByID locks the transfer until the surrounding transaction ends.
+	LockByID(ctx context.Context, id uuid.UUID) (*Transfer, error)
+	// List returns the transfers the restaurant sends or receives, newest first.
+	List(ctx context.Context, restaurantID uuid.UUID, filter Filter) ([]*Transfer, error)
+	// Update saves the transfer and the current state of its lines.
+	Update(ctx context.Context, t *Transfer) error
+}
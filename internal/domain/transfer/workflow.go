@@ -0,0 +1,126 @@
+package transfer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// Ship marks the transfer in transit. quantities maps line ids to what is
+// actually sent; lines left out ship in full, and a line may ship nothing
+// when the source has run out. The caller takes the stock out of the
+// source and records each line's cost with RecordShipment.
+func (t *Transfer) Ship(quantities map[uuid.UUID]float64, by uuid.UUID, at time.Time) error {
+	if t.Status != StatusRequested {
+		return ErrNotRequested
+	}
+	for id := range quantities {
+		if t.line(id) == nil {
+			return ErrLineNotFound
+		}
+	}
+	total := 0.0
+	for _, l := range t.Lines {
+		qty, ok := quantities[l.ID]
+		if !ok {
+			qty = l.QuantityRequested
+		}
+		qty = inventory.RoundQty(qty)
+		if qty < 0 {
+			return inventory.ErrNonPositiveQuantity
+		}
+		if qty > l.QuantityRequested {
+			return ErrOverShipment
+		}
+		l.QuantityShipped = &qty
+		total += qty
+	}
+	if total <= 0 {
+		return ErrEmptyTransfer
+	}
+	t.Status = StatusShipped
+	t.ShippedBy = &by
+	t.ShippedAt = &at
+	return nil
+}
+
+// RecordShipment values the line at the source's average cost and keeps the
+// earliest expiry of the batches it shipped from, so the destination's
+// batch doesn't outlive the stock in it.
+func (l *Line) RecordShipment(unitCost float64, draws []inventory.BatchDraw) {
+	l.UnitCost = &unitCost
+	for _, d := range draws {
+		if e := d.Batch.ExpiryDate; e != nil && (l.ExpiryDate == nil || e.Before(*l.ExpiryDate)) {
+			l.ExpiryDate = e
+		}
+	}
+}
+
+// Receipt is what arrived of one line. A nil Quantity means everything
+// shipped; a shortfall is recorded with Reason, other by default.
+type Receipt struct {
+	Quantity *float64
+	Reason   inventory.AdjustmentReason
+	Notes    string
+}
+
+// Receive books what arrived at the destination, keyed by line id; lines
+// left out arrived in full. The caller credits the destination items.
+func (t *Transfer) Receive(receipts map[uuid.UUID]Receipt, by uuid.UUID, at time.Time) error {
+	if t.Status != StatusShipped {
+		return ErrNotShipped
+	}
+	for id := range receipts {
+		if t.line(id) == nil {
+			return ErrLineNotFound
+		}
+	}
+	for _, l := range t.Lines {
+		r := receipts[l.ID]
+		qty := l.Shipped()
+		if r.Quantity != nil {
+			qty = inventory.RoundQty(*r.Quantity)
+		}
+		if qty < 0 {
+			return inventory.ErrNonPositiveQuantity
+		}
+		if qty > l.Shipped() {
+			return ErrOverReceipt
+		}
+		l.QuantityReceived = &qty
+		l.Notes = r.Notes
+		if l.Discrepancy() > 0 {
+			reason := r.Reason
+			if reason == "" {
+				reason = inventory.ReasonOther
+			}
+			if !reason.Valid() {
+				return inventory.ErrInvalidAdjustmentReason
+			}
+			l.DiscrepancyReason = &reason
+		}
+	}
+	t.Status = StatusReceived
+	t.ReceivedBy = &by
+	t.ReceivedAt = &at
+	return nil
+}
+
+// Cancel withdraws a transfer that hasn't shipped yet.
+func (t *Transfer) Cancel() error {
+	if t.Status != StatusRequested {
+		return ErrInvalidTransition
+	}
+	t.Status = StatusCancelled
+	return nil
+}
+
+func (t *Transfer) line(id uuid.UUID) *Line {
+	for _, l := range t.Lines {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+// Package tx defines how use cases run several repository calls atomically
+// without knowing which database is behind the repositories.
+package tx
+
+import "context"
+
+// Transactor runs fn inside a database transaction. Repositories called with
+// the ctx passed to fn take part in that transaction; the transaction is
+// committed when fn returns nil and rolled back otherwise.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
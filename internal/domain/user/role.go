@@ -0,0 +1,37 @@
+// Package user defines the User entity and related value objects.
+package user
+
+// Role mirrors user_role_enum in the database.
+type Role string
+
+const (
+	RoleCustomer         Role = "customer"
+	RoleAdmin            Role = "admin"
+	RoleKitchen          Role = "kitchen"
+	RoleDelivery         Role = "delivery"
+	RoleInventoryManager Role = "inventory_manager"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// Valid reports whether r is one of the roles above.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleCustomer, RoleAdmin, RoleKitchen, RoleDelivery, RoleInventoryManager:
+		return true
+	}
+	return false
+}
+
+// SeesAllOrders reports whether the role works on other people's orders:
+// admins, the kitchen preparing them and drivers delivering them. Every
+// other role only sees the orders it placed.
+func (r Role) SeesAllOrders() bool {
+	switch r {
+	case RoleAdmin, RoleKitchen, RoleDelivery:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+// Package wallet defines customers' stored-value wallets and gift cards,
+// and the double-entry ledger that records every movement of their money.
+package wallet
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountType mirrors wallet_account_type_enum. Each customer has a
+// user_wallet account; every other type is a single system account.
+type AccountType string
+
+const (
+	AccountUserWallet AccountType = "user_wallet"
+	// AccountProviderClearing is the other side of money taken from or
+	// given back to cards through the payment provider
+	AccountProviderClearing AccountType = "provider_clearing"
+	// AccountGiftCardLiability holds the value of unredeemed gift cards
+	AccountGiftCardLiability AccountType = "gift_card_liability"
+	// AccountOrderSettlement holds what was paid for orders
+	AccountOrderSettlement AccountType = "order_settlement"
+)
+
+// TransactionType mirrors wallet_transaction_type_enum.
+type TransactionType string
+
+const (
+	TransactionTopUp              TransactionType = "top_up"
+	TransactionGiftCardPurchase   TransactionType = "gift_card_purchase"
+	TransactionGiftCardRedemption TransactionType = "gift_card_redemption"
+	TransactionOrderPayment       TransactionType = "order_payment"
+	TransactionOrderRefund        TransactionType = "order_refund"
+)
+
+type Account struct {
+	ID        uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Type      AccountType `gorm:"type:wallet_account_type_enum;not null"`
+	UserID    *uuid.UUID  `gorm:"type:uuid;unique"`
+	Balance   float64     `gorm:"type:decimal(12,2);not null;default:0"`
+	CreatedAt time.Time   `gorm:"autoCreateTime"`
+	UpdatedAt time.Time   `gorm:"autoUpdateTime"`
+}
+
+func (Account) TableName() string {
+	return "wallet_accounts"
+}
+
+// Transaction is a journal entry: postings that move money between
+// accounts and add up to zero.
+type Transaction struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Type              TransactionType `gorm:"type:wallet_transaction_type_enum;not null"`
+	UserID            *uuid.UUID      `gorm:"type:uuid"`
+	OrderID           *uuid.UUID      `gorm:"type:uuid"`
+	GiftCardID        *uuid.UUID      `gorm:"type:uuid"`
+	ProviderReference string          `gorm:"size:255"`
+	Description       string          `gorm:"type:text"`
+	CreatedAt         time.Time       `gorm:"autoCreateTime"`
+
+	Postings []*Posting `gorm:"foreignKey:TransactionID"`
+}
+
+func (Transaction) TableName() string {
+	return "wallet_transactions"
+}
+
+// Posting moves Amount into an account, or out of it when negative.
+// BalanceAfter is the account's balance once the posting is applied.
+type Posting struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TransactionID uuid.UUID `gorm:"type:uuid;not null"`
+	AccountID     uuid.UUID `gorm:"type:uuid;not null"`
+	Amount        float64   `gorm:"type:decimal(12,2);not null"`
+	BalanceAfter  float64   `gorm:"type:decimal(12,2);not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+
+	Transaction *Transaction `gorm:"foreignKey:TransactionID"`
+}
+
+func (Posting) TableName() string {
+	return "wallet_postings"
+}
@@ -0,0 +1,19 @@
+package wallet
+
+import "errors"
+
+var (
+	ErrInsufficientFunds     = errors.New("not enough funds in wallet")
+	ErrUnbalanced            = errors.New("wallet transaction does not balance")
+	ErrInvalidAmount         = errors.New("amount must be positive")
+	ErrInvalidGiftCardAmount = errors.New("gift card amount is out of range")
+	ErrGiftCardNotFound      = errors.New("gift card not found")
+	ErrGiftCardRedeemed      = errors.New("gift card is already redeemed")
+	ErrWalletAmountTooLarge  = errors.New("wallet amount is more than the order total")
+)
+
+// Machine-readable codes returned alongside the error message so clients can
+// react without parsing text.
+const (
+	CodeInsufficientFunds = "INSUFFICIENT_WALLET_FUNDS"
+)
@@ -0,0 +1,119 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// GiftCardStatus mirrors gift_card_status_enum.
+type GiftCardStatus string
+
+const (
+	GiftCardActive   GiftCardStatus = "active"
+	GiftCardRedeemed GiftCardStatus = "redeemed"
+)
+
+// Gift cards are sold for MinGiftCardAmount to MaxGiftCardAmount.
+const (
+	MinGiftCardAmount = 5.0
+	MaxGiftCardAmount = 500.0
+)
+
+// codeAlphabet leaves out characters easily mistaken for one another
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// codeLength is the number of characters in a code, printed in groups of four
+const codeLength = 16
+
+// GiftCard is a code worth Amount that anyone holding it can redeem into
+// their wallet, once. Only a hash of the code is stored.
+type GiftCard struct {
+	ID             uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	CodeHash       string         `gorm:"size:64;not null;unique"`
+	CodeLast4      string         `gorm:"column:code_last4;size:4;not null"`
+	Amount         float64        `gorm:"type:decimal(10,2);not null"`
+	Status         GiftCardStatus `gorm:"type:gift_card_status_enum;not null;default:'active'"`
+	PurchaserID    uuid.UUID      `gorm:"type:uuid;not null"`
+	RecipientName  string         `gorm:"size:255"`
+	RecipientEmail string         `gorm:"size:255"`
+	Message        string         `gorm:"type:text"`
+	SentAt         *time.Time
+	RedeemedBy     *uuid.UUID `gorm:"type:uuid"`
+	RedeemedAt     *time.Time
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+func (GiftCard) TableName() string {
+	return "gift_cards"
+}
+
+// NewGiftCard issues a card and returns it with its code, which can't be
+// recovered later.
+func NewGiftCard(purchaserID uuid.UUID, amount float64, recipientName, recipientEmail, message string) (*GiftCard, string, error) {
+	amount = money.Round(amount)
+	if amount < MinGiftCardAmount || amount > MaxGiftCardAmount {
+		return nil, "", ErrInvalidGiftCardAmount
+	}
+	code, err := newCode()
+	if err != nil {
+		return nil, "", err
+	}
+	return &GiftCard{
+		ID:             uuid.New(),
+		CodeHash:       HashCode(code),
+		CodeLast4:      code[len(code)-4:],
+		Amount:         amount,
+		Status:         GiftCardActive,
+		PurchaserID:    purchaserID,
+		RecipientName:  strings.TrimSpace(recipientName),
+		RecipientEmail: strings.TrimSpace(recipientEmail),
+		Message:        strings.TrimSpace(message),
+	}, code, nil
+}
+
+// Redeem marks the card as redeemed by the user.
+func (g *GiftCard) Redeem(userID uuid.UUID, at time.Time) error {
+	if g.Status != GiftCardActive {
+		return ErrGiftCardRedeemed
+	}
+	g.Status = GiftCardRedeemed
+	g.RedeemedBy = &userID
+	g.RedeemedAt = &at
+	return nil
+}
+
+// HashCode hashes a code as typed: case, spaces and dashes don't matter.
+func HashCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func newCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := 0; i < codeLength; i++ {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(codeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,240 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// OrderPayment is what the ledger recorded as paid for an order: the part
+// paid from the wallet and the part charged to a card.
+type OrderPayment struct {
+	Wallet   float64
+	Card     float64
+	Refunded bool
+}
+
+// Paid reports whether any of the order was paid through the ledger.
+func (p OrderPayment) Paid() bool {
+	return p.Wallet > 0 || p.Card > 0
+}
+
+// Ledger writes the double-entry ledger. Every write must run inside the
+// caller's transaction; wallets are locked before they are debited, so a
+// balance is checked and moved as one step.
+type Ledger struct {
+	repo Repository
+}
+
+func NewLedger(repo Repository) *Ledger {
+	return &Ledger{repo: repo}
+}
+
+// leg is one side of a transaction: amount moves into the account, or out
+// of it when negative
+type leg struct {
+	account *Account
+	amount  float64
+}
+
+// Balance returns what the user has in their wallet, without locking it.
+func (l *Ledger) Balance(ctx context.Context, userID uuid.UUID) (float64, error) {
+	acc, err := l.repo.GetWallet(ctx, userID)
+	if err != nil || acc == nil {
+		return 0, err
+	}
+	return acc.Balance, nil
+}
+
+// TopUp adds money charged to the user's card to their wallet.
+func (l *Ledger) TopUp(ctx context.Context, userID uuid.UUID, amount float64, chargeID string) (*Transaction, error) {
+	wallet, err := l.repo.LockWallet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	clearing, err := l.repo.SystemAccount(ctx, AccountProviderClearing)
+	if err != nil {
+		return nil, err
+	}
+	return l.post(ctx, &Transaction{
+		Type:              TransactionTopUp,
+		UserID:            &userID,
+		ProviderReference: chargeID,
+		Description:       "Wallet top-up",
+	}, leg{clearing, -amount}, leg{wallet, amount})
+}
+
+// PurchaseGiftCard pays for the card with the charge, or from the
+// purchaser's wallet when chargeID is empty.
+func (l *Ledger) PurchaseGiftCard(ctx context.Context, card *GiftCard, chargeID string) (*Transaction, error) {
+	var from *Account
+	var err error
+	if chargeID == "" {
+		from, err = l.repo.LockWallet(ctx, card.PurchaserID)
+	} else {
+		from, err = l.repo.SystemAccount(ctx, AccountProviderClearing)
+	}
+	if err != nil {
+		return nil, err
+	}
+	liability, err := l.repo.SystemAccount(ctx, AccountGiftCardLiability)
+	if err != nil {
+		return nil, err
+	}
+	return l.post(ctx, &Transaction{
+		Type:              TransactionGiftCardPurchase,
+		UserID:            &card.PurchaserID,
+		GiftCardID:        &card.ID,
+		ProviderReference: chargeID,
+		Description:       "Gift card ending " + card.CodeLast4,
+	}, leg{from, -card.Amount}, leg{liability, card.Amount})
+}
+
+// RedeemGiftCard moves the card's value into the user's wallet. The card
+// must already be marked redeemed by them.
+func (l *Ledger) RedeemGiftCard(ctx context.Context, card *GiftCard, userID uuid.UUID) (*Transaction, error) {
+	liability, err := l.repo.SystemAccount(ctx, AccountGiftCardLiability)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := l.repo.LockWallet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return l.post(ctx, &Transaction{
+		Type:        TransactionGiftCardRedemption,
+		UserID:      &userID,
+		GiftCardID:  &card.ID,
+		Description: "Gift card ending " + card.CodeLast4,
+	}, leg{liability, -card.Amount}, leg{wallet, card.Amount})
+}
+
+// PayOrder records paying for the order: o.WalletAmount from the wallet
+// and cardAmount by the charge. It runs in the transaction that saves the
+// order.
+func (l *Ledger) PayOrder(ctx context.Context, o *order.Order, cardAmount float64, chargeID string) (*Transaction, error) {
+	legs := []leg{}
+	if o.WalletAmount > 0 {
+		wallet, err := l.repo.LockWallet(ctx, *o.UserID)
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, leg{wallet, -o.WalletAmount})
+	}
+	if cardAmount > 0 {
+		clearing, err := l.repo.SystemAccount(ctx, AccountProviderClearing)
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, leg{clearing, -cardAmount})
+	}
+	settlement, err := l.repo.SystemAccount(ctx, AccountOrderSettlement)
+	if err != nil {
+		return nil, err
+	}
+	legs = append(legs, leg{settlement, money.Sum(o.WalletAmount, cardAmount)})
+	return l.post(ctx, orderTransaction(o, TransactionOrderPayment, chargeID), legs...)
+}
+
+// OrderPayment returns what the ledger recorded as paid for the order.
+func (l *Ledger) OrderPayment(ctx context.Context, orderID uuid.UUID) (OrderPayment, error) {
+	var paid OrderPayment
+	txs, err := l.repo.ListByOrder(ctx, orderID)
+	if err != nil {
+		return paid, err
+	}
+	clearing, err := l.repo.SystemAccount(ctx, AccountProviderClearing)
+	if err != nil {
+		return paid, err
+	}
+	for _, t := range txs {
+		switch t.Type {
+		case TransactionOrderRefund:
+			paid.Refunded = true
+		case TransactionOrderPayment:
+			for _, p := range t.Postings {
+				if p.Amount >= 0 {
+					continue
+				}
+				if p.AccountID == clearing.ID {
+					paid.Card = money.Sum(paid.Card, -p.Amount)
+				} else {
+					paid.Wallet = money.Sum(paid.Wallet, -p.Amount)
+				}
+			}
+		}
+	}
+	return paid, nil
+}
+
+// RefundOrder gives back what was paid for the order. The wallet part
+// always goes back to the wallet; the card part goes there too when
+// toWallet is set, and otherwise back to the card, with refundID as the
+// provider's reference.
+func (l *Ledger) RefundOrder(
+	ctx context.Context, o *order.Order, paid OrderPayment, toWallet bool, refundID string,
+) (*Transaction, error) {
+	settlement, err := l.repo.SystemAccount(ctx, AccountOrderSettlement)
+	if err != nil {
+		return nil, err
+	}
+	legs := []leg{{settlement, -money.Sum(paid.Wallet, paid.Card)}}
+
+	toWalletAmount := paid.Wallet
+	if toWallet {
+		toWalletAmount = money.Sum(paid.Wallet, paid.Card)
+	} else if paid.Card > 0 {
+		clearing, err := l.repo.SystemAccount(ctx, AccountProviderClearing)
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, leg{clearing, paid.Card})
+	}
+	if toWalletAmount > 0 {
+		wallet, err := l.repo.LockWallet(ctx, *o.UserID)
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, leg{wallet, toWalletAmount})
+	}
+	return l.post(ctx, orderTransaction(o, TransactionOrderRefund, refundID), legs...)
+}
+
+// post checks the legs balance and that no wallet goes negative, then
+// writes them as one transaction
+func (l *Ledger) post(ctx context.Context, t *Transaction, legs ...leg) (*Transaction, error) {
+	var total float64
+	for _, lg := range legs {
+		amount := money.Round(lg.amount)
+		if amount == 0 {
+			continue
+		}
+		if lg.account.Type == AccountUserWallet && money.Sum(lg.account.Balance, amount) < 0 {
+			return nil, ErrInsufficientFunds
+		}
+		total += amount
+		t.Postings = append(t.Postings, &Posting{AccountID: lg.account.ID, Amount: amount})
+	}
+	if len(t.Postings) == 0 {
+		return nil, ErrInvalidAmount
+	}
+	if money.Round(total) != 0 {
+		return nil, ErrUnbalanced
+	}
+	if err := l.repo.CreateTransaction(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func orderTransaction(o *order.Order, t TransactionType, providerReference string) *Transaction {
+	return &Transaction{
+		Type:              t,
+		UserID:            o.UserID,
+		OrderID:           &o.ID,
+		ProviderReference: providerReference,
+		Description:       "Order " + o.OrderNumber,
+	}
+}
@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// GetWallet returns the user's wallet, or nil if they never had one.
+	GetWallet(ctx context.Context, userID uuid.UUID) (*Account, error)
+	// LockWallet opens the user's wallet if needed and locks it until the
+	// surrounding transaction ends.
+	LockWallet(ctx context.Context, userID uuid.UUID) (*Account, error)
+	// SystemAccount returns the system account of the given type.
+	SystemAccount(ctx context.Context, t AccountType) (*Account, error)
+
+	// CreateTransaction inserts the transaction with its postings, applies
+	// them to the account balances and fills in each BalanceAfter.
+	CreateTransaction(ctx context.Context, t *Transaction) error
+	// ListByOrder returns the order's transactions with their postings.
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*Transaction, error)
+	// ListPostings returns the account's postings with their transactions,
+	// newest first.
+	ListPostings(ctx context.Context, accountID uuid.UUID, limit, offset int) ([]*Posting, error)
+}
+
+type GiftCardRepository interface {
+	Create(ctx context.Context, g *GiftCard) error
+	// LockByCode locks the card with the code's hash until the surrounding
+	// transaction ends. It returns nil if there is none.
+	LockByCode(ctx context.Context, codeHash string) (*GiftCard, error)
+	Update(ctx context.Context, g *GiftCard) error
+	// ListByPurchaser returns the cards the user bought, newest first.
+	ListByPurchaser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*GiftCard, error)
+}
@@ -0,0 +1,40 @@
+// Package notification provides notifier implementations.
+package notification
+
+import (
+	"context"
+
+	"github.com/james-wukong/orders-api/internal/domain/notification"
+	"github.com/rs/zerolog"
+)
+
+// LogNotifier writes notifications to the application log. It stands in until
+// a push or email provider is configured.
+type LogNotifier struct {
+	log zerolog.Logger
+}
+
+func NewLogNotifier(log zerolog.Logger) *LogNotifier {
+	return &LogNotifier{log: log}
+}
+
+func (n *LogNotifier) Notify(_ context.Context, msg notification.Message) error {
+	n.write(n.log.Info(), msg)
+	return nil
+}
+
+// Send logs a message addressed to an email address or webhook URL
+func (n *LogNotifier) Send(_ context.Context, to string, msg notification.Message) error {
+	n.write(n.log.Info().Str("to", to), msg)
+	return nil
+}
+
+func (n *LogNotifier) write(event *zerolog.Event, msg notification.Message) {
+	event = event.
+		Str("user_id", msg.UserID.String()).
+		Str("subject", msg.Subject)
+	for k, v := range msg.Data {
+		event = event.Str(k, v)
+	}
+	event.Msg(msg.Body)
+}
@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/notification"
+)
+
+// WebhookSender POSTs messages as JSON to a URL. With a secret, each request
+// carries an X-Signature header: "sha256=" and the hex HMAC-SHA256 of the
+// body, so receivers can check it came from us.
+type WebhookSender struct {
+	client *http.Client
+	secret string
+}
+
+func NewWebhookSender(timeout time.Duration, secret string) *WebhookSender {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &WebhookSender{
+		client: &http.Client{Timeout: timeout},
+		secret: secret,
+	}
+}
+
+type webhookPayload struct {
+	Subject string            `json:"subject"`
+	Body    string            `json:"body"`
+	Data    map[string]string `json:"data,omitempty"`
+	SentAt  string            `json:"sent_at"`
+}
+
+func (s *WebhookSender) Send(ctx context.Context, to string, msg notification.Message) error {
+	payload, err := json.Marshal(webhookPayload{
+		Subject: msg.Subject,
+		Body:    msg.Body,
+		Data:    msg.Data,
+		SentAt:  time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.secret))
+		mac.Write(payload)
+		req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook %s: %w", to, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned %s", to, resp.Status)
+	}
+	return nil
+}
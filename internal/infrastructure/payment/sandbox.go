@@ -0,0 +1,42 @@
+// Package payment provides payment.Provider implementations.
+// The sandbox provider approves every charge without moving money and is
+// meant for local development and tests.
+package payment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+)
+
+// DeclinedToken is the card token the sandbox always declines
+const DeclinedToken = "tok_declined"
+
+type SandboxProvider struct{}
+
+func NewSandboxProvider() *SandboxProvider {
+	return &SandboxProvider{}
+}
+
+func (p *SandboxProvider) Charge(_ context.Context, req payment.ChargeRequest) (*payment.Charge, error) {
+	if req.Amount <= 0 {
+		return nil, payment.ErrInvalidAmount
+	}
+	if req.Token == "" || req.Token == DeclinedToken {
+		return nil, payment.ErrPaymentDeclined
+	}
+	return &payment.Charge{ID: "ch_sandbox_" + uuid.NewString(), Amount: req.Amount}, nil
+}
+
+// Refund derives the reference from the idempotency key, so a retried
+// refund comes back as the same one.
+func (p *SandboxProvider) Refund(_ context.Context, req payment.RefundRequest) (string, error) {
+	if req.Amount <= 0 {
+		return "", payment.ErrInvalidAmount
+	}
+	if req.IdempotencyKey != "" {
+		return "re_sandbox_" + req.IdempotencyKey, nil
+	}
+	return "re_sandbox_" + uuid.NewString(), nil
+}
@@ -0,0 +1,101 @@
+// Package postgres implements the delivery address repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/address"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type addressRepository struct {
+	db *gorm.DB
+}
+
+// NewAddressRepository creates a new instance of the GORM repository
+func NewAddressRepository(db *gorm.DB) address.Repository {
+	return &addressRepository{db: db}
+}
+
+func (r *addressRepository) Create(ctx context.Context, a *address.Address) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if a.IsDefault {
+			if err := clearDefaultAddress(tx, a); err != nil {
+				return err
+			}
+		}
+		return tx.Create(a).Error
+	})
+}
+
+func (r *addressRepository) GetByID(ctx context.Context, id uuid.UUID) (*address.Address, error) {
+	var a address.Address
+	err := conn(ctx, r.db).First(&a, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *addressRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*address.Address, error) {
+	var addresses []*address.Address
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("is_default DESC, updated_at DESC").
+		Find(&addresses).Error
+	return addresses, err
+}
+
+func (r *addressRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&address.Address{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *addressRepository) Update(ctx context.Context, a *address.Address) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if a.IsDefault {
+			if err := clearDefaultAddress(tx, a); err != nil {
+				return err
+			}
+		}
+		return tx.Save(a).Error
+	})
+}
+
+func (r *addressRepository) Delete(ctx context.Context, a *address.Address) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&address.Address{}, "id = ?", a.ID).Error; err != nil {
+			return err
+		}
+		if !a.IsDefault {
+			return nil
+		}
+
+		// Promote the most recently used remaining address
+		var next address.Address
+		err := tx.Where("user_id = ?", a.UserID).Order("updated_at DESC").First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(&next).Update("is_default", true).Error
+	})
+}
+
+// clearDefaultAddress unsets is_default on the user's other addresses. The
+// row locks it takes serialize concurrent default changes for the same user;
+// the partial unique index on (user_id) WHERE is_default is the backstop.
+func clearDefaultAddress(tx *gorm.DB, a *address.Address) error {
+	return tx.Model(&address.Address{}).
+		Where("user_id = ? AND id <> ? AND is_default", a.UserID, a.ID).
+		Update("is_default", false).Error
+}
@@ -0,0 +1,54 @@
+// Package postgres implements the delivery zone repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type deliveryZoneRepository struct {
+	db *gorm.DB
+}
+
+// NewDeliveryZoneRepository creates a new instance of the GORM repository
+func NewDeliveryZoneRepository(db *gorm.DB) delivery.Repository {
+	return &deliveryZoneRepository{db: db}
+}
+
+func (r *deliveryZoneRepository) Create(ctx context.Context, z *delivery.Zone) error {
+	return conn(ctx, r.db).Create(z).Error
+}
+
+func (r *deliveryZoneRepository) GetByID(ctx context.Context, id uuid.UUID) (*delivery.Zone, error) {
+	var z delivery.Zone
+	err := conn(ctx, r.db).First(&z, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &z, nil
+}
+
+func (r *deliveryZoneRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*delivery.Zone, error) {
+	var zones []*delivery.Zone
+	err := conn(ctx, r.db).
+		Where("restaurant_id = ?", restaurantID).
+		Order("priority ASC, delivery_fee ASC").
+		Find(&zones).Error
+	return zones, err
+}
+
+func (r *deliveryZoneRepository) Update(ctx context.Context, z *delivery.Zone) error {
+	return conn(ctx, r.db).Save(z).Error
+}
+
+func (r *deliveryZoneRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&delivery.Zone{}, "id = ?", id).Error
+}
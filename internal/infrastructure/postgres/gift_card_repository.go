@@ -0,0 +1,55 @@
+// Package postgres implements the gift card repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type giftCardRepository struct {
+	db *gorm.DB
+}
+
+// NewGiftCardRepository creates a new instance of the GORM repository
+func NewGiftCardRepository(db *gorm.DB) wallet.GiftCardRepository {
+	return &giftCardRepository{db: db}
+}
+
+func (r *giftCardRepository) Create(ctx context.Context, g *wallet.GiftCard) error {
+	return conn(ctx, r.db).Create(g).Error
+}
+
+func (r *giftCardRepository) LockByCode(ctx context.Context, codeHash string) (*wallet.GiftCard, error) {
+	var g wallet.GiftCard
+	err := conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&g, "code_hash = ?", codeHash).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &g, nil
+}
+
+func (r *giftCardRepository) Update(ctx context.Context, g *wallet.GiftCard) error {
+	return conn(ctx, r.db).Save(g).Error
+}
+
+func (r *giftCardRepository) ListByPurchaser(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]*wallet.GiftCard, error) {
+	var cards []*wallet.GiftCard
+	q := conn(ctx, r.db).Where("purchaser_id = ?", userID)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Offset(offset).Order("created_at DESC, id").Find(&cards).Error
+	return cards, err
+}
@@ -0,0 +1,245 @@
+// Package postgres implements the inventory repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type inventoryItemRepository struct {
+	db *gorm.DB
+}
+
+// NewInventoryItemRepository creates a new instance of the GORM repository
+func NewInventoryItemRepository(db *gorm.DB) inventory.Repository {
+	return &inventoryItemRepository{db: db}
+}
+
+func (r *inventoryItemRepository) Create(ctx context.Context, item *inventory.Item) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(item).Error
+}
+
+func (r *inventoryItemRepository) GetByID(ctx context.Context, id uuid.UUID) (*inventory.Item, error) {
+	return r.first(conn(ctx, r.db), "id = ?", id)
+}
+
+func (r *inventoryItemRepository) LockByID(ctx context.Context, id uuid.UUID) (*inventory.Item, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), "id = ?", id)
+}
+
+func (r *inventoryItemRepository) GetBySKU(
+	ctx context.Context, restaurantID uuid.UUID, sku string,
+) (*inventory.Item, error) {
+	return r.first(conn(ctx, r.db), "restaurant_id = ? AND sku = ?", restaurantID, sku)
+}
+
+func (r *inventoryItemRepository) GetByBarcode(
+	ctx context.Context, restaurantID uuid.UUID, codes []string,
+) (*inventory.Item, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	item, err := r.first(conn(ctx, r.db).Order("is_active DESC"), "restaurant_id = ? AND barcode IN ?", restaurantID, codes)
+	if item != nil || err != nil {
+		return item, err
+	}
+	return r.first(conn(ctx, r.db), "restaurant_id = ? AND sku IN ?", restaurantID, codes)
+}
+
+func (r *inventoryItemRepository) first(db *gorm.DB, query string, args ...any) (*inventory.Item, error) {
+	var item inventory.Item
+	err := db.Preload("Unit").Where(query, args...).First(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *inventoryItemRepository) ListByRestaurant(
+	ctx context.Context, restaurantID uuid.UUID, filter inventory.ItemFilter,
+) ([]*inventory.Item, error) {
+	q := conn(ctx, r.db).Preload("Unit").Where("restaurant_id = ?", restaurantID)
+	if !filter.IncludeInactive {
+		q = q.Where("is_active")
+	}
+	if filter.Search != "" {
+		like := "%" + filter.Search + "%"
+		q = q.Where("name ILIKE ? OR sku ILIKE ?", like, like)
+	}
+	if filter.StorageLocation != "" {
+		q = q.Where("storage_location = ?", filter.StorageLocation)
+	}
+	if filter.CategoryID != nil {
+		q = q.Where("category_id = ?", *filter.CategoryID)
+	}
+
+	var items []*inventory.Item
+	err := q.Order("name").Find(&items).Error
+	return items, err
+}
+
+func (r *inventoryItemRepository) ListBelowReorderPoint(
+	ctx context.Context, restaurantID uuid.UUID,
+) ([]*inventory.Item, error) {
+	var items []*inventory.Item
+	err := conn(ctx, r.db).
+		Preload("Unit").
+		Where("restaurant_id = ? AND is_active", restaurantID).
+		Where("current_stock <= COALESCE(reorder_point, minimum_stock)").
+		Order("name").
+		Find(&items).Error
+	return items, err
+}
+
+func (r *inventoryItemRepository) Update(ctx context.Context, item *inventory.Item) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(item).Error
+}
+
+func (r *inventoryItemRepository) CreateTransaction(ctx context.Context, tx *inventory.Transaction) error {
+	return conn(ctx, r.db).Create(tx).Error
+}
+
+func (r *inventoryItemRepository) CreateAdjustment(ctx context.Context, adj *inventory.Adjustment) error {
+	return conn(ctx, r.db).Create(adj).Error
+}
+
+func (r *inventoryItemRepository) ListTransactions(
+	ctx context.Context, itemID uuid.UUID, limit int,
+) ([]*inventory.Transaction, error) {
+	var txs []*inventory.Transaction
+	err := conn(ctx, r.db).
+		Where("inventory_item_id = ?", itemID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *inventoryItemRepository) ListByReference(
+	ctx context.Context, refType string, refID uuid.UUID,
+) ([]*inventory.Transaction, error) {
+	var txs []*inventory.Transaction
+	err := conn(ctx, r.db).
+		Where("reference_type = ? AND reference_id = ?", refType, refID).
+		Order("created_at ASC").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *inventoryItemRepository) ListLedger(
+	ctx context.Context, restaurantID uuid.UUID, before time.Time,
+) ([]*inventory.Transaction, error) {
+	var txs []*inventory.Transaction
+	err := conn(ctx, r.db).
+		Select("inventory_transactions.*").
+		Joins("JOIN inventory_items ON inventory_items.id = inventory_transactions.inventory_item_id").
+		Where("inventory_items.restaurant_id = ? AND inventory_transactions.created_at < ?", restaurantID, before).
+		Order("inventory_transactions.created_at, inventory_transactions.id").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *inventoryItemRepository) UsageTotals(
+	ctx context.Context, itemIDs []uuid.UUID, from, to time.Time,
+) (map[uuid.UUID]float64, error) {
+	totals := make(map[uuid.UUID]float64, len(itemIDs))
+	if len(itemIDs) == 0 {
+		return totals, nil
+	}
+	var rows []struct {
+		InventoryItemID uuid.UUID
+		TotalUsed       float64
+	}
+	err := conn(ctx, r.db).
+		Table("daily_inventory_usage").
+		Select("inventory_item_id, SUM(total_used) AS total_used").
+		Where("inventory_item_id IN ? AND usage_date >= ? AND usage_date < ?", itemIDs, from, to).
+		Group("inventory_item_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		totals[row.InventoryItemID] = row.TotalUsed
+	}
+	return totals, nil
+}
+
+func (r *inventoryItemRepository) CreateAlert(ctx context.Context, alert *inventory.Alert) error {
+	// idx_stock_alerts_open keeps one open alert per item, type and expiry date
+	return conn(ctx, r.db).Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(alert).Error
+}
+
+func (r *inventoryItemRepository) CreateBatch(ctx context.Context, batch *inventory.Batch) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(batch).Error
+}
+
+func (r *inventoryItemRepository) LockBatches(ctx context.Context, itemID uuid.UUID) ([]*inventory.Batch, error) {
+	var batches []*inventory.Batch
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("inventory_item_id = ? AND quantity_remaining > 0", itemID).
+		Order("expiry_date ASC NULLS LAST, received_at ASC").
+		Find(&batches).Error
+	return batches, err
+}
+
+func (r *inventoryItemRepository) LockBatchesByNumber(
+	ctx context.Context, itemID uuid.UUID, numbers []string,
+) ([]*inventory.Batch, error) {
+	var batches []*inventory.Batch
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("inventory_item_id = ? AND batch_number IN ?", itemID, numbers).
+		Order("received_at ASC").
+		Find(&batches).Error
+	return batches, err
+}
+
+func (r *inventoryItemRepository) UpdateBatch(ctx context.Context, batch *inventory.Batch) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(batch).Error
+}
+
+func (r *inventoryItemRepository) ListExpiringBatches(
+	ctx context.Context, restaurantID uuid.UUID, on time.Time,
+) ([]*inventory.Batch, error) {
+	return r.expiringBatches(conn(ctx, r.db), restaurantID, on)
+}
+
+func (r *inventoryItemRepository) LockExpiringBatches(
+	ctx context.Context, restaurantID uuid.UUID, on time.Time,
+) ([]*inventory.Batch, error) {
+	db := conn(ctx, r.db).Clauses(clause.Locking{
+		Strength: "UPDATE",
+		Table:    clause.Table{Name: "inventory_batches"},
+		Options:  "SKIP LOCKED",
+	})
+	return r.expiringBatches(db, restaurantID, on)
+}
+
+func (r *inventoryItemRepository) expiringBatches(
+	db *gorm.DB, restaurantID uuid.UUID, on time.Time,
+) ([]*inventory.Batch, error) {
+	var batches []*inventory.Batch
+	err := db.
+		Select("inventory_batches.*").
+		Joins("JOIN inventory_items ON inventory_items.id = inventory_batches.inventory_item_id").
+		Preload("Item.Unit").
+		Where("inventory_items.restaurant_id = ? AND inventory_items.is_active", restaurantID).
+		Where("inventory_batches.quantity_remaining > 0 AND inventory_batches.expiry_date IS NOT NULL").
+		Where("inventory_batches.expiry_date <= ?::date + COALESCE(inventory_items.expiry_alert_days, 0)",
+			on.Format(time.DateOnly)).
+		Order("inventory_batches.expiry_date ASC").
+		Find(&batches).Error
+	return batches, err
+}
@@ -0,0 +1,126 @@
+// Package postgres implements the loyalty repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// liveCreditsSQL sums a user's credits unexpired at the bound time. Points
+// earned by an order that was since reversed no longer count.
+const liveCreditsSQL = `
+	SELECT COALESCE(SUM(l.points), 0)
+	FROM loyalty_ledger l
+	WHERE l.user_id = %s
+	  AND l.type IN ('earn', 'redeem_reversal')
+	  AND (l.expires_at IS NULL OR l.expires_at > ?)
+	  AND NOT (l.type = 'earn' AND EXISTS (
+		SELECT 1 FROM loyalty_ledger r
+		WHERE r.order_id = l.order_id AND r.type = 'earn_reversal'
+	  ))`
+
+type loyaltyRepository struct {
+	db *gorm.DB
+}
+
+// NewLoyaltyRepository creates a new instance of the GORM repository
+func NewLoyaltyRepository(db *gorm.DB) loyalty.Repository {
+	return &loyaltyRepository{db: db}
+}
+
+func (r *loyaltyRepository) GetRule(ctx context.Context, restaurantID uuid.UUID) (*loyalty.Rule, error) {
+	var rule loyalty.Rule
+	err := conn(ctx, r.db).First(&rule, "restaurant_id = ?", restaurantID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *loyaltyRepository) SaveRule(ctx context.Context, rule *loyalty.Rule) error {
+	return conn(ctx, r.db).Save(rule).Error
+}
+
+func (r *loyaltyRepository) GetAccount(ctx context.Context, userID uuid.UUID) (*loyalty.Account, error) {
+	var acc loyalty.Account
+	err := conn(ctx, r.db).First(&acc, "user_id = ?", userID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *loyaltyRepository) LockAccount(ctx context.Context, userID uuid.UUID) (*loyalty.Account, error) {
+	db := conn(ctx, r.db)
+	// Opening the account first gives concurrent first-time writers a row
+	// to queue on
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&loyalty.Account{UserID: userID}).Error
+	if err != nil {
+		return nil, err
+	}
+	var acc loyalty.Account
+	err = db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&acc, "user_id = ?", userID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *loyaltyRepository) Append(ctx context.Context, acc *loyalty.Account, e *loyalty.Entry) error {
+	db := conn(ctx, r.db)
+	if err := db.Create(e).Error; err != nil {
+		return err
+	}
+	return db.Model(acc).Update("balance", acc.Balance).Error
+}
+
+func (r *loyaltyRepository) ListEntries(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]*loyalty.Entry, error) {
+	var entries []*loyalty.Entry
+	q := conn(ctx, r.db).Where("user_id = ?", userID)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Offset(offset).Order("created_at DESC, id").Find(&entries).Error
+	return entries, err
+}
+
+func (r *loyaltyRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*loyalty.Entry, error) {
+	var entries []*loyalty.Entry
+	err := conn(ctx, r.db).Where("order_id = ?", orderID).Order("created_at, id").Find(&entries).Error
+	return entries, err
+}
+
+func (r *loyaltyRepository) LivePoints(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	var live int
+	err := conn(ctx, r.db).Raw(fmt.Sprintf(liveCreditsSQL, "?"), userID, at.UTC()).Scan(&live).Error
+	return live, err
+}
+
+func (r *loyaltyRepository) ListExpired(ctx context.Context, at time.Time, limit int) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := conn(ctx, r.db).Raw(`
+		SELECT a.user_id
+		FROM loyalty_accounts a
+		WHERE a.balance > (`+fmt.Sprintf(liveCreditsSQL, "a.user_id")+`)
+		ORDER BY a.user_id
+		LIMIT ?`,
+		at.UTC(), limit,
+	).Scan(&userIDs).Error
+	return userIDs, err
+}
@@ -0,0 +1,67 @@
+// Package postgres implements the menu item repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type menuItemRepository struct {
+	db *gorm.DB
+}
+
+// NewMenuItemRepository creates a new instance of the GORM repository
+func NewMenuItemRepository(db *gorm.DB) menu.Repository {
+	return &menuItemRepository{db: db}
+}
+
+func (r *menuItemRepository) GetByID(ctx context.Context, id uuid.UUID) (*menu.MenuItem, error) {
+	var item menu.MenuItem
+	err := conn(ctx, r.db).First(&item, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *menuItemRepository) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*menu.MenuItem, error) {
+	var items []*menu.MenuItem
+	if len(ids) == 0 {
+		return items, nil
+	}
+	err := conn(ctx, r.db).Where("id IN ?", ids).Find(&items).Error
+	return items, err
+}
+
+func (r *menuItemRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*menu.MenuItem, error) {
+	var items []*menu.MenuItem
+	err := conn(ctx, r.db).
+		Where("restaurant_id = ?", restaurantID).
+		Order("display_order, name").
+		Find(&items).Error
+	return items, err
+}
+
+func (r *menuItemRepository) RefreshAvailability(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Exec("SELECT refresh_menu_item_availability(?)", id).Error
+}
+
+func (r *menuItemRepository) ListAvailabilityChanges(
+	ctx context.Context, id uuid.UUID, limit int,
+) ([]*menu.AvailabilityChange, error) {
+	var changes []*menu.AvailabilityChange
+	err := conn(ctx, r.db).
+		Where("menu_item_id = ?", id).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&changes).Error
+	return changes, err
+}
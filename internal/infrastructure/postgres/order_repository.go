@@ -0,0 +1,138 @@
+// Package postgres implements the order repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type orderRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderRepository creates a new instance of the GORM repository
+func NewOrderRepository(db *gorm.DB) order.Repository {
+	return &orderRepository{db: db}
+}
+
+func (r *orderRepository) Create(ctx context.Context, o *order.Order) error {
+	// GORM inserts the order and its items in a single transaction.
+	// order_number is generated by the set_order_number trigger, so read it back.
+	return conn(ctx, r.db).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "order_number"}}}).
+		Create(o).Error
+}
+
+func (r *orderRepository) GetByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	var o order.Order
+	err := conn(ctx, r.db).Preload("Items").First(&o, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+func (r *orderRepository) LockByID(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+	var o order.Order
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Items").
+		First(&o, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+func (r *orderRepository) Update(ctx context.Context, o *order.Order) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(o).Error
+}
+
+func (r *orderRepository) LockNextDueForRelease(ctx context.Context, now time.Time) (*order.Order, error) {
+	var o order.Order
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Preload("Items").
+		Where("status = ? AND release_at IS NOT NULL AND release_at <= ?", order.StatusPending, now).
+		Order("release_at ASC").
+		First(&o).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+func (r *orderRepository) CountInKitchen(ctx context.Context, restaurantID, excludeID uuid.UUID) (int, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&order.Order{}).
+		Where("restaurant_id = ? AND id <> ? AND status IN ?",
+			restaurantID, excludeID, []order.Status{order.StatusConfirmed, order.StatusPreparing}).
+		Count(&count).Error
+	return int(count), err
+}
+
+func (r *orderRepository) ETAAccuracy(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (*order.ETAAccuracy, error) {
+	var row struct {
+		Orders              int
+		MeanErrorMinutes    float64
+		MeanAbsErrorMinutes float64
+		OnTimeRate          float64
+	}
+	err := conn(ctx, r.db).Raw(`
+		SELECT COUNT(*) AS orders,
+			COALESCE(AVG(error_minutes), 0) AS mean_error_minutes,
+			COALESCE(AVG(ABS(error_minutes)), 0) AS mean_abs_error_minutes,
+			COALESCE(AVG(CASE WHEN error_minutes <= ? THEN 1.0 ELSE 0.0 END), 0) AS on_time_rate
+		FROM order_eta_accuracy
+		WHERE restaurant_id = ? AND delivered_at >= ? AND delivered_at < ?`,
+		order.OnTimeTolerance.Minutes(), restaurantID, from, to,
+	).Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order.ETAAccuracy{
+		From:                from,
+		To:                  to,
+		Orders:              row.Orders,
+		MeanErrorMinutes:    row.MeanErrorMinutes,
+		MeanAbsErrorMinutes: row.MeanAbsErrorMinutes,
+		OnTimeRate:          row.OnTimeRate,
+	}, nil
+}
+
+func (r *orderRepository) ListByTableSession(ctx context.Context, sessionID uuid.UUID) ([]*order.Order, error) {
+	var orders []*order.Order
+	err := conn(ctx, r.db).
+		Preload("Items").
+		Where("table_session_id = ?", sessionID).
+		Order("created_at ASC, id").
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *orderRepository) SetTableNumber(ctx context.Context, sessionID uuid.UUID, number string) error {
+	return conn(ctx, r.db).
+		Model(&order.Order{}).
+		Where("table_session_id = ? AND status NOT IN ?",
+			sessionID, []order.Status{order.StatusDelivered, order.StatusCancelled}).
+		Update("table_number", number).Error
+}
@@ -0,0 +1,73 @@
+// Package postgres implements the pre-order slot repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type orderSlotRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderSlotRepository creates a new instance of the GORM repository
+func NewOrderSlotRepository(db *gorm.DB) order.SlotRepository {
+	return &orderSlotRepository{db: db}
+}
+
+// Reserve relies on a single conditional upsert, so concurrent reservations
+// from any number of replicas can never push a slot over capacity.
+func (r *orderSlotRepository) Reserve(ctx context.Context, restaurantID uuid.UUID, start time.Time, capacity int) error {
+	res := conn(ctx, r.db).Exec(`
+		INSERT INTO order_slots (restaurant_id, slot_start, reserved)
+		SELECT CAST(? AS UUID), CAST(? AS TIMESTAMP), 1 WHERE CAST(? AS INTEGER) > 0
+		ON CONFLICT (restaurant_id, slot_start)
+		DO UPDATE SET reserved = order_slots.reserved + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE order_slots.reserved < ?`,
+		restaurantID, start.UTC(), capacity, capacity,
+	)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return order.ErrSlotFull
+	}
+	return nil
+}
+
+func (r *orderSlotRepository) Release(ctx context.Context, restaurantID uuid.UUID, start time.Time) error {
+	return conn(ctx, r.db).Exec(`
+		UPDATE order_slots
+		SET reserved = reserved - 1, updated_at = CURRENT_TIMESTAMP
+		WHERE restaurant_id = ? AND slot_start = ? AND reserved > 0`,
+		restaurantID, start.UTC(),
+	).Error
+}
+
+func (r *orderSlotRepository) ListReserved(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (map[time.Time]int, error) {
+	var rows []struct {
+		SlotStart time.Time
+		Reserved  int
+	}
+	err := conn(ctx, r.db).
+		Table("order_slots").
+		Select("slot_start, reserved").
+		Where("restaurant_id = ? AND slot_start >= ? AND slot_start < ?", restaurantID, from.UTC(), to.UTC()).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make(map[time.Time]int, len(rows))
+	for _, row := range rows {
+		reserved[row.SlotStart.UTC()] = row.Reserved
+	}
+	return reserved, nil
+}
@@ -0,0 +1,139 @@
+// Package postgres implements the purchase order repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type purchaseOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewPurchaseOrderRepository creates a new instance of the GORM repository
+func NewPurchaseOrderRepository(db *gorm.DB) purchasing.Repository {
+	return &purchaseOrderRepository{db: db}
+}
+
+func (r *purchaseOrderRepository) Create(ctx context.Context, po *purchasing.PurchaseOrder) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// po_number is generated by the set_po_number trigger, so read it back.
+		err := tx.Omit(clause.Associations).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "po_number"}}}).
+			Create(po).Error
+		if err != nil || len(po.Lines) == 0 {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(po.Lines).Error
+	})
+}
+
+func (r *purchaseOrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrder, error) {
+	return r.first(conn(ctx, r.db), id)
+}
+
+func (r *purchaseOrderRepository) LockByID(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrder, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *purchaseOrderRepository) first(db *gorm.DB, id uuid.UUID) (*purchasing.PurchaseOrder, error) {
+	var po purchasing.PurchaseOrder
+	err := withPurchaseOrderLines(db).First(&po, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &po, nil
+}
+
+func (r *purchaseOrderRepository) ListByRestaurant(
+	ctx context.Context, restaurantID uuid.UUID, filter purchasing.Filter,
+) ([]*purchasing.PurchaseOrder, error) {
+	var orders []*purchasing.PurchaseOrder
+	q := withPurchaseOrderLines(conn(ctx, r.db)).Where("restaurant_id = ?", restaurantID)
+	if filter.SupplierID != nil {
+		q = q.Where("supplier_id = ?", *filter.SupplierID)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	err := q.Order("created_at DESC").Find(&orders).Error
+	return orders, err
+}
+
+func (r *purchaseOrderRepository) Update(ctx context.Context, po *purchasing.PurchaseOrder) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(po).Error; err != nil {
+			return err
+		}
+		for _, l := range po.Lines {
+			if err := tx.Omit(clause.Associations).Save(l).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *purchaseOrderRepository) ReplaceLines(ctx context.Context, po *purchasing.PurchaseOrder) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(po).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&purchasing.Line{}, "purchase_order_id = ?", po.ID).Error; err != nil {
+			return err
+		}
+		if len(po.Lines) == 0 {
+			return nil
+		}
+		return tx.Omit(clause.Associations).Create(po.Lines).Error
+	})
+}
+
+func withPurchaseOrderLines(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at, id")
+		}).
+		Preload("Lines.Item.Unit")
+}
+
+func (r *purchaseOrderRepository) OnOrder(ctx context.Context, restaurantID uuid.UUID) (map[uuid.UUID]float64, error) {
+	var rows []struct {
+		InventoryItemID uuid.UUID
+		Outstanding     float64
+	}
+	err := conn(ctx, r.db).
+		Table("purchase_order_items poi").
+		Select("poi.inventory_item_id, SUM(poi.quantity_ordered - poi.quantity_received) AS outstanding").
+		Joins("JOIN purchase_orders po ON po.id = poi.purchase_order_id").
+		Where("po.restaurant_id = ? AND po.status IN ?", restaurantID, []purchasing.Status{
+			purchasing.StatusDraft, purchasing.StatusSubmitted, purchasing.StatusApproved, purchasing.StatusOrdered,
+		}).
+		Group("poi.inventory_item_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	onOrder := make(map[uuid.UUID]float64, len(rows))
+	for _, row := range rows {
+		onOrder[row.InventoryItemID] = row.Outstanding
+	}
+	return onOrder, nil
+}
+
+func (r *purchaseOrderRepository) TryLockReorders(ctx context.Context, restaurantID uuid.UUID) (bool, error) {
+	var locked bool
+	err := conn(ctx, r.db).
+		Raw("SELECT pg_try_advisory_xact_lock(hashtext(?))", "reorders:"+restaurantID.String()).
+		Scan(&locked).Error
+	return locked, err
+}
@@ -0,0 +1,86 @@
+// Package postgres implements the recipe repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type recipeRepository struct {
+	db *gorm.DB
+}
+
+// NewRecipeRepository creates a new instance of the GORM repository
+func NewRecipeRepository(db *gorm.DB) recipe.Repository {
+	return &recipeRepository{db: db}
+}
+
+// withIngredients preloads everything needed to deduct and cost a recipe
+func withIngredients(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("MenuItem").
+		Preload("Ingredients", func(db *gorm.DB) *gorm.DB {
+			return db.Order("ingredient_order, created_at")
+		}).
+		Preload("Ingredients.Item.Unit").
+		Preload("Ingredients.Unit")
+}
+
+func (r *recipeRepository) GetByMenuItem(ctx context.Context, menuItemID uuid.UUID) (*recipe.Recipe, error) {
+	var rec recipe.Recipe
+	err := withIngredients(conn(ctx, r.db)).First(&rec, "menu_item_id = ?", menuItemID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *recipeRepository) ListByMenuItems(ctx context.Context, menuItemIDs []uuid.UUID) ([]*recipe.Recipe, error) {
+	var recipes []*recipe.Recipe
+	if len(menuItemIDs) == 0 {
+		return recipes, nil
+	}
+	err := withIngredients(conn(ctx, r.db)).
+		Where("menu_item_id IN ?", menuItemIDs).
+		Find(&recipes).Error
+	return recipes, err
+}
+
+func (r *recipeRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*recipe.Recipe, error) {
+	var recipes []*recipe.Recipe
+	err := withIngredients(conn(ctx, r.db)).
+		Joins("JOIN menu_items mi ON mi.id = recipes.menu_item_id").
+		Where("mi.restaurant_id = ?", restaurantID).
+		Order("mi.name").
+		Find(&recipes).Error
+	return recipes, err
+}
+
+func (r *recipeRepository) Save(ctx context.Context, rec *recipe.Recipe) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(rec).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&recipe.Ingredient{}, "recipe_id = ?", rec.ID).Error; err != nil {
+			return err
+		}
+		if len(rec.Ingredients) == 0 {
+			return nil
+		}
+		return tx.Omit(clause.Associations).Create(rec.Ingredients).Error
+	})
+}
+
+func (r *recipeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	// recipe_ingredients cascade
+	return conn(ctx, r.db).Delete(&recipe.Recipe{}, "id = ?", id).Error
+}
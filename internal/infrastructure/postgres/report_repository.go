@@ -0,0 +1,212 @@
+// Package postgres implements the report repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new instance of the GORM repository
+func NewReportRepository(db *gorm.DB) report.Repository {
+	return &reportRepository{db: db}
+}
+
+// salesColumns aggregates order_summary rows into report.Sales
+const salesColumns = `COUNT(*) AS orders,
+	COALESCE(SUM(item_quantity), 0)::bigint AS items,
+	COALESCE(SUM(subtotal), 0) AS subtotal,
+	COALESCE(SUM(discount), 0) AS discount,
+	COALESCE(SUM(total), 0) AS total`
+
+// localCreatedAt is when an order was placed on its restaurant's clock.
+// created_at is stored in UTC; it needs restaurants joined as r.
+const localCreatedAt = `((order_summary.created_at AT TIME ZONE 'UTC') AT TIME ZONE COALESCE(r.timezone, 'UTC'))`
+
+// sales scopes order_summary to the period's orders that weren't cancelled
+func (r *reportRepository) sales(ctx context.Context, p report.Period) *gorm.DB {
+	return conn(ctx, r.db).
+		Table("order_summary").
+		Where("order_summary.restaurant_id = ? AND order_summary.created_at >= ? AND order_summary.created_at < ?",
+			p.RestaurantID, p.From.UTC(), p.To.UTC()).
+		Where("order_summary.status <> ?", order.StatusCancelled)
+}
+
+// localSales is sales with the restaurant joined, to cut by localCreatedAt
+func (r *reportRepository) localSales(ctx context.Context, p report.Period) *gorm.DB {
+	return r.sales(ctx, p).Joins("JOIN restaurants r ON r.id = order_summary.restaurant_id")
+}
+
+func (r *reportRepository) SalesByDay(ctx context.Context, p report.Period) ([]*report.DailySales, error) {
+	var rows []*report.DailySales
+	err := r.localSales(ctx, p).
+		Select("DATE(" + localCreatedAt + ") AS day, " + salesColumns).
+		Group("DATE(" + localCreatedAt + ")").
+		Order("day").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) SalesByHour(ctx context.Context, p report.Period) ([]*report.HourlySales, error) {
+	var rows []*report.HourlySales
+	err := r.localSales(ctx, p).
+		Select("EXTRACT(HOUR FROM " + localCreatedAt + ")::int AS hour, " + salesColumns).
+		Group("EXTRACT(HOUR FROM " + localCreatedAt + ")").
+		Order("hour").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) SalesByItem(ctx context.Context, p report.Period) ([]*report.ItemSales, error) {
+	var rows []*report.ItemSales
+	err := conn(ctx, r.db).Raw(`
+		SELECT mi.id AS menu_item_id,
+			mi.name AS item_name,
+			c.id AS category_id,
+			COALESCE(c.name, '') AS category_name,
+			SUM(oi.quantity)::bigint AS quantity,
+			COUNT(DISTINCT oi.order_id) AS orders,
+			SUM(oi.subtotal) AS revenue
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		JOIN menu_items mi ON mi.id = oi.menu_item_id
+		LEFT JOIN categories c ON c.id = mi.category_id
+		WHERE o.restaurant_id = ? AND o.created_at >= ? AND o.created_at < ? AND o.status <> ?
+		GROUP BY mi.id, mi.name, c.id, c.name
+		ORDER BY revenue DESC, mi.name`,
+		p.RestaurantID, p.From.UTC(), p.To.UTC(), order.StatusCancelled,
+	).Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) SalesByCategory(ctx context.Context, p report.Period) ([]*report.CategorySales, error) {
+	var rows []*report.CategorySales
+	err := conn(ctx, r.db).Raw(`
+		SELECT c.id AS category_id,
+			COALESCE(c.name, '') AS category_name,
+			SUM(oi.quantity)::bigint AS quantity,
+			COUNT(DISTINCT oi.order_id) AS orders,
+			SUM(oi.subtotal) AS revenue
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		JOIN menu_items mi ON mi.id = oi.menu_item_id
+		LEFT JOIN categories c ON c.id = mi.category_id
+		WHERE o.restaurant_id = ? AND o.created_at >= ? AND o.created_at < ? AND o.status <> ?
+		GROUP BY c.id, c.name
+		ORDER BY revenue DESC, c.name NULLS LAST`,
+		p.RestaurantID, p.From.UTC(), p.To.UTC(), order.StatusCancelled,
+	).Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) SalesByOrderType(ctx context.Context, p report.Period) ([]*report.OrderTypeSales, error) {
+	var rows []*report.OrderTypeSales
+	err := r.sales(ctx, p).
+		Select("order_type, " + salesColumns).
+		Group("order_type").
+		Order("orders DESC, order_type").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) Cancellations(ctx context.Context, p report.Period) (*report.Cancellations, error) {
+	c := &report.Cancellations{Period: p}
+	scope := func() *gorm.DB {
+		return conn(ctx, r.db).
+			Table("order_summary").
+			Where("restaurant_id = ? AND created_at >= ? AND created_at < ?", p.RestaurantID, p.From.UTC(), p.To.UTC())
+	}
+
+	var totals struct {
+		Placed    int
+		Cancelled int
+		Value     float64
+	}
+	err := scope().
+		Select(`COUNT(*) AS placed,
+			COUNT(*) FILTER (WHERE status = ?) AS cancelled,
+			COALESCE(SUM(total) FILTER (WHERE status = ?), 0) AS value`,
+			order.StatusCancelled, order.StatusCancelled).
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	c.Placed, c.Cancelled, c.Value = totals.Placed, totals.Cancelled, totals.Value
+
+	err = scope().
+		Select(`COALESCE(TRIM(cancellation_reason), '') AS reason,
+			COUNT(*) AS orders,
+			COALESCE(SUM(total), 0) AS value`).
+		Where("status = ?", order.StatusCancelled).
+		Group("COALESCE(TRIM(cancellation_reason), '')").
+		Order("orders DESC, reason").
+		Scan(&c.Reasons).Error
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *reportRepository) InventoryValue(ctx context.Context, restaurantID uuid.UUID) ([]*report.StockValue, error) {
+	var rows []*report.StockValue
+	err := conn(ctx, r.db).Raw(`
+		SELECT iv.id AS inventory_item_id,
+			iv.name,
+			COALESCE(iv.current_stock, 0) AS current_stock,
+			COALESCE(iv.average_cost, 0) AS average_cost,
+			COALESCE(iv.total_value, 0) AS total_value,
+			COALESCE(iv.unit, '') AS unit,
+			COALESCE(iv.category_name, '') AS category_name,
+			COALESCE(iv.supplier_name, '') AS supplier_name,
+			ls.id IS NOT NULL AS low_stock
+		FROM inventory_value iv
+		LEFT JOIN low_stock_items ls ON ls.id = iv.id
+		WHERE iv.restaurant_id = ?
+		ORDER BY iv.name`,
+		restaurantID,
+	).Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) LowStock(ctx context.Context, restaurantID uuid.UUID) ([]*report.LowStockItem, error) {
+	var rows []*report.LowStockItem
+	err := conn(ctx, r.db).
+		Table("low_stock_items").
+		Select(`id AS inventory_item_id,
+			name,
+			COALESCE(current_stock, 0) AS current_stock,
+			COALESCE(minimum_stock, 0) AS minimum_stock,
+			reorder_point,
+			reorder_quantity,
+			COALESCE(unit, '') AS unit,
+			COALESCE(supplier_name, '') AS supplier_name,
+			COALESCE(supplier_phone, '') AS supplier_phone`).
+		Where("restaurant_id = ?", restaurantID).
+		Order("name").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *reportRepository) Usage(ctx context.Context, p report.Period) ([]*report.Usage, error) {
+	var rows []*report.Usage
+	err := conn(ctx, r.db).
+		Table("daily_inventory_usage").
+		Select(`usage_date AS day,
+			inventory_item_id,
+			ingredient_name AS item_name,
+			COALESCE(unit, '') AS unit,
+			total_used AS used,
+			COALESCE(total_cost, 0) AS cost`).
+		Where("restaurant_id = ? AND usage_date >= ? AND usage_date < ?", p.RestaurantID, p.From, p.To).
+		Order("usage_date, ingredient_name").
+		Scan(&rows).Error
+	return rows, err
+}
@@ -57,7 +57,9 @@ func (r *restaurantRepository) List(ctx context.Context, limit, offset int) ([]*
 func (r *restaurantRepository) Update(ctx context.Context, res *restaurant.Restaurant) error {
 	// Updates current record, only updating non-zero fields
 	// If you want to update all fields (including zeros), use .Save(res)
-	return r.db.WithContext(ctx).Save(res).Error
+	// Rating and TotalReviews are kept by the review repository; saving a
+	// stale copy would undo reviews made since it was read
+	return r.db.WithContext(ctx).Omit("Rating", "TotalReviews").Save(res).Error
 }
 
 func (r *restaurantRepository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -0,0 +1,140 @@
+// Package postgres implements the review repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewRepository creates a new instance of the GORM repository
+func NewReviewRepository(db *gorm.DB) review.Repository {
+	return &reviewRepository{db: db}
+}
+
+func (r *reviewRepository) Create(ctx context.Context, rv *review.Review) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(rv).Error; err != nil || len(rv.Items) == 0 {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(rv.Items).Error
+	})
+}
+
+func (r *reviewRepository) GetByID(ctx context.Context, id uuid.UUID) (*review.Review, error) {
+	return r.first(conn(ctx, r.db), "id = ?", id)
+}
+
+func (r *reviewRepository) LockByID(ctx context.Context, id uuid.UUID) (*review.Review, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), "id = ?", id)
+}
+
+func (r *reviewRepository) GetByOrder(ctx context.Context, orderID uuid.UUID) (*review.Review, error) {
+	return r.first(conn(ctx, r.db), "order_id = ?", orderID)
+}
+
+func (r *reviewRepository) first(db *gorm.DB, query string, args ...any) (*review.Review, error) {
+	var rv review.Review
+	err := withItemRatings(db).Where(query, args...).First(&rv).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &rv, nil
+}
+
+func (r *reviewRepository) ListByRestaurant(
+	ctx context.Context, restaurantID uuid.UUID, filter review.Filter,
+) ([]*review.Review, error) {
+	var reviews []*review.Review
+	q := withItemRatings(conn(ctx, r.db)).Where("restaurant_id = ?", restaurantID)
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if filter.Rating != 0 {
+		q = q.Where("rating = ?", filter.Rating)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+	err := q.Offset(filter.Offset).Order("created_at DESC, id").Find(&reviews).Error
+	return reviews, err
+}
+
+func (r *reviewRepository) Update(ctx context.Context, rv *review.Review) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(rv).Error
+}
+
+func (r *reviewRepository) RefreshRestaurantRating(ctx context.Context, restaurantID uuid.UUID) error {
+	db := conn(ctx, r.db)
+	// The lock is its own statement so the update below reads reviews
+	// committed by whoever held it before
+	var locked restaurant.Restaurant
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&locked, "id = ?", restaurantID).Error
+	if err != nil {
+		return err
+	}
+	return db.Exec(`
+		UPDATE restaurants SET (rating, total_reviews) = (
+			SELECT COALESCE(ROUND(AVG(rv.rating), 2), 0), COUNT(*)
+			FROM reviews rv
+			WHERE rv.restaurant_id = ? AND rv.status = ?
+		)
+		WHERE id = ?`,
+		restaurantID, review.StatusVisible, restaurantID,
+	).Error
+}
+
+func (r *reviewRepository) Summarize(ctx context.Context, restaurantID uuid.UUID) (*review.Summary, error) {
+	var rows []struct {
+		Rating  int
+		Reviews int
+	}
+	err := conn(ctx, r.db).
+		Model(&review.Review{}).
+		Select("rating, COUNT(*) AS reviews").
+		Where("restaurant_id = ? AND status = ?", restaurantID, review.StatusVisible).
+		Group("rating").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &review.Summary{Stars: make(map[int]int, review.MaxRating)}
+	for stars := review.MinRating; stars <= review.MaxRating; stars++ {
+		summary.Stars[stars] = 0
+	}
+	var total int
+	for _, row := range rows {
+		summary.Stars[row.Rating] = row.Reviews
+		summary.TotalReviews += row.Reviews
+		total += row.Rating * row.Reviews
+	}
+	if summary.TotalReviews > 0 {
+		summary.Rating = float64(total) / float64(summary.TotalReviews)
+	}
+	return summary, nil
+}
+
+// withItemRatings preloads item ratings with the order lines they rate
+func withItemRatings(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("review_item_ratings.created_at, review_item_ratings.id")
+		}).
+		Preload("Items.OrderItem")
+}
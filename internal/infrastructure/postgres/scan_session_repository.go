@@ -0,0 +1,137 @@
+// Package postgres implements the scan session repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type scanSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewScanSessionRepository creates a new instance of the GORM repository
+func NewScanSessionRepository(db *gorm.DB) scan.Repository {
+	return &scanSessionRepository{db: db}
+}
+
+func (r *scanSessionRepository) Create(ctx context.Context, s *scan.Session) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(s).Error; err != nil || len(s.Lines) == 0 {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(s.Lines).Error
+	})
+}
+
+func (r *scanSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*scan.Session, error) {
+	return r.first(conn(ctx, r.db), id)
+}
+
+func (r *scanSessionRepository) LockByID(ctx context.Context, id uuid.UUID) (*scan.Session, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *scanSessionRepository) first(db *gorm.DB, id uuid.UUID) (*scan.Session, error) {
+	var s scan.Session
+	err := withScanLines(db).First(&s, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *scanSessionRepository) ListByRestaurant(
+	ctx context.Context, restaurantID uuid.UUID, filter scan.Filter,
+) ([]*scan.Session, error) {
+	var sessions []*scan.Session
+	q := withScanLines(conn(ctx, r.db)).Where("restaurant_id = ?", restaurantID)
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if filter.Type != "" {
+		q = q.Where("type = ?", filter.Type)
+	}
+	err := q.Order("created_at DESC").Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *scanSessionRepository) Update(ctx context.Context, s *scan.Session) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(s).Error; err != nil {
+			return err
+		}
+		for _, l := range s.Lines {
+			if err := tx.Omit(clause.Associations).Save(l).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *scanSessionRepository) CreateUnknown(ctx context.Context, u *scan.UnknownBarcode) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(u).Error
+}
+
+func (r *scanSessionRepository) GetUnknown(ctx context.Context, id uuid.UUID) (*scan.UnknownBarcode, error) {
+	var u scan.UnknownBarcode
+	err := conn(ctx, r.db).First(&u, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *scanSessionRepository) ListUnknown(
+	ctx context.Context, restaurantID uuid.UUID, status scan.UnknownStatus,
+) ([]*scan.UnknownBarcode, error) {
+	var unknown []*scan.UnknownBarcode
+	q := conn(ctx, r.db).Where("restaurant_id = ?", restaurantID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("created_at DESC").Find(&unknown).Error
+	return unknown, err
+}
+
+func (r *scanSessionRepository) LockPendingUnknown(
+	ctx context.Context, restaurantID uuid.UUID, barcode string,
+) ([]*scan.UnknownBarcode, error) {
+	var unknown []*scan.UnknownBarcode
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("restaurant_id = ? AND barcode = ? AND status = ?", restaurantID, barcode, scan.UnknownPending).
+		Order("created_at").
+		Find(&unknown).Error
+	return unknown, err
+}
+
+func (r *scanSessionRepository) UpdateUnknown(ctx context.Context, u *scan.UnknownBarcode) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(u).Error
+}
+
+// withScanLines preloads lines in the order they were first scanned, and
+// the unknown barcodes still pending
+func withScanLines(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("scan_session_lines.created_at, scan_session_lines.id")
+		}).
+		Preload("Lines.Item.Unit").
+		Preload("Unknown", func(db *gorm.DB) *gorm.DB {
+			return db.Where("status = ?", scan.UnknownPending).Order("unknown_barcodes.created_at")
+		})
+}
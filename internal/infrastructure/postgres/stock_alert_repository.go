@@ -0,0 +1,153 @@
+// Package postgres implements the stock alert repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type stockAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewStockAlertRepository creates a new instance of the GORM repository
+func NewStockAlertRepository(db *gorm.DB) inventory.AlertRepository {
+	return &stockAlertRepository{db: db}
+}
+
+func (r *stockAlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*inventory.Alert, error) {
+	return r.first(conn(ctx, r.db), id)
+}
+
+func (r *stockAlertRepository) LockByID(ctx context.Context, id uuid.UUID) (*inventory.Alert, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *stockAlertRepository) first(db *gorm.DB, id uuid.UUID) (*inventory.Alert, error) {
+	var alert inventory.Alert
+	err := db.Preload("Item.Unit").Where("id = ?", id).First(&alert).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *stockAlertRepository) List(
+	ctx context.Context, restaurantID uuid.UUID, filter inventory.AlertFilter,
+) ([]*inventory.Alert, error) {
+	q := conn(ctx, r.db).
+		Select("stock_alerts.*").
+		Joins("JOIN inventory_items ON inventory_items.id = stock_alerts.inventory_item_id").
+		Preload("Item.Unit").
+		Where("inventory_items.restaurant_id = ?", restaurantID)
+	switch filter.Status {
+	case inventory.AlertStatusAll:
+	case inventory.AlertStatusResolved:
+		q = q.Where("stock_alerts.is_resolved")
+	case inventory.AlertStatusUnacknowledged:
+		q = q.Where("NOT stock_alerts.is_resolved AND stock_alerts.acknowledged_at IS NULL")
+	default:
+		q = q.Where("NOT stock_alerts.is_resolved")
+	}
+	if filter.Type != "" {
+		q = q.Where("stock_alerts.alert_type = ?", filter.Type)
+	}
+	if filter.InventoryItemID != nil {
+		q = q.Where("stock_alerts.inventory_item_id = ?", *filter.InventoryItemID)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	var alerts []*inventory.Alert
+	err := q.Order("stock_alerts.created_at DESC").Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *stockAlertRepository) Update(ctx context.Context, alert *inventory.Alert) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(alert).Error
+}
+
+func (r *stockAlertRepository) LockUnnotified(
+	ctx context.Context, now time.Time, maxAttempts, limit int,
+) ([]*inventory.Alert, error) {
+	var alerts []*inventory.Alert
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Preload("Item.Unit").
+		Where("notified_at IS NULL AND notify_attempts < ?", maxAttempts).
+		Where("notify_claimed_until IS NULL OR notify_claimed_until <= ?", now).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *stockAlertRepository) MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return conn(ctx, r.db).Model(&inventory.Alert{}).Where("id = ?", id).
+		Updates(map[string]any{"notified_at": at, "notify_claimed_until": nil}).Error
+}
+
+func (r *stockAlertRepository) ReleaseClaim(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Model(&inventory.Alert{}).Where("id = ?", id).
+		Update("notify_claimed_until", nil).Error
+}
+
+func (r *stockAlertRepository) CreateSubscription(ctx context.Context, sub *inventory.AlertSubscription) error {
+	res := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(sub)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return inventory.ErrDuplicateSubscription
+	}
+	return nil
+}
+
+func (r *stockAlertRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*inventory.AlertSubscription, error) {
+	var sub inventory.AlertSubscription
+	err := conn(ctx, r.db).Where("id = ?", id).First(&sub).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (r *stockAlertRepository) ListSubscriptions(
+	ctx context.Context, restaurantID, userID uuid.UUID,
+) ([]*inventory.AlertSubscription, error) {
+	var subs []*inventory.AlertSubscription
+	err := conn(ctx, r.db).
+		Where("restaurant_id = ? AND user_id = ?", restaurantID, userID).
+		Order("created_at").
+		Find(&subs).Error
+	return subs, err
+}
+
+func (r *stockAlertRepository) ListActiveSubscriptions(
+	ctx context.Context, restaurantID uuid.UUID,
+) ([]*inventory.AlertSubscription, error) {
+	var subs []*inventory.AlertSubscription
+	err := conn(ctx, r.db).
+		Where("restaurant_id = ? AND is_active", restaurantID).
+		Order("created_at").
+		Find(&subs).Error
+	return subs, err
+}
+
+func (r *stockAlertRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&inventory.AlertSubscription{}, "id = ?", id).Error
+}
@@ -0,0 +1,98 @@
+// Package postgres implements the stock transfer repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type stockTransferRepository struct {
+	db *gorm.DB
+}
+
+// NewStockTransferRepository creates a new instance of the GORM repository
+func NewStockTransferRepository(db *gorm.DB) transfer.Repository {
+	return &stockTransferRepository{db: db}
+}
+
+func (r *stockTransferRepository) Create(ctx context.Context, t *transfer.Transfer) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// transfer_number is generated by the set_transfer_number trigger, so read it back.
+		err := tx.Omit(clause.Associations).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "transfer_number"}}}).
+			Create(t).Error
+		if err != nil || len(t.Lines) == 0 {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(t.Lines).Error
+	})
+}
+
+func (r *stockTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	return r.first(conn(ctx, r.db), id)
+}
+
+func (r *stockTransferRepository) LockByID(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *stockTransferRepository) first(db *gorm.DB, id uuid.UUID) (*transfer.Transfer, error) {
+	var t transfer.Transfer
+	err := withTransferLines(db).First(&t, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *stockTransferRepository) List(
+	ctx context.Context, restaurantID uuid.UUID, filter transfer.Filter,
+) ([]*transfer.Transfer, error) {
+	var transfers []*transfer.Transfer
+	q := withTransferLines(conn(ctx, r.db))
+	switch filter.Direction {
+	case transfer.DirectionOutgoing:
+		q = q.Where("source_restaurant_id = ?", restaurantID)
+	case transfer.DirectionIncoming:
+		q = q.Where("destination_restaurant_id = ?", restaurantID)
+	default:
+		q = q.Where("source_restaurant_id = ? OR destination_restaurant_id = ?", restaurantID, restaurantID)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	err := q.Order("created_at DESC").Find(&transfers).Error
+	return transfers, err
+}
+
+func (r *stockTransferRepository) Update(ctx context.Context, t *transfer.Transfer) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(t).Error; err != nil {
+			return err
+		}
+		for _, l := range t.Lines {
+			if err := tx.Omit(clause.Associations).Save(l).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func withTransferLines(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at, id")
+		}).
+		Preload("Lines.SourceItem.Unit").
+		Preload("Lines.DestinationItem.Unit")
+}
@@ -0,0 +1,89 @@
+// Package postgres implements the stocktake repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type stocktakeRepository struct {
+	db *gorm.DB
+}
+
+// NewStocktakeRepository creates a new instance of the GORM repository
+func NewStocktakeRepository(db *gorm.DB) stocktake.Repository {
+	return &stocktakeRepository{db: db}
+}
+
+func (r *stocktakeRepository) Create(ctx context.Context, s *stocktake.Stocktake) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(s).Error; err != nil || len(s.Lines) == 0 {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(s.Lines).Error
+	})
+}
+
+func (r *stocktakeRepository) GetByID(ctx context.Context, id uuid.UUID) (*stocktake.Stocktake, error) {
+	return r.first(conn(ctx, r.db), id)
+}
+
+func (r *stocktakeRepository) LockByID(ctx context.Context, id uuid.UUID) (*stocktake.Stocktake, error) {
+	return r.first(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *stocktakeRepository) first(db *gorm.DB, id uuid.UUID) (*stocktake.Stocktake, error) {
+	var s stocktake.Stocktake
+	err := withStocktakeLines(db).First(&s, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *stocktakeRepository) ListByRestaurant(
+	ctx context.Context, restaurantID uuid.UUID, filter stocktake.Filter,
+) ([]*stocktake.Stocktake, error) {
+	var stocktakes []*stocktake.Stocktake
+	q := withStocktakeLines(conn(ctx, r.db)).Where("restaurant_id = ?", restaurantID)
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	err := q.Order("created_at DESC").Find(&stocktakes).Error
+	return stocktakes, err
+}
+
+func (r *stocktakeRepository) Update(ctx context.Context, s *stocktake.Stocktake) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(s).Error; err != nil {
+			return err
+		}
+		for _, l := range s.Lines {
+			if err := tx.Omit(clause.Associations).Save(l).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// withStocktakeLines preloads lines in item name order, the order staff
+// walk the shelves in
+func withStocktakeLines(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.
+				Joins("JOIN inventory_items ON inventory_items.id = stocktake_lines.inventory_item_id").
+				Order("inventory_items.name, stocktake_lines.id")
+		}).
+		Preload("Lines.Item.Unit")
+}
@@ -0,0 +1,94 @@
+// Package postgres implements the supplier price repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type supplierPriceRepository struct {
+	db *gorm.DB
+}
+
+// NewSupplierPriceRepository creates a new instance of the GORM repository
+func NewSupplierPriceRepository(db *gorm.DB) supplier.PriceRepository {
+	return &supplierPriceRepository{db: db}
+}
+
+func (r *supplierPriceRepository) CreatePrice(ctx context.Context, p *supplier.Price) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(p).Error
+}
+
+func (r *supplierPriceRepository) GetPrice(
+	ctx context.Context, supplierID, itemID uuid.UUID,
+) (*supplier.Price, error) {
+	var p supplier.Price
+	err := conn(ctx, r.db).
+		Where("supplier_id = ? AND inventory_item_id = ?", supplierID, itemID).
+		First(&p).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *supplierPriceRepository) ListPrices(ctx context.Context, supplierID uuid.UUID) ([]*supplier.Price, error) {
+	var prices []*supplier.Price
+	err := conn(ctx, r.db).
+		Select("supplier_prices.*").
+		Preload("Item.Unit").
+		Joins("JOIN inventory_items ON inventory_items.id = supplier_prices.inventory_item_id").
+		Where("supplier_prices.supplier_id = ?", supplierID).
+		Order("inventory_items.name").
+		Find(&prices).Error
+	return prices, err
+}
+
+func (r *supplierPriceRepository) ListItemPrices(ctx context.Context, itemIDs []uuid.UUID) ([]*supplier.Price, error) {
+	var prices []*supplier.Price
+	if len(itemIDs) == 0 {
+		return prices, nil
+	}
+	err := conn(ctx, r.db).
+		Select("supplier_prices.*").
+		Preload("Supplier").
+		Joins("JOIN suppliers ON suppliers.id = supplier_prices.supplier_id").
+		Where("supplier_prices.inventory_item_id IN ? AND suppliers.is_active", itemIDs).
+		Order("supplier_prices.unit_price, suppliers.name").
+		Find(&prices).Error
+	return prices, err
+}
+
+func (r *supplierPriceRepository) UpdatePrice(ctx context.Context, p *supplier.Price) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(p).Error
+}
+
+func (r *supplierPriceRepository) DeletePrice(ctx context.Context, p *supplier.Price) error {
+	return conn(ctx, r.db).Delete(&supplier.Price{}, "id = ?", p.ID).Error
+}
+
+func (r *supplierPriceRepository) CreatePriceChange(ctx context.Context, c *supplier.PriceChange) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(c).Error
+}
+
+func (r *supplierPriceRepository) ListPriceHistory(
+	ctx context.Context, itemID uuid.UUID, supplierID *uuid.UUID, limit int,
+) ([]*supplier.PriceChange, error) {
+	q := conn(ctx, r.db).Preload("Supplier").Where("inventory_item_id = ?", itemID)
+	if supplierID != nil {
+		q = q.Where("supplier_id = ?", *supplierID)
+	}
+
+	var changes []*supplier.PriceChange
+	err := q.Order("created_at DESC").Limit(limit).Find(&changes).Error
+	return changes, err
+}
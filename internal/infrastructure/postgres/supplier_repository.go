@@ -0,0 +1,65 @@
+// Package postgres implements the supplier repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type supplierRepository struct {
+	db *gorm.DB
+}
+
+// NewSupplierRepository creates a new instance of the GORM repository
+func NewSupplierRepository(db *gorm.DB) supplier.Repository {
+	return &supplierRepository{db: db}
+}
+
+func (r *supplierRepository) GetByID(ctx context.Context, id uuid.UUID) (*supplier.Supplier, error) {
+	var s supplier.Supplier
+	err := conn(ctx, r.db).First(&s, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *supplierRepository) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*supplier.Supplier, error) {
+	var suppliers []*supplier.Supplier
+	if len(ids) == 0 {
+		return suppliers, nil
+	}
+	err := conn(ctx, r.db).Where("id IN ?", ids).Find(&suppliers).Error
+	return suppliers, err
+}
+
+func (r *supplierRepository) Create(ctx context.Context, s *supplier.Supplier) error {
+	return conn(ctx, r.db).Create(s).Error
+}
+
+func (r *supplierRepository) List(ctx context.Context, filter supplier.Filter) ([]*supplier.Supplier, error) {
+	q := conn(ctx, r.db)
+	if !filter.IncludeInactive {
+		q = q.Where("is_active")
+	}
+	if filter.Search != "" {
+		like := "%" + filter.Search + "%"
+		q = q.Where("name ILIKE ? OR contact_person ILIKE ? OR email ILIKE ?", like, like, like)
+	}
+
+	var suppliers []*supplier.Supplier
+	err := q.Order("name").Find(&suppliers).Error
+	return suppliers, err
+}
+
+func (r *supplierRepository) Update(ctx context.Context, s *supplier.Supplier) error {
+	return conn(ctx, r.db).Save(s).Error
+}
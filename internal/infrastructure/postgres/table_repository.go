@@ -0,0 +1,75 @@
+// Package postgres implements the table repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/table"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tableRepository struct {
+	db *gorm.DB
+}
+
+// NewTableRepository creates a new instance of the GORM repository
+func NewTableRepository(db *gorm.DB) table.Repository {
+	return &tableRepository{db: db}
+}
+
+func (r *tableRepository) Create(ctx context.Context, t *table.Table) error {
+	return conn(ctx, r.db).Create(t).Error
+}
+
+func (r *tableRepository) GetByID(ctx context.Context, id uuid.UUID) (*table.Table, error) {
+	var t table.Table
+	err := conn(ctx, r.db).First(&t, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *tableRepository) GetByNumber(ctx context.Context, restaurantID uuid.UUID, number string) (*table.Table, error) {
+	var t table.Table
+	err := conn(ctx, r.db).First(&t, "restaurant_id = ? AND number = ?", restaurantID, number).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *tableRepository) LockByID(ctx context.Context, id uuid.UUID) (*table.Table, error) {
+	var t table.Table
+	err := conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).First(&t, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *tableRepository) Update(ctx context.Context, t *table.Table) error {
+	return conn(ctx, r.db).Save(t).Error
+}
+
+func (r *tableRepository) ListByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*table.Table, error) {
+	var tables []*table.Table
+	err := conn(ctx, r.db).
+		Where("restaurant_id = ?", restaurantID).
+		Order("LENGTH(number), number").
+		Find(&tables).Error
+	return tables, err
+}
@@ -0,0 +1,84 @@
+// Package postgres implements the table session repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/table"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tableSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewTableSessionRepository creates a new instance of the GORM repository
+func NewTableSessionRepository(db *gorm.DB) table.SessionRepository {
+	return &tableSessionRepository{db: db}
+}
+
+func (r *tableSessionRepository) Create(ctx context.Context, s *table.Session) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(s).Error
+}
+
+func (r *tableSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*table.Session, error) {
+	var s table.Session
+	err := conn(ctx, r.db).Preload("Table").First(&s, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *tableSessionRepository) LockByID(ctx context.Context, id uuid.UUID) (*table.Session, error) {
+	var s table.Session
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Table").
+		First(&s, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *tableSessionRepository) GetOpenByTable(ctx context.Context, tableID uuid.UUID) (*table.Session, error) {
+	var s table.Session
+	err := conn(ctx, r.db).First(&s, "table_id = ? AND status = ?", tableID, table.SessionOpen).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *tableSessionRepository) Update(ctx context.Context, s *table.Session) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Save(s).Error
+}
+
+func (r *tableSessionRepository) ListByRestaurant(
+	ctx context.Context, restaurantID uuid.UUID, status table.SessionStatus, limit, offset int,
+) ([]*table.Session, error) {
+	var sessions []*table.Session
+	q := conn(ctx, r.db).Preload("Table").Where("restaurant_id = ?", restaurantID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Offset(offset).Order("created_at DESC, id").Find(&sessions).Error
+	return sessions, err
+}
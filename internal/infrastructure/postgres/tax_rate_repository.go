@@ -0,0 +1,31 @@
+// Package postgres implements the tax rate repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+
+	"gorm.io/gorm"
+)
+
+type taxRateRepository struct {
+	db *gorm.DB
+}
+
+// NewTaxRateRepository creates a new instance of the GORM repository
+func NewTaxRateRepository(db *gorm.DB) tax.Repository {
+	return &taxRateRepository{db: db}
+}
+
+func (r *taxRateRepository) Create(ctx context.Context, rate *tax.Rate) error {
+	return conn(ctx, r.db).Create(rate).Error
+}
+
+func (r *taxRateRepository) ListActiveByCountry(ctx context.Context, country string) ([]*tax.Rate, error) {
+	var rates []*tax.Rate
+	err := conn(ctx, r.db).
+		Where("is_active = ? AND UPPER(country) = UPPER(?)", true, country).
+		Find(&rates).Error
+	return rates, err
+}
@@ -0,0 +1,56 @@
+// Package postgres implements the unit of measure repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type unitRepository struct {
+	db *gorm.DB
+}
+
+// NewUnitRepository creates a new instance of the GORM repository
+func NewUnitRepository(db *gorm.DB) inventory.UnitRepository {
+	return &unitRepository{db: db}
+}
+
+func (r *unitRepository) GetByID(ctx context.Context, id uuid.UUID) (*inventory.Unit, error) {
+	var u inventory.Unit
+	err := conn(ctx, r.db).First(&u, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *unitRepository) FindByCode(ctx context.Context, code string) (*inventory.Unit, error) {
+	var u inventory.Unit
+	// An exact abbreviation wins over a name match ("L" vs "liter")
+	err := conn(ctx, r.db).
+		Where("abbreviation = ? OR LOWER(name) = LOWER(?)", code, code).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "abbreviation = ? DESC", Vars: []any{code}}}).
+		First(&u).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *unitRepository) List(ctx context.Context) ([]*inventory.Unit, error) {
+	var units []*inventory.Unit
+	err := conn(ctx, r.db).Order("type, conversion_factor").Find(&units).Error
+	return units, err
+}
@@ -0,0 +1,108 @@
+// Package postgres implements the wallet repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type walletRepository struct {
+	db *gorm.DB
+}
+
+// NewWalletRepository creates a new instance of the GORM repository
+func NewWalletRepository(db *gorm.DB) wallet.Repository {
+	return &walletRepository{db: db}
+}
+
+func (r *walletRepository) GetWallet(ctx context.Context, userID uuid.UUID) (*wallet.Account, error) {
+	var acc wallet.Account
+	err := conn(ctx, r.db).First(&acc, "user_id = ?", userID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *walletRepository) LockWallet(ctx context.Context, userID uuid.UUID) (*wallet.Account, error) {
+	db := conn(ctx, r.db)
+	// Opening the wallet first gives concurrent first-time writers a row
+	// to queue on
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&wallet.Account{Type: wallet.AccountUserWallet, UserID: &userID}).Error
+	if err != nil {
+		return nil, err
+	}
+	var acc wallet.Account
+	err = db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&acc, "user_id = ?", userID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *walletRepository) SystemAccount(ctx context.Context, t wallet.AccountType) (*wallet.Account, error) {
+	var acc wallet.Account
+	err := conn(ctx, r.db).First(&acc, "type = ?", t).Error
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *walletRepository) CreateTransaction(ctx context.Context, t *wallet.Transaction) error {
+	db := conn(ctx, r.db)
+	if err := db.Omit(clause.Associations).Create(t).Error; err != nil {
+		return err
+	}
+	// Applying postings in account order keeps concurrent transactions
+	// that touch the same system accounts from deadlocking
+	postings := append([]*wallet.Posting(nil), t.Postings...)
+	sort.Slice(postings, func(i, j int) bool {
+		return bytes.Compare(postings[i].AccountID[:], postings[j].AccountID[:]) < 0
+	})
+	for _, p := range postings {
+		p.TransactionID = t.ID
+		err := db.Raw(`
+			UPDATE wallet_accounts
+			SET balance = balance + ?, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ?
+			RETURNING balance`,
+			p.Amount, p.AccountID,
+		).Scan(&p.BalanceAfter).Error
+		if err != nil {
+			return err
+		}
+	}
+	return db.Omit(clause.Associations).Create(&t.Postings).Error
+}
+
+func (r *walletRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*wallet.Transaction, error) {
+	var txs []*wallet.Transaction
+	err := conn(ctx, r.db).Preload("Postings").
+		Where("order_id = ?", orderID).Order("created_at, id").Find(&txs).Error
+	return txs, err
+}
+
+func (r *walletRepository) ListPostings(
+	ctx context.Context, accountID uuid.UUID, limit, offset int,
+) ([]*wallet.Posting, error) {
+	var postings []*wallet.Posting
+	q := conn(ctx, r.db).Preload("Transaction").Where("account_id = ?", accountID)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Offset(offset).Order("created_at DESC, id").Find(&postings).Error
+	return postings, err
+}
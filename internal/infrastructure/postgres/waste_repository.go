@@ -0,0 +1,62 @@
+// Package postgres implements the waste repository using GORM for PostgreSQL
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type wasteRepository struct {
+	db *gorm.DB
+}
+
+// NewWasteRepository creates a new instance of the GORM repository
+func NewWasteRepository(db *gorm.DB) inventory.WasteRepository {
+	return &wasteRepository{db: db}
+}
+
+func (r *wasteRepository) Create(ctx context.Context, entry *inventory.WasteEntry) error {
+	return conn(ctx, r.db).Omit(clause.Associations).Create(entry).Error
+}
+
+func (r *wasteRepository) List(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) ([]*inventory.WasteEntry, error) {
+	var entries []*inventory.WasteEntry
+	err := conn(ctx, r.db).
+		Preload("Item.Unit").
+		Preload("Unit").
+		Where("restaurant_id = ? AND created_at >= ? AND created_at < ?", restaurantID, from, to).
+		Order("created_at DESC").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *wasteRepository) Lines(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) ([]inventory.WasteLine, error) {
+	var lines []inventory.WasteLine
+	err := conn(ctx, r.db).
+		Table("inventory_transactions it").
+		Select(`DATE(it.created_at) AS day,
+			ii.id AS inventory_item_id,
+			ii.name AS item_name,
+			COALESCE(uom.abbreviation, '') AS unit,
+			COALESCE(it.reason, 'other') AS reason,
+			SUM(it.quantity) AS quantity,
+			COALESCE(SUM(it.total_cost), 0) AS cost`).
+		Joins("JOIN inventory_items ii ON ii.id = it.inventory_item_id").
+		Joins("LEFT JOIN units_of_measure uom ON uom.id = it.unit_of_measure_id").
+		Where("it.transaction_type = 'waste' AND ii.restaurant_id = ?", restaurantID).
+		Where("it.created_at >= ? AND it.created_at < ?", from, to).
+		Group("DATE(it.created_at), ii.id, ii.name, uom.abbreviation, it.reason").
+		Order("day, ii.name").
+		Scan(&lines).Error
+	return lines, err
+}
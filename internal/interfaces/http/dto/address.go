@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/address"
+)
+
+// CreateAddressRequest is what the client sends (POST /me/addresses)
+type CreateAddressRequest struct {
+	Label                string `json:"label" binding:"omitempty,max=50"`
+	StreetAddress        string `json:"street_address" binding:"required"`
+	Apartment            string `json:"apartment" binding:"omitempty,max=50"`
+	City                 string `json:"city" binding:"required,max=100"`
+	State                string `json:"state" binding:"omitempty,max=100"`
+	PostalCode           string `json:"postal_code" binding:"required,max=20"`
+	Country              string `json:"country" binding:"omitempty,max=100"`
+	Phone                string `json:"phone" binding:"omitempty,max=20"`
+	IsDefault            bool   `json:"is_default"`
+	DeliveryInstructions string `json:"delivery_instructions"`
+}
+
+// UpdateAddressRequest is what the client sends (PUT /me/addresses/:id).
+// Nil fields are left unchanged.
+type UpdateAddressRequest struct {
+	Label                *string `json:"label" binding:"omitempty,max=50"`
+	StreetAddress        *string `json:"street_address" binding:"omitempty,min=1"`
+	Apartment            *string `json:"apartment" binding:"omitempty,max=50"`
+	City                 *string `json:"city" binding:"omitempty,min=1,max=100"`
+	State                *string `json:"state" binding:"omitempty,max=100"`
+	PostalCode           *string `json:"postal_code" binding:"omitempty,min=1,max=20"`
+	Country              *string `json:"country" binding:"omitempty,max=100"`
+	Phone                *string `json:"phone" binding:"omitempty,max=20"`
+	IsDefault            *bool   `json:"is_default"`
+	DeliveryInstructions *string `json:"delivery_instructions"`
+}
+
+type AddressResponse struct {
+	ID                   string   `json:"id"`
+	Label                string   `json:"label,omitempty"`
+	StreetAddress        string   `json:"street_address"`
+	Apartment            string   `json:"apartment,omitempty"`
+	City                 string   `json:"city"`
+	State                string   `json:"state,omitempty"`
+	PostalCode           string   `json:"postal_code"`
+	Country              string   `json:"country"`
+	Latitude             *float64 `json:"latitude"`
+	Longitude            *float64 `json:"longitude"`
+	Phone                string   `json:"phone,omitempty"`
+	IsDefault            bool     `json:"is_default"`
+	DeliveryInstructions string   `json:"delivery_instructions,omitempty"`
+	UpdatedAt            string   `json:"updated_at"`
+}
+
+func MapToAddressResponse(entity *address.Address) AddressResponse {
+	return AddressResponse{
+		ID:                   entity.ID.String(),
+		Label:                entity.Label,
+		StreetAddress:        entity.StreetAddress,
+		Apartment:            entity.Apartment,
+		City:                 entity.City,
+		State:                entity.State,
+		PostalCode:           entity.PostalCode,
+		Country:              entity.Country,
+		Latitude:             entity.Latitude,
+		Longitude:            entity.Longitude,
+		Phone:                entity.Phone,
+		IsDefault:            entity.IsDefault,
+		DeliveryInstructions: entity.DeliveryInstructions,
+		UpdatedAt:            entity.UpdatedAt.Format(time.RFC3339),
+	}
+}
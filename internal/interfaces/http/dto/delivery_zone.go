@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+)
+
+// CreateDeliveryZoneRequest is what the client sends (POST /restaurants/:id/delivery-zones)
+type CreateDeliveryZoneRequest struct {
+	Name     string `json:"name" binding:"required"`
+	ZoneType string `json:"zone_type" binding:"required,oneof=radius polygon"`
+
+	// Radius rings
+	MinRadiusKm float64 `json:"min_radius_km" binding:"omitempty,min=0"`
+	MaxRadiusKm float64 `json:"max_radius_km" binding:"omitempty,gt=0"`
+
+	// GeoJSON Polygon or MultiPolygon geometry
+	Polygon *delivery.Geometry `json:"polygon"`
+
+	DeliveryFee     float64 `json:"delivery_fee" binding:"min=0"`
+	MinimumOrder    float64 `json:"minimum_order" binding:"min=0"`
+	ExtraETAMinutes int     `json:"extra_eta_minutes" binding:"min=0"`
+	Priority        int     `json:"priority"`
+}
+
+type DeliveryZoneResponse struct {
+	ID              string             `json:"id"`
+	RestaurantID    string             `json:"restaurant_id"`
+	Name            string             `json:"name"`
+	ZoneType        string             `json:"zone_type"`
+	MinRadiusKm     float64            `json:"min_radius_km,omitempty"`
+	MaxRadiusKm     float64            `json:"max_radius_km,omitempty"`
+	Polygon         *delivery.Geometry `json:"polygon,omitempty"`
+	DeliveryFee     float64            `json:"delivery_fee"`
+	MinimumOrder    float64            `json:"minimum_order"`
+	ExtraETAMinutes int                `json:"extra_eta_minutes"`
+	Priority        int                `json:"priority"`
+	IsActive        bool               `json:"is_active"`
+	CreatedAt       string             `json:"created_at"`
+}
+
+func MapToDeliveryZoneResponse(entity *delivery.Zone) DeliveryZoneResponse {
+	return DeliveryZoneResponse{
+		ID:              entity.ID.String(),
+		RestaurantID:    entity.RestaurantID.String(),
+		Name:            entity.Name,
+		ZoneType:        string(entity.ZoneType),
+		MinRadiusKm:     entity.MinRadiusKm,
+		MaxRadiusKm:     entity.MaxRadiusKm,
+		Polygon:         entity.Polygon,
+		DeliveryFee:     entity.DeliveryFee,
+		MinimumOrder:    entity.MinimumOrder,
+		ExtraETAMinutes: entity.ExtraETAMinutes,
+		Priority:        entity.Priority,
+		IsActive:        entity.IsActive,
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,293 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// CreateInventoryItemRequest is what the client sends (POST /inventory/items).
+// Unit is an abbreviation or name from units_of_measure, e.g. "kg".
+type CreateInventoryItemRequest struct {
+	RestaurantID          string   `json:"restaurant_id" binding:"required,uuid"`
+	Name                  string   `json:"name" binding:"required,max=255"`
+	SKU                   string   `json:"sku" binding:"omitempty,max=100"`
+	Description           string   `json:"description"`
+	Unit                  string   `json:"unit" binding:"required"`
+	CategoryID            string   `json:"category_id" binding:"omitempty,uuid"`
+	SupplierID            string   `json:"supplier_id" binding:"omitempty,uuid"`
+	MinimumStock          float64  `json:"minimum_stock" binding:"min=0"`
+	MaximumStock          *float64 `json:"maximum_stock" binding:"omitempty,min=0"`
+	ReorderPoint          *float64 `json:"reorder_point" binding:"omitempty,min=0"`
+	ReorderQuantity       *float64 `json:"reorder_quantity" binding:"omitempty,gt=0"`
+	UnitCost              float64  `json:"unit_cost" binding:"min=0"`
+	StorageLocation       string   `json:"storage_location" binding:"omitempty,max=255"`
+	ShelfLifeDays         *int     `json:"shelf_life_days" binding:"omitempty,min=0"`
+	ExpiryAlertDays       *int     `json:"expiry_alert_days" binding:"omitempty,min=0"`
+	IsPerishable          bool     `json:"is_perishable"`
+	RequiresRefrigeration bool     `json:"requires_refrigeration"`
+	Barcode               string   `json:"barcode" binding:"omitempty,max=100"`
+	ImageURL              string   `json:"image_url"`
+	Notes                 string   `json:"notes"`
+}
+
+// UpdateInventoryItemRequest is what the client sends (PUT /inventory/items/:id).
+// Nil fields are left unchanged. Stock and unit change only through stock
+// operations.
+type UpdateInventoryItemRequest struct {
+	Name                  *string  `json:"name" binding:"omitempty,min=1,max=255"`
+	SKU                   *string  `json:"sku" binding:"omitempty,max=100"`
+	Description           *string  `json:"description"`
+	CategoryID            *string  `json:"category_id" binding:"omitempty,uuid"`
+	SupplierID            *string  `json:"supplier_id" binding:"omitempty,uuid"`
+	MinimumStock          *float64 `json:"minimum_stock" binding:"omitempty,min=0"`
+	MaximumStock          *float64 `json:"maximum_stock" binding:"omitempty,min=0"`
+	ReorderPoint          *float64 `json:"reorder_point" binding:"omitempty,min=0"`
+	ReorderQuantity       *float64 `json:"reorder_quantity" binding:"omitempty,gt=0"`
+	StorageLocation       *string  `json:"storage_location" binding:"omitempty,max=255"`
+	ShelfLifeDays         *int     `json:"shelf_life_days" binding:"omitempty,min=0"`
+	ExpiryAlertDays       *int     `json:"expiry_alert_days" binding:"omitempty,min=0"`
+	IsPerishable          *bool    `json:"is_perishable"`
+	RequiresRefrigeration *bool    `json:"requires_refrigeration"`
+	IsActive              *bool    `json:"is_active"`
+	Barcode               *string  `json:"barcode" binding:"omitempty,max=100"`
+	ImageURL              *string  `json:"image_url"`
+	Notes                 *string  `json:"notes"`
+}
+
+// ReceiveStockRequest books a delivery (POST /inventory/items/:id/receive).
+// Quantity and UnitCost are in Unit, which defaults to the item's unit.
+// ExpiryDate defaults to the item's shelf life from today, if it has one.
+type ReceiveStockRequest struct {
+	Quantity    float64 `json:"quantity" binding:"required,gt=0"`
+	Unit        string  `json:"unit"`
+	UnitCost    float64 `json:"unit_cost" binding:"min=0"`
+	BatchNumber string  `json:"batch_number" binding:"omitempty,max=100"`
+	ExpiryDate  string  `json:"expiry_date" binding:"omitempty,datetime=2006-01-02"`
+	Notes       string  `json:"notes"`
+}
+
+// ConsumeStockRequest takes stock out (POST /inventory/items/:id/consume)
+type ConsumeStockRequest struct {
+	Quantity float64 `json:"quantity" binding:"required,gt=0"`
+	Unit     string  `json:"unit"`
+	Reason   string  `json:"reason"`
+}
+
+// AdjustStockRequest corrects stock (POST /inventory/items/:id/adjust).
+// Quantity is signed: negative removes stock.
+type AdjustStockRequest struct {
+	Quantity float64 `json:"quantity" binding:"required"`
+	Unit     string  `json:"unit"`
+	Reason   string  `json:"reason" binding:"required,oneof=damaged expired theft miscounted spoiled returned_to_supplier other"`
+	Details  string  `json:"details"`
+}
+
+type UnitResponse struct {
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	Abbreviation     string  `json:"abbreviation"`
+	Type             string  `json:"type"`
+	BaseUnit         string  `json:"base_unit"`
+	ConversionFactor float64 `json:"conversion_factor"`
+}
+
+type InventoryItemResponse struct {
+	ID                    string        `json:"id"`
+	RestaurantID          string        `json:"restaurant_id"`
+	SKU                   string        `json:"sku,omitempty"`
+	Name                  string        `json:"name"`
+	Description           string        `json:"description,omitempty"`
+	Unit                  *UnitResponse `json:"unit"`
+	CategoryID            *string       `json:"category_id"`
+	SupplierID            *string       `json:"supplier_id"`
+	CurrentStock          float64       `json:"current_stock"`
+	MinimumStock          float64       `json:"minimum_stock"`
+	MaximumStock          *float64      `json:"maximum_stock"`
+	ReorderPoint          *float64      `json:"reorder_point"`
+	ReorderQuantity       *float64      `json:"reorder_quantity"`
+	UnitCost              float64       `json:"unit_cost"`
+	AverageCost           float64       `json:"average_cost"`
+	LastPurchaseCost      *float64      `json:"last_purchase_cost"`
+	StorageLocation       string        `json:"storage_location,omitempty"`
+	ShelfLifeDays         *int          `json:"shelf_life_days"`
+	ExpiryAlertDays       int           `json:"expiry_alert_days"`
+	IsActive              bool          `json:"is_active"`
+	IsPerishable          bool          `json:"is_perishable"`
+	RequiresRefrigeration bool          `json:"requires_refrigeration"`
+	Barcode               string        `json:"barcode,omitempty"`
+	UpdatedAt             string        `json:"updated_at"`
+}
+
+type InventoryTransactionResponse struct {
+	ID              string   `json:"id"`
+	InventoryItemID string   `json:"inventory_item_id"`
+	Type            string   `json:"type"`
+	Quantity        float64  `json:"quantity"`
+	QuantityBefore  float64  `json:"quantity_before"`
+	QuantityAfter   float64  `json:"quantity_after"`
+	UnitCost        *float64 `json:"unit_cost"`
+	TotalCost       *float64 `json:"total_cost"`
+	ReferenceType   string   `json:"reference_type,omitempty"`
+	ReferenceID     *string  `json:"reference_id,omitempty"`
+	Reason          string   `json:"reason,omitempty"`
+	BatchNumber     string   `json:"batch_number,omitempty"`
+	ExpiryDate      *string  `json:"expiry_date,omitempty"`
+	PerformedBy     *string  `json:"performed_by,omitempty"`
+	Notes           string   `json:"notes,omitempty"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+// StockMovementResponse is returned by receive and adjust
+type StockMovementResponse struct {
+	Item        InventoryItemResponse        `json:"item"`
+	Transaction InventoryTransactionResponse `json:"transaction"`
+}
+
+// StockDrawResponse is returned by consume, which writes one entry per batch
+type StockDrawResponse struct {
+	Item         InventoryItemResponse          `json:"item"`
+	Transactions []InventoryTransactionResponse `json:"transactions"`
+}
+
+type InventoryBatchResponse struct {
+	ID                string   `json:"id"`
+	InventoryItemID   string   `json:"inventory_item_id"`
+	ItemName          string   `json:"item_name,omitempty"`
+	Unit              string   `json:"unit,omitempty"`
+	BatchNumber       string   `json:"batch_number,omitempty"`
+	ExpiryDate        *string  `json:"expiry_date"`
+	QuantityReceived  float64  `json:"quantity_received"`
+	QuantityRemaining float64  `json:"quantity_remaining"`
+	UnitCost          *float64 `json:"unit_cost"`
+	ReceivedAt        string   `json:"received_at"`
+}
+
+type WasteSuggestionResponse struct {
+	Quantity float64 `json:"quantity"`
+	Value    float64 `json:"value"`
+	Reason   string  `json:"reason"`
+}
+
+// ExpiringBatchResponse is a batch that has expired or soon will
+// (GET /inventory/expiring). Expired batches carry a suggested waste entry.
+type ExpiringBatchResponse struct {
+	InventoryBatchResponse
+	Status         string                   `json:"status"`
+	DaysLeft       *int                     `json:"days_left"`
+	SuggestedWaste *WasteSuggestionResponse `json:"suggested_waste"`
+}
+
+func MapToUnitResponse(entity *inventory.Unit) *UnitResponse {
+	if entity == nil {
+		return nil
+	}
+	return &UnitResponse{
+		ID:               entity.ID.String(),
+		Name:             entity.Name,
+		Abbreviation:     entity.Abbreviation,
+		Type:             string(entity.Type),
+		BaseUnit:         entity.BaseUnit,
+		ConversionFactor: entity.ConversionFactor,
+	}
+}
+
+func MapToInventoryItemResponse(entity *inventory.Item) InventoryItemResponse {
+	res := InventoryItemResponse{
+		ID:                    entity.ID.String(),
+		RestaurantID:          entity.RestaurantID.String(),
+		Name:                  entity.Name,
+		Description:           entity.Description,
+		Unit:                  MapToUnitResponse(entity.Unit),
+		CategoryID:            uuidString(entity.CategoryID),
+		SupplierID:            uuidString(entity.SupplierID),
+		CurrentStock:          entity.CurrentStock,
+		MinimumStock:          entity.MinimumStock,
+		MaximumStock:          entity.MaximumStock,
+		ReorderPoint:          entity.ReorderPoint,
+		ReorderQuantity:       entity.ReorderQuantity,
+		UnitCost:              entity.UnitCost,
+		AverageCost:           entity.AverageCost,
+		LastPurchaseCost:      entity.LastPurchaseCost,
+		StorageLocation:       entity.StorageLocation,
+		ShelfLifeDays:         entity.ShelfLifeDays,
+		ExpiryAlertDays:       entity.ExpiryAlertDays,
+		IsActive:              entity.IsActive,
+		IsPerishable:          entity.IsPerishable,
+		RequiresRefrigeration: entity.RequiresRefrigeration,
+		Barcode:               entity.Barcode,
+		UpdatedAt:             entity.UpdatedAt.Format(time.RFC3339),
+	}
+	if entity.SKU != nil {
+		res.SKU = *entity.SKU
+	}
+	return res
+}
+
+func MapToInventoryTransactionResponse(entity *inventory.Transaction) InventoryTransactionResponse {
+	return InventoryTransactionResponse{
+		ID:              entity.ID.String(),
+		InventoryItemID: entity.InventoryItemID.String(),
+		Type:            string(entity.TransactionType),
+		Quantity:        entity.Quantity,
+		QuantityBefore:  entity.QuantityBefore,
+		QuantityAfter:   entity.QuantityAfter,
+		UnitCost:        entity.UnitCost,
+		TotalCost:       entity.TotalCost,
+		ReferenceType:   entity.ReferenceType,
+		ReferenceID:     uuidString(entity.ReferenceID),
+		Reason:          entity.Reason,
+		BatchNumber:     entity.BatchNumber,
+		ExpiryDate:      dateString(entity.ExpiryDate),
+		PerformedBy:     uuidString(entity.PerformedBy),
+		Notes:           entity.Notes,
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func MapToInventoryBatchResponse(entity *inventory.Batch) InventoryBatchResponse {
+	res := InventoryBatchResponse{
+		ID:                entity.ID.String(),
+		InventoryItemID:   entity.InventoryItemID.String(),
+		BatchNumber:       entity.BatchNumber,
+		ExpiryDate:        dateString(entity.ExpiryDate),
+		QuantityReceived:  entity.QuantityReceived,
+		QuantityRemaining: entity.QuantityRemaining,
+		UnitCost:          entity.UnitCost,
+		ReceivedAt:        entity.ReceivedAt.Format(time.RFC3339),
+	}
+	if entity.Item != nil {
+		res.ItemName = entity.Item.Name
+		if entity.Item.Unit != nil {
+			res.Unit = entity.Item.Unit.Abbreviation
+		}
+	}
+	return res
+}
+
+// MapToExpiringBatchResponse describes the batch as it stands on the given day
+func MapToExpiringBatchResponse(entity *inventory.Batch, on time.Time) ExpiringBatchResponse {
+	res := ExpiringBatchResponse{
+		InventoryBatchResponse: MapToInventoryBatchResponse(entity),
+		Status:                 string(entity.ExpiryStatus(on, entity.Item.ExpiryAlertDays)),
+		DaysLeft:               entity.DaysLeft(on),
+	}
+	if w := entity.SuggestWaste(on); w != nil {
+		res.SuggestedWaste = &WasteSuggestionResponse{
+			Quantity: w.Quantity,
+			Value:    w.Value,
+			Reason:   string(w.Reason),
+		}
+	}
+	return res
+}
+
+// uuidString formats an optional id, keeping nil as JSON null
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// SaveLoyaltyRuleRequest sets how a restaurant's orders earn and redeem
+// points (PUT /restaurants/:id/loyalty-rule). MaxRedeemPercent of 0 turns
+// redemption off.
+type SaveLoyaltyRuleRequest struct {
+	PointsPerUnit    *float64 `json:"points_per_unit" binding:"required,min=0"`
+	MinimumSubtotal  float64  `json:"minimum_subtotal" binding:"min=0"`
+	MaxRedeemPercent float64  `json:"max_redeem_percent" binding:"min=0,max=100"`
+	IsActive         *bool    `json:"is_active"`
+}
+
+type LoyaltyRuleResponse struct {
+	RestaurantID     string  `json:"restaurant_id"`
+	PointsPerUnit    float64 `json:"points_per_unit"`
+	MinimumSubtotal  float64 `json:"minimum_subtotal"`
+	MaxRedeemPercent float64 `json:"max_redeem_percent"`
+	IsActive         bool    `json:"is_active"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+// LoyaltyAccountResponse is the customer's balance and what it is worth
+// at checkout.
+type LoyaltyAccountResponse struct {
+	Balance    int     `json:"balance"`
+	PointValue float64 `json:"point_value"`
+	Value      float64 `json:"value"`
+}
+
+type LoyaltyEntryResponse struct {
+	ID           string  `json:"id"`
+	Type         string  `json:"type"`
+	Points       int     `json:"points"`
+	BalanceAfter int     `json:"balance_after"`
+	OrderID      *string `json:"order_id"`
+	RestaurantID *string `json:"restaurant_id"`
+	ExpiresAt    *string `json:"expires_at,omitempty"`
+	Note         string  `json:"note,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+func MapToLoyaltyRuleResponse(entity *loyalty.Rule) LoyaltyRuleResponse {
+	return LoyaltyRuleResponse{
+		RestaurantID:     entity.RestaurantID.String(),
+		PointsPerUnit:    entity.PointsPerUnit,
+		MinimumSubtotal:  entity.MinimumSubtotal,
+		MaxRedeemPercent: entity.MaxRedeemPercent,
+		IsActive:         entity.IsActive,
+		UpdatedAt:        entity.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func MapToLoyaltyAccountResponse(entity *loyalty.Account, pointValue float64) LoyaltyAccountResponse {
+	res := LoyaltyAccountResponse{Balance: entity.Balance, PointValue: pointValue}
+	if entity.Balance > 0 {
+		res.Value = money.Round(float64(entity.Balance) * pointValue)
+	}
+	return res
+}
+
+func MapToLoyaltyEntryResponse(entity *loyalty.Entry) LoyaltyEntryResponse {
+	return LoyaltyEntryResponse{
+		ID:           entity.ID.String(),
+		Type:         string(entity.Type),
+		Points:       entity.Points,
+		BalanceAfter: entity.BalanceAfter,
+		OrderID:      uuidString(entity.OrderID),
+		RestaurantID: uuidString(entity.RestaurantID),
+		ExpiresAt:    timeString(entity.ExpiresAt),
+		Note:         entity.Note,
+		CreatedAt:    entity.CreatedAt.Format(time.RFC3339),
+	}
+}
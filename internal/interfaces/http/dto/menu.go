@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+)
+
+// MenuItemResponse is a menu item as customers see it. An item switched off
+// because an ingredient ran out has IsAvailable false, UnavailableReason
+// saying which and StockDisabledAt when.
+type MenuItemResponse struct {
+	ID                string   `json:"id"`
+	RestaurantID      string   `json:"restaurant_id"`
+	CategoryID        *string  `json:"category_id"`
+	Name              string   `json:"name"`
+	Slug              string   `json:"slug"`
+	Description       string   `json:"description,omitempty"`
+	Price             float64  `json:"price"`
+	DiscountPrice     *float64 `json:"discount_price"`
+	ImageURL          string   `json:"image_url,omitempty"`
+	IsVegetarian      bool     `json:"is_vegetarian"`
+	IsVegan           bool     `json:"is_vegan"`
+	IsGlutenFree      bool     `json:"is_gluten_free"`
+	IsSpicy           bool     `json:"is_spicy"`
+	SpiceLevel        *int     `json:"spice_level"`
+	Calories          *int     `json:"calories"`
+	PreparationTime   *int     `json:"preparation_time"`
+	IsAvailable       bool     `json:"is_available"`
+	UnavailableReason string   `json:"unavailable_reason,omitempty"`
+	StockDisabledAt   *string  `json:"stock_disabled_at"`
+	IsFeatured        bool     `json:"is_featured"`
+	DisplayOrder      int      `json:"display_order"`
+}
+
+type MenuAvailabilityChangeResponse struct {
+	ID              string  `json:"id"`
+	IsAvailable     bool    `json:"is_available"`
+	Reason          string  `json:"reason"`
+	InventoryItemID *string `json:"inventory_item_id"`
+	Details         string  `json:"details,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// MenuAvailabilityResponse is a menu item's current availability and the
+// log of stock switching it off and on (GET /menu-items/:id/availability).
+type MenuAvailabilityResponse struct {
+	MenuItemID        string                           `json:"menu_item_id"`
+	Name              string                           `json:"name"`
+	IsAvailable       bool                             `json:"is_available"`
+	UnavailableReason string                           `json:"unavailable_reason,omitempty"`
+	StockDisabledAt   *string                          `json:"stock_disabled_at"`
+	Changes           []MenuAvailabilityChangeResponse `json:"changes"`
+}
+
+func MapToMenuItemResponse(entity *menu.MenuItem) MenuItemResponse {
+	return MenuItemResponse{
+		ID:                entity.ID.String(),
+		RestaurantID:      entity.RestaurantID.String(),
+		CategoryID:        uuidString(entity.CategoryID),
+		Name:              entity.Name,
+		Slug:              entity.Slug,
+		Description:       entity.Description,
+		Price:             entity.Price,
+		DiscountPrice:     entity.DiscountPrice,
+		ImageURL:          entity.ImageURL,
+		IsVegetarian:      entity.IsVegetarian,
+		IsVegan:           entity.IsVegan,
+		IsGlutenFree:      entity.IsGlutenFree,
+		IsSpicy:           entity.IsSpicy,
+		SpiceLevel:        entity.SpiceLevel,
+		Calories:          entity.Calories,
+		PreparationTime:   entity.PreparationTime,
+		IsAvailable:       entity.IsAvailable,
+		UnavailableReason: entity.UnavailableReason,
+		StockDisabledAt:   timeString(entity.StockDisabledAt),
+		IsFeatured:        entity.IsFeatured,
+		DisplayOrder:      entity.DisplayOrder,
+	}
+}
+
+func MapToMenuAvailabilityResponse(mi *menu.MenuItem, changes []*menu.AvailabilityChange) MenuAvailabilityResponse {
+	res := MenuAvailabilityResponse{
+		MenuItemID:        mi.ID.String(),
+		Name:              mi.Name,
+		IsAvailable:       mi.IsAvailable,
+		UnavailableReason: mi.UnavailableReason,
+		StockDisabledAt:   timeString(mi.StockDisabledAt),
+		Changes:           make([]MenuAvailabilityChangeResponse, 0, len(changes)),
+	}
+	for _, ch := range changes {
+		res.Changes = append(res.Changes, MenuAvailabilityChangeResponse{
+			ID:              ch.ID.String(),
+			IsAvailable:     ch.IsAvailable,
+			Reason:          ch.Reason,
+			InventoryItemID: uuidString(ch.InventoryItemID),
+			Details:         ch.Details,
+			CreatedAt:       ch.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res
+}
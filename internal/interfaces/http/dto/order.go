@@ -0,0 +1,287 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// CheckoutItemRequest is one cart line sent at checkout
+type CheckoutItemRequest struct {
+	MenuItemID          string `json:"menu_item_id" binding:"required,uuid"`
+	Quantity            int    `json:"quantity" binding:"required,min=1"`
+	SpecialInstructions string `json:"special_instructions"`
+}
+
+// CheckoutRequest is what the client sends (POST /checkout and POST /checkout/quote)
+type CheckoutRequest struct {
+	RestaurantID string                `json:"restaurant_id" binding:"required,uuid"`
+	OrderType    string                `json:"order_type" binding:"omitempty,oneof=delivery pickup dine_in"`
+	Items        []CheckoutItemRequest `json:"items" binding:"required,min=1,dive"`
+
+	Tip           *float64 `json:"tip" binding:"omitempty,min=0"`
+	PaymentMethod string   `json:"payment_method" binding:"omitempty,oneof=credit_card debit_card cash wallet online_payment"`
+	// RedeemPoints spends loyalty points as a discount on the order
+	RedeemPoints int `json:"redeem_points" binding:"omitempty,min=0"`
+	// WalletAmount pays part of the order from the wallet; payment_method
+	// wallet pays all of it. PaymentToken charges the rest to a card.
+	WalletAmount *float64 `json:"wallet_amount" binding:"omitempty,gt=0"`
+	PaymentToken string   `json:"payment_token"`
+
+	DeliveryAddressID    string `json:"delivery_address_id" binding:"omitempty,uuid"`
+	DeliveryPhone        string `json:"delivery_phone"`
+	DeliveryInstructions string `json:"delivery_instructions"`
+	SpecialInstructions  string `json:"special_instructions"`
+
+	// ScheduledFor places a pre-order for the start of a slot (RFC 3339).
+	// Leave empty to order for as soon as possible.
+	ScheduledFor *time.Time `json:"scheduled_for"`
+}
+
+// UpdateOrderStatusRequest is sent by staff to PATCH /orders/:id/status
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=confirmed preparing ready out_for_delivery delivered"`
+}
+
+// CancelOrderRequest is sent to POST /orders/:id/cancel
+type CancelOrderRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// RefundOrderRequest is sent by admins to POST /orders/:id/refund
+type RefundOrderRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+	// Destination is where the card part of the payment goes: back to the
+	// card (original, the default) or to the customer's wallet
+	Destination string `json:"destination" binding:"omitempty,oneof=original wallet"`
+}
+
+// TaxLineResponse is the tax charged by a single rate
+type TaxLineResponse struct {
+	Name          string  `json:"name"`
+	Category      string  `json:"category"`
+	Rate          float64 `json:"rate"`
+	TaxableAmount float64 `json:"taxable_amount"`
+	TaxAmount     float64 `json:"tax_amount"`
+	Inclusive     bool    `json:"inclusive"`
+}
+
+type OrderItemResponse struct {
+	MenuItemID          string  `json:"menu_item_id"`
+	Name                string  `json:"name"`
+	Quantity            int     `json:"quantity"`
+	UnitPrice           float64 `json:"unit_price"`
+	Subtotal            float64 `json:"subtotal"`
+	SpecialInstructions string  `json:"special_instructions,omitempty"`
+}
+
+// OrderResponse is returned by checkout, quotes and order lookups.
+// ID and OrderNumber are empty for quotes.
+type OrderResponse struct {
+	ID            string              `json:"id,omitempty"`
+	OrderNumber   string              `json:"order_number,omitempty"`
+	RestaurantID  string              `json:"restaurant_id"`
+	OrderType     string              `json:"order_type"`
+	TableNumber   string              `json:"table_number,omitempty"`
+	Status        string              `json:"status"`
+	Items         []OrderItemResponse `json:"items"`
+	Subtotal      float64             `json:"subtotal"`
+	DeliveryFee   float64             `json:"delivery_fee"`
+	Discount      float64             `json:"discount"`
+	PointsSpent   int                 `json:"points_redeemed"`
+	Tip           float64             `json:"tip"`
+	Tax           float64             `json:"tax"`
+	TaxIncluded   float64             `json:"tax_included"`
+	TaxBreakdown  []TaxLineResponse   `json:"tax_breakdown"`
+	Total         float64             `json:"total"`
+	WalletAmount  float64             `json:"wallet_amount"`
+	PaymentStatus string              `json:"payment_status"`
+	ScheduledFor  string              `json:"scheduled_for,omitempty"`
+	ETA           string              `json:"estimated_delivery_time,omitempty"`
+	CreatedAt     string              `json:"created_at,omitempty"`
+}
+
+// OrderTrackingResponse is pushed on the order tracking stream whenever the
+// status or ETA changes
+type OrderTrackingResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	ETA    string `json:"estimated_delivery_time,omitempty"`
+}
+
+// ETAAccuracyResponse compares promised ETAs with actual delivery times.
+// Errors are in minutes; positive means late.
+type ETAAccuracyResponse struct {
+	RestaurantID        string  `json:"restaurant_id"`
+	From                string  `json:"from"`
+	To                  string  `json:"to"`
+	Orders              int     `json:"orders"`
+	MeanErrorMinutes    float64 `json:"mean_error_minutes"`
+	MeanAbsErrorMinutes float64 `json:"mean_abs_error_minutes"`
+	OnTimeRate          float64 `json:"on_time_rate"`
+}
+
+// PreorderSlotResponse is one bookable pre-order slot
+type PreorderSlotResponse struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	Capacity  int    `json:"capacity"`
+	Remaining int    `json:"remaining"`
+}
+
+// ReceiptResponse is the customer-facing receipt of a placed order
+type ReceiptResponse struct {
+	OrderNumber    string              `json:"order_number"`
+	RestaurantName string              `json:"restaurant_name"`
+	RestaurantAddr string              `json:"restaurant_address,omitempty"`
+	TableNumber    string              `json:"table_number,omitempty"`
+	Items          []OrderItemResponse `json:"items"`
+	Subtotal       float64             `json:"subtotal"`
+	DeliveryFee    float64             `json:"delivery_fee"`
+	Discount       float64             `json:"discount"`
+	PointsSpent    int                 `json:"points_redeemed"`
+	Tip            float64             `json:"tip"`
+	TaxBreakdown   []TaxLineResponse   `json:"tax_breakdown"`
+	Tax            float64             `json:"tax"`
+	TaxIncluded    float64             `json:"tax_included"`
+	Total          float64             `json:"total"`
+	WalletAmount   float64             `json:"wallet_amount"`
+	PaymentMethod  string              `json:"payment_method,omitempty"`
+	PaymentStatus  string              `json:"payment_status"`
+	IssuedAt       string              `json:"issued_at"`
+}
+
+func MapToTaxLines(breakdown tax.Breakdown) ([]TaxLineResponse, float64) {
+	lines := make([]TaxLineResponse, 0, len(breakdown))
+	var included float64
+	for _, b := range breakdown {
+		lines = append(lines, TaxLineResponse{
+			Name:          b.Name,
+			Category:      string(b.Category),
+			Rate:          b.Rate,
+			TaxableAmount: b.Taxable,
+			TaxAmount:     b.Amount,
+			Inclusive:     b.Inclusive,
+		})
+		if b.Inclusive {
+			included += b.Amount
+		}
+	}
+	return lines, money.Round(included)
+}
+
+func mapToOrderItems(items []*order.Item) []OrderItemResponse {
+	res := make([]OrderItemResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, OrderItemResponse{
+			MenuItemID:          it.MenuItemID.String(),
+			Name:                it.ItemName,
+			Quantity:            it.Quantity,
+			UnitPrice:           it.UnitPrice,
+			Subtotal:            it.Subtotal,
+			SpecialInstructions: it.SpecialInstructions,
+		})
+	}
+	return res
+}
+
+func MapToOrderResponse(entity *order.Order) OrderResponse {
+	taxLines, included := MapToTaxLines(entity.TaxBreakdown)
+	res := OrderResponse{
+		OrderNumber:   entity.OrderNumber,
+		RestaurantID:  entity.RestaurantID.String(),
+		OrderType:     string(entity.OrderType),
+		TableNumber:   entity.TableNumber,
+		Status:        string(entity.Status),
+		Items:         mapToOrderItems(entity.Items),
+		Subtotal:      entity.Subtotal,
+		DeliveryFee:   entity.DeliveryFee,
+		Discount:      entity.Discount,
+		PointsSpent:   entity.PointsRedeemed,
+		Tip:           entity.Tip,
+		Tax:           entity.Tax,
+		TaxIncluded:   included,
+		TaxBreakdown:  taxLines,
+		Total:         entity.Total,
+		WalletAmount:  entity.WalletAmount,
+		PaymentStatus: string(entity.PaymentStatus),
+	}
+	if entity.ScheduledFor != nil {
+		res.ScheduledFor = entity.ScheduledFor.Format(time.RFC3339)
+	}
+	if entity.EstimatedDeliveryTime != nil {
+		res.ETA = entity.EstimatedDeliveryTime.Format(time.RFC3339)
+	}
+	if !entity.CreatedAt.IsZero() {
+		res.ID = entity.ID.String()
+		res.CreatedAt = entity.CreatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func MapToReceiptResponse(o *order.Order, r *restaurant.Restaurant) ReceiptResponse {
+	taxLines, included := MapToTaxLines(o.TaxBreakdown)
+	res := ReceiptResponse{
+		OrderNumber:    o.OrderNumber,
+		RestaurantName: r.Name,
+		RestaurantAddr: r.Address,
+		TableNumber:    o.TableNumber,
+		Items:          mapToOrderItems(o.Items),
+		Subtotal:       o.Subtotal,
+		DeliveryFee:    o.DeliveryFee,
+		Discount:       o.Discount,
+		PointsSpent:    o.PointsRedeemed,
+		Tip:            o.Tip,
+		TaxBreakdown:   taxLines,
+		Tax:            o.Tax,
+		TaxIncluded:    included,
+		Total:          o.Total,
+		WalletAmount:   o.WalletAmount,
+		PaymentStatus:  string(o.PaymentStatus),
+		IssuedAt:       o.CreatedAt.Format(time.RFC3339),
+	}
+	if o.PaymentMethod != nil {
+		res.PaymentMethod = string(*o.PaymentMethod)
+	}
+	return res
+}
+
+func MapToPreorderSlots(slots []order.Slot, loc *time.Location) []PreorderSlotResponse {
+	res := make([]PreorderSlotResponse, 0, len(slots))
+	for _, s := range slots {
+		res = append(res, PreorderSlotResponse{
+			Start:     s.Start.In(loc).Format(time.RFC3339),
+			End:       s.End.In(loc).Format(time.RFC3339),
+			Capacity:  s.Capacity,
+			Remaining: s.Remaining(),
+		})
+	}
+	return res
+}
+
+func MapToOrderTracking(entity *order.Order) OrderTrackingResponse {
+	res := OrderTrackingResponse{
+		ID:     entity.ID.String(),
+		Status: string(entity.Status),
+	}
+	if entity.EstimatedDeliveryTime != nil {
+		res.ETA = entity.EstimatedDeliveryTime.Format(time.RFC3339)
+	}
+	return res
+}
+
+func MapToETAAccuracyResponse(restaurantID string, a *order.ETAAccuracy) ETAAccuracyResponse {
+	return ETAAccuracyResponse{
+		RestaurantID:        restaurantID,
+		From:                a.From.Format(time.RFC3339),
+		To:                  a.To.Format(time.RFC3339),
+		Orders:              a.Orders,
+		MeanErrorMinutes:    math.Round(a.MeanErrorMinutes*10) / 10,
+		MeanAbsErrorMinutes: math.Round(a.MeanAbsErrorMinutes*10) / 10,
+		OnTimeRate:          math.Round(a.OnTimeRate*1000) / 1000,
+	}
+}
@@ -0,0 +1,239 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+)
+
+// CreatePurchaseOrderRequest opens a draft (POST /inventory/purchase-orders)
+type CreatePurchaseOrderRequest struct {
+	RestaurantID         string                     `json:"restaurant_id" binding:"required,uuid"`
+	SupplierID           string                     `json:"supplier_id" binding:"required,uuid"`
+	ExpectedDeliveryDate string                     `json:"expected_delivery_date" binding:"omitempty,datetime=2006-01-02"`
+	ShippingCost         float64                    `json:"shipping_cost" binding:"min=0"`
+	Discount             float64                    `json:"discount" binding:"min=0"`
+	Notes                string                     `json:"notes"`
+	DeliveryInstructions string                     `json:"delivery_instructions"`
+	Lines                []PurchaseOrderLineRequest `json:"lines" binding:"dive"`
+}
+
+// UpdatePurchaseOrderRequest replaces a draft's details and lines
+// (PUT /inventory/purchase-orders/:id)
+type UpdatePurchaseOrderRequest struct {
+	SupplierID           string                     `json:"supplier_id" binding:"required,uuid"`
+	ExpectedDeliveryDate string                     `json:"expected_delivery_date" binding:"omitempty,datetime=2006-01-02"`
+	ShippingCost         float64                    `json:"shipping_cost" binding:"min=0"`
+	Discount             float64                    `json:"discount" binding:"min=0"`
+	Notes                string                     `json:"notes"`
+	DeliveryInstructions string                     `json:"delivery_instructions"`
+	Lines                []PurchaseOrderLineRequest `json:"lines" binding:"dive"`
+}
+
+// PurchaseOrderLineRequest orders Quantity of an item, in the item's unit.
+type PurchaseOrderLineRequest struct {
+	InventoryItemID string  `json:"inventory_item_id" binding:"required,uuid"`
+	Quantity        float64 `json:"quantity" binding:"required,gt=0"`
+	UnitPrice       float64 `json:"unit_price" binding:"min=0"`
+	Tax             float64 `json:"tax" binding:"min=0"`
+	Notes           string  `json:"notes"`
+}
+
+// ReceivePurchaseOrderRequest books a delivery, which may be partial
+// (POST /inventory/purchase-orders/:id/receive)
+type ReceivePurchaseOrderRequest struct {
+	Lines []ReceiptLineRequest `json:"lines" binding:"required,min=1,dive"`
+	Notes string               `json:"notes"`
+}
+
+// ReceiptLineRequest receives Quantity against a line. UnitCost defaults to
+// the line's unit price, for when the invoice differs from the order.
+// BatchNumber defaults to the PO number and ExpiryDate to the item's shelf
+// life from today, if it has one.
+type ReceiptLineRequest struct {
+	LineID      string   `json:"line_id" binding:"required,uuid"`
+	Quantity    float64  `json:"quantity" binding:"required,gt=0"`
+	UnitCost    *float64 `json:"unit_cost" binding:"omitempty,min=0"`
+	BatchNumber string   `json:"batch_number" binding:"omitempty,max=100"`
+	ExpiryDate  string   `json:"expiry_date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type PurchaseOrderLineResponse struct {
+	ID               string        `json:"id"`
+	InventoryItemID  string        `json:"inventory_item_id"`
+	ItemName         string        `json:"item_name"`
+	Unit             *UnitResponse `json:"unit"`
+	QuantityOrdered  float64       `json:"quantity_ordered"`
+	QuantityReceived float64       `json:"quantity_received"`
+	Outstanding      float64       `json:"outstanding"`
+	UnitPrice        float64       `json:"unit_price"`
+	Subtotal         float64       `json:"subtotal"`
+	Tax              float64       `json:"tax"`
+	Total            float64       `json:"total"`
+	Notes            string        `json:"notes,omitempty"`
+}
+
+type PurchaseOrderResponse struct {
+	ID                   string                      `json:"id"`
+	PONumber             string                      `json:"po_number"`
+	RestaurantID         string                      `json:"restaurant_id"`
+	SupplierID           string                      `json:"supplier_id"`
+	Status               string                      `json:"status"`
+	OrderDate            string                      `json:"order_date"`
+	ExpectedDeliveryDate *string                     `json:"expected_delivery_date"`
+	ActualDeliveryDate   *string                     `json:"actual_delivery_date"`
+	Lines                []PurchaseOrderLineResponse `json:"lines"`
+	Subtotal             float64                     `json:"subtotal"`
+	Tax                  float64                     `json:"tax"`
+	ShippingCost         float64                     `json:"shipping_cost"`
+	Discount             float64                     `json:"discount"`
+	Total                float64                     `json:"total"`
+	Notes                string                      `json:"notes,omitempty"`
+	DeliveryInstructions string                      `json:"delivery_instructions,omitempty"`
+	CreatedBy            *string                     `json:"created_by"`
+	ApprovedBy           *string                     `json:"approved_by"`
+	ReceivedBy           *string                     `json:"received_by"`
+	CreatedAt            string                      `json:"created_at"`
+	UpdatedAt            string                      `json:"updated_at"`
+}
+
+// PurchaseReceiptResponse is the purchase order after a receipt, with the
+// ledger entries the receipt posted
+type PurchaseReceiptResponse struct {
+	PurchaseOrder PurchaseOrderResponse          `json:"purchase_order"`
+	Transactions  []InventoryTransactionResponse `json:"transactions"`
+}
+
+func MapToPurchaseOrderResponse(entity *purchasing.PurchaseOrder) PurchaseOrderResponse {
+	res := PurchaseOrderResponse{
+		ID:                   entity.ID.String(),
+		PONumber:             entity.PONumber,
+		RestaurantID:         entity.RestaurantID.String(),
+		SupplierID:           entity.SupplierID.String(),
+		Status:               string(entity.Status),
+		OrderDate:            entity.OrderDate.Format(time.DateOnly),
+		ExpectedDeliveryDate: dateString(entity.ExpectedDeliveryDate),
+		ActualDeliveryDate:   dateString(entity.ActualDeliveryDate),
+		Lines:                make([]PurchaseOrderLineResponse, 0, len(entity.Lines)),
+		Subtotal:             entity.Subtotal,
+		Tax:                  entity.Tax,
+		ShippingCost:         entity.ShippingCost,
+		Discount:             entity.Discount,
+		Total:                entity.Total,
+		Notes:                entity.Notes,
+		DeliveryInstructions: entity.DeliveryInstructions,
+		CreatedBy:            uuidString(entity.CreatedBy),
+		ApprovedBy:           uuidString(entity.ApprovedBy),
+		ReceivedBy:           uuidString(entity.ReceivedBy),
+		CreatedAt:            entity.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            entity.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, l := range entity.Lines {
+		line := PurchaseOrderLineResponse{
+			ID:               l.ID.String(),
+			InventoryItemID:  l.InventoryItemID.String(),
+			QuantityOrdered:  l.QuantityOrdered,
+			QuantityReceived: l.QuantityReceived,
+			Outstanding:      l.Outstanding(),
+			UnitPrice:        l.UnitPrice,
+			Subtotal:         l.Subtotal,
+			Tax:              l.Tax,
+			Total:            l.Total,
+			Notes:            l.Notes,
+		}
+		if l.Item != nil {
+			line.ItemName = l.Item.Name
+			line.Unit = MapToUnitResponse(l.Item.Unit)
+		}
+		res.Lines = append(res.Lines, line)
+	}
+	return res
+}
+
+// dateString formats an optional date, keeping nil as JSON null
+func dateString(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.DateOnly)
+	return &s
+}
+
+// DraftReordersRequest drafts purchase orders from low stock
+// (POST /inventory/reorder-suggestions/drafts)
+type DraftReordersRequest struct {
+	RestaurantID string `json:"restaurant_id" binding:"required,uuid"`
+	Mode         string `json:"mode" binding:"omitempty,oneof=standard forecast"`
+}
+
+type ReorderLineResponse struct {
+	InventoryItemID string        `json:"inventory_item_id"`
+	ItemName        string        `json:"item_name"`
+	Unit            *UnitResponse `json:"unit"`
+	CurrentStock    float64       `json:"current_stock"`
+	ReorderPoint    *float64      `json:"reorder_point"`
+	OnOrder         float64       `json:"on_order"`
+	DailyUsage      float64       `json:"daily_usage"`
+	Quantity        float64       `json:"quantity"`
+	UnitPrice       float64       `json:"unit_price"`
+	Subtotal        float64       `json:"subtotal"`
+}
+
+type ReorderProposalResponse struct {
+	SupplierID         string                `json:"supplier_id"`
+	SupplierName       string                `json:"supplier_name"`
+	NextDelivery       string                `json:"next_delivery"`
+	MinimumOrderAmount *float64              `json:"minimum_order_amount"`
+	MeetsMinimum       bool                  `json:"meets_minimum"`
+	Subtotal           float64               `json:"subtotal"`
+	Lines              []ReorderLineResponse `json:"lines"`
+}
+
+type ReorderPlanResponse struct {
+	Mode       string                    `json:"mode"`
+	Proposals  []ReorderProposalResponse `json:"proposals"`
+	Unassigned []ReorderLineResponse     `json:"unassigned"`
+	Drafts     []PurchaseOrderResponse   `json:"drafts,omitempty"`
+}
+
+func MapToReorderPlanResponse(plan *purchasing.ReorderPlan, drafts []*purchasing.PurchaseOrder) ReorderPlanResponse {
+	res := ReorderPlanResponse{
+		Mode:       string(plan.Mode),
+		Proposals:  make([]ReorderProposalResponse, 0, len(plan.Proposals)),
+		Unassigned: mapToReorderLines(plan.Unassigned),
+	}
+	for _, p := range plan.Proposals {
+		res.Proposals = append(res.Proposals, ReorderProposalResponse{
+			SupplierID:         p.Supplier.ID.String(),
+			SupplierName:       p.Supplier.Name,
+			NextDelivery:       p.NextDelivery.Format(time.DateOnly),
+			MinimumOrderAmount: p.Supplier.MinimumOrderAmount,
+			MeetsMinimum:       p.MeetsMinimum,
+			Subtotal:           p.Subtotal,
+			Lines:              mapToReorderLines(p.Lines),
+		})
+	}
+	for _, po := range drafts {
+		res.Drafts = append(res.Drafts, MapToPurchaseOrderResponse(po))
+	}
+	return res
+}
+
+func mapToReorderLines(lines []*purchasing.ReorderLine) []ReorderLineResponse {
+	res := make([]ReorderLineResponse, 0, len(lines))
+	for _, l := range lines {
+		res = append(res, ReorderLineResponse{
+			InventoryItemID: l.Item.ID.String(),
+			ItemName:        l.Item.Name,
+			Unit:            MapToUnitResponse(l.Item.Unit),
+			CurrentStock:    l.Item.CurrentStock,
+			ReorderPoint:    l.Item.ReorderPoint,
+			OnOrder:         l.OnOrder,
+			DailyUsage:      l.DailyUsage,
+			Quantity:        l.Quantity,
+			UnitPrice:       l.UnitPrice,
+			Subtotal:        l.Subtotal,
+		})
+	}
+	return res
+}
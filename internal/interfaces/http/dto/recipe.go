@@ -0,0 +1,151 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+)
+
+// SaveRecipeRequest defines a menu item's recipe (PUT /menu-items/:id/recipe)
+// and replaces any existing one. Ingredient quantities cover ServingSize
+// portions.
+type SaveRecipeRequest struct {
+	Name            string                    `json:"name" binding:"omitempty,max=255"`
+	Description     string                    `json:"description"`
+	Instructions    string                    `json:"instructions"`
+	PreparationTime *int                      `json:"preparation_time" binding:"omitempty,min=0"`
+	CookingTime     *int                      `json:"cooking_time" binding:"omitempty,min=0"`
+	ServingSize     int                       `json:"serving_size" binding:"omitempty,min=1"`
+	YieldQuantity   *float64                  `json:"yield_quantity" binding:"omitempty,gt=0"`
+	YieldUnit       string                    `json:"yield_unit" binding:"omitempty,max=50"`
+	Notes           string                    `json:"notes"`
+	Ingredients     []RecipeIngredientRequest `json:"ingredients" binding:"required,min=1,dive"`
+}
+
+// RecipeIngredientRequest is one line of a recipe. Unit is an abbreviation
+// or name from units_of_measure and defaults to the inventory item's unit.
+type RecipeIngredientRequest struct {
+	InventoryItemID string  `json:"inventory_item_id" binding:"required,uuid"`
+	Quantity        float64 `json:"quantity" binding:"required,gt=0"`
+	Unit            string  `json:"unit"`
+	IsOptional      bool    `json:"is_optional"`
+	Notes           string  `json:"notes"`
+}
+
+type RecipeIngredientResponse struct {
+	ID              string        `json:"id"`
+	InventoryItemID string        `json:"inventory_item_id"`
+	ItemName        string        `json:"item_name"`
+	Quantity        float64       `json:"quantity"`
+	Unit            *UnitResponse `json:"unit"`
+	IsOptional      bool          `json:"is_optional"`
+	Notes           string        `json:"notes,omitempty"`
+	// Per portion, in the inventory item's unit
+	PortionQuantity float64 `json:"portion_quantity"`
+	UnitCost        float64 `json:"unit_cost"`
+	Cost            float64 `json:"cost"`
+}
+
+type RecipeResponse struct {
+	ID              string                     `json:"id"`
+	MenuItemID      string                     `json:"menu_item_id"`
+	Name            string                     `json:"name"`
+	Description     string                     `json:"description,omitempty"`
+	Instructions    string                     `json:"instructions,omitempty"`
+	PreparationTime *int                       `json:"preparation_time"`
+	CookingTime     *int                       `json:"cooking_time"`
+	ServingSize     int                        `json:"serving_size"`
+	YieldQuantity   *float64                   `json:"yield_quantity"`
+	YieldUnit       string                     `json:"yield_unit,omitempty"`
+	Notes           string                     `json:"notes,omitempty"`
+	Ingredients     []RecipeIngredientResponse `json:"ingredients"`
+	MenuPrice       float64                    `json:"menu_price"`
+	PlateCost       float64                    `json:"plate_cost"`
+	FoodCostPercent *float64                   `json:"food_cost_percent"`
+	UpdatedAt       string                     `json:"updated_at"`
+}
+
+type FoodCostLineResponse struct {
+	MenuItemID      string   `json:"menu_item_id"`
+	MenuItemName    string   `json:"menu_item_name"`
+	RecipeID        string   `json:"recipe_id"`
+	MenuPrice       float64  `json:"menu_price"`
+	PlateCost       float64  `json:"plate_cost"`
+	FoodCostPercent *float64 `json:"food_cost_percent"`
+	ExceedsTarget   bool     `json:"exceeds_target"`
+}
+
+type FoodCostReportResponse struct {
+	RestaurantID     string                 `json:"restaurant_id"`
+	ThresholdPercent float64                `json:"threshold_percent"`
+	Items            []FoodCostLineResponse `json:"items"`
+	Flagged          int                    `json:"flagged"`
+}
+
+func MapToRecipeResponse(pc *recipe.PlateCost) RecipeResponse {
+	r := pc.Recipe
+	res := RecipeResponse{
+		ID:              r.ID.String(),
+		MenuItemID:      r.MenuItemID.String(),
+		Name:            r.Name,
+		Description:     r.Description,
+		Instructions:    r.Instructions,
+		PreparationTime: r.PreparationTime,
+		CookingTime:     r.CookingTime,
+		ServingSize:     r.ServingSize,
+		YieldQuantity:   r.YieldQuantity,
+		YieldUnit:       r.YieldUnit,
+		Notes:           r.Notes,
+		Ingredients:     make([]RecipeIngredientResponse, 0, len(pc.Lines)),
+		MenuPrice:       pc.MenuPrice,
+		PlateCost:       pc.Cost,
+		FoodCostPercent: pc.FoodCostPercent,
+		UpdatedAt:       r.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, line := range pc.Lines {
+		ing := line.Ingredient
+		unit := ing.Unit
+		if unit == nil {
+			unit = ing.Item.Unit
+		}
+		res.Ingredients = append(res.Ingredients, RecipeIngredientResponse{
+			ID:              ing.ID.String(),
+			InventoryItemID: ing.InventoryItemID.String(),
+			ItemName:        ing.Item.Name,
+			Quantity:        ing.Quantity,
+			Unit:            MapToUnitResponse(unit),
+			IsOptional:      ing.IsOptional,
+			Notes:           ing.Notes,
+			PortionQuantity: line.Quantity,
+			UnitCost:        line.UnitCost,
+			Cost:            line.Cost,
+		})
+	}
+	return res
+}
+
+func MapToFoodCostReportResponse(restaurantID string, threshold float64, costs []*recipe.PlateCost) FoodCostReportResponse {
+	res := FoodCostReportResponse{
+		RestaurantID:     restaurantID,
+		ThresholdPercent: threshold,
+		Items:            make([]FoodCostLineResponse, 0, len(costs)),
+	}
+	for _, pc := range costs {
+		line := FoodCostLineResponse{
+			MenuItemID:      pc.Recipe.MenuItemID.String(),
+			RecipeID:        pc.Recipe.ID.String(),
+			MenuPrice:       pc.MenuPrice,
+			PlateCost:       pc.Cost,
+			FoodCostPercent: pc.FoodCostPercent,
+			ExceedsTarget:   pc.Exceeds(threshold),
+		}
+		if pc.Recipe.MenuItem != nil {
+			line.MenuItemName = pc.Recipe.MenuItem.Name
+		}
+		if line.ExceedsTarget {
+			res.Flagged++
+		}
+		res.Items = append(res.Items, line)
+	}
+	return res
+}
@@ -0,0 +1,618 @@
+package dto
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// CSVReport is a report that can be written as CSV: a header and one
+// record per row of the report.
+type CSVReport interface {
+	CSVHeader() []string
+	CSVRecords() [][]string
+}
+
+// ReportPeriodResponse is the restaurant and [from, to) a report covers
+type ReportPeriodResponse struct {
+	RestaurantID string `json:"restaurant_id"`
+	From         string `json:"from"`
+	To           string `json:"to"`
+}
+
+// SalesResponse sums orders that weren't cancelled. Items counts units
+// ordered; subtotal is before tax and fees and discount is taken off it.
+type SalesResponse struct {
+	Orders            int     `json:"orders"`
+	Items             int     `json:"items"`
+	Subtotal          float64 `json:"subtotal"`
+	Discount          float64 `json:"discount"`
+	Total             float64 `json:"total"`
+	AverageOrderValue float64 `json:"average_order_value"`
+}
+
+type DailySalesResponse struct {
+	Date string `json:"date"`
+	SalesResponse
+}
+
+type DailySalesReportResponse struct {
+	ReportPeriodResponse
+	Totals SalesResponse        `json:"totals"`
+	Days   []DailySalesResponse `json:"days"`
+}
+
+// HourlySalesResponse is the sales placed in one hour of the day (0-23)
+// over the whole period.
+type HourlySalesResponse struct {
+	Hour int `json:"hour"`
+	SalesResponse
+}
+
+type HourlySalesReportResponse struct {
+	ReportPeriodResponse
+	Hours []HourlySalesResponse `json:"hours"`
+}
+
+// ItemSalesResponse is what a menu item sold. Revenue is before
+// order-level discounts.
+type ItemSalesResponse struct {
+	MenuItemID   string  `json:"menu_item_id"`
+	ItemName     string  `json:"item_name"`
+	CategoryID   *string `json:"category_id"`
+	CategoryName string  `json:"category_name,omitempty"`
+	Quantity     int     `json:"quantity"`
+	Orders       int     `json:"orders"`
+	Revenue      float64 `json:"revenue"`
+}
+
+type ItemSalesReportResponse struct {
+	ReportPeriodResponse
+	Items []ItemSalesResponse `json:"items"`
+}
+
+type CategorySalesResponse struct {
+	CategoryID   *string `json:"category_id"`
+	CategoryName string  `json:"category_name,omitempty"`
+	Quantity     int     `json:"quantity"`
+	Orders       int     `json:"orders"`
+	Revenue      float64 `json:"revenue"`
+}
+
+type CategorySalesReportResponse struct {
+	ReportPeriodResponse
+	Categories []CategorySalesResponse `json:"categories"`
+}
+
+// OrderTypeSalesResponse is the sales of one order type; share is its
+// fraction of the period's orders.
+type OrderTypeSalesResponse struct {
+	OrderType string `json:"order_type"`
+	SalesResponse
+	Share float64 `json:"share"`
+}
+
+// OrderSummaryResponse is the average order value overall and the mix of
+// order types.
+type OrderSummaryResponse struct {
+	ReportPeriodResponse
+	Totals     SalesResponse            `json:"totals"`
+	OrderTypes []OrderTypeSalesResponse `json:"order_types"`
+}
+
+type CancellationReasonResponse struct {
+	Reason string  `json:"reason"`
+	Orders int     `json:"orders"`
+	Value  float64 `json:"value"`
+}
+
+// CancellationsResponse compares the orders placed with those of them that
+// were cancelled; rate is cancelled over placed.
+type CancellationsResponse struct {
+	ReportPeriodResponse
+	Placed    int                          `json:"placed"`
+	Cancelled int                          `json:"cancelled"`
+	Rate      float64                      `json:"rate"`
+	Value     float64                      `json:"value"`
+	Reasons   []CancellationReasonResponse `json:"reasons"`
+}
+
+type StockValueResponse struct {
+	InventoryItemID string  `json:"inventory_item_id"`
+	Name            string  `json:"name"`
+	CurrentStock    float64 `json:"current_stock"`
+	Unit            string  `json:"unit,omitempty"`
+	AverageCost     float64 `json:"average_cost"`
+	TotalValue      float64 `json:"total_value"`
+	CategoryName    string  `json:"category_name,omitempty"`
+	SupplierName    string  `json:"supplier_name,omitempty"`
+	LowStock        bool    `json:"low_stock"`
+}
+
+// InventoryValueResponse is what the restaurant's active stock is worth
+// now at average cost.
+type InventoryValueResponse struct {
+	RestaurantID string               `json:"restaurant_id"`
+	TotalValue   float64              `json:"total_value"`
+	Items        []StockValueResponse `json:"items"`
+}
+
+type LowStockItemResponse struct {
+	InventoryItemID string   `json:"inventory_item_id"`
+	Name            string   `json:"name"`
+	CurrentStock    float64  `json:"current_stock"`
+	MinimumStock    float64  `json:"minimum_stock"`
+	ReorderPoint    *float64 `json:"reorder_point"`
+	ReorderQuantity *float64 `json:"reorder_quantity"`
+	Unit            string   `json:"unit,omitempty"`
+	SupplierName    string   `json:"supplier_name,omitempty"`
+	SupplierPhone   string   `json:"supplier_phone,omitempty"`
+}
+
+type LowStockResponse struct {
+	RestaurantID string                 `json:"restaurant_id"`
+	Items        []LowStockItemResponse `json:"items"`
+}
+
+type UsageResponse struct {
+	Date            string  `json:"date"`
+	InventoryItemID string  `json:"inventory_item_id"`
+	ItemName        string  `json:"item_name"`
+	Used            float64 `json:"used"`
+	Unit            string  `json:"unit,omitempty"`
+	Cost            float64 `json:"cost"`
+}
+
+type InventoryUsageResponse struct {
+	ReportPeriodResponse
+	TotalCost float64         `json:"total_cost"`
+	Usage     []UsageResponse `json:"usage"`
+}
+
+// EngineeredItemResponse is a menu item's place on the menu-engineering
+// grid. Plate cost and margins are per plate and null without a recipe;
+// menu_mix is the item's share of units sold and popularity_index that
+// share over an equal one.
+type EngineeredItemResponse struct {
+	MenuItemID         string   `json:"menu_item_id"`
+	ItemName           string   `json:"item_name"`
+	CategoryID         *string  `json:"category_id"`
+	Quantity           int      `json:"quantity"`
+	Revenue            float64  `json:"revenue"`
+	SellingPrice       float64  `json:"selling_price"`
+	PlateCost          *float64 `json:"plate_cost"`
+	ContributionMargin *float64 `json:"contribution_margin"`
+	TotalMargin        float64  `json:"total_margin"`
+	MenuMix            float64  `json:"menu_mix"`
+	PopularityIndex    float64  `json:"popularity_index"`
+	Quadrant           string   `json:"quadrant"`
+	Actions            []string `json:"suggested_actions"`
+}
+
+// MenuEngineeringResponse classifies the menu: items are popular at a
+// popularity index of popularity_threshold or more and earn well at a
+// contribution margin of average_margin or more.
+type MenuEngineeringResponse struct {
+	ReportPeriodResponse
+	Quantity            int                      `json:"quantity"`
+	Revenue             float64                  `json:"revenue"`
+	TotalMargin         float64                  `json:"total_margin"`
+	AverageMargin       float64                  `json:"average_margin"`
+	PopularityThreshold float64                  `json:"popularity_threshold"`
+	Quadrants           map[string]int           `json:"quadrants"`
+	Items               []EngineeredItemResponse `json:"items"`
+}
+
+func mapToReportPeriod(p report.Period) ReportPeriodResponse {
+	return ReportPeriodResponse{
+		RestaurantID: p.RestaurantID.String(),
+		From:         p.From.Format(time.RFC3339),
+		To:           p.To.Format(time.RFC3339),
+	}
+}
+
+func mapToSalesResponse(s report.Sales) SalesResponse {
+	return SalesResponse{
+		Orders:            s.Orders,
+		Items:             s.Items,
+		Subtotal:          s.Subtotal,
+		Discount:          s.Discount,
+		Total:             s.Total,
+		AverageOrderValue: s.AverageOrderValue(),
+	}
+}
+
+func MapToDailySalesReport(p report.Period, rows []*report.DailySales) DailySalesReportResponse {
+	res := DailySalesReportResponse{
+		ReportPeriodResponse: mapToReportPeriod(p),
+		Days:                 make([]DailySalesResponse, 0, len(rows)),
+	}
+	var totals report.Sales
+	for _, r := range rows {
+		totals.Add(r.Sales)
+		res.Days = append(res.Days, DailySalesResponse{
+			Date:          r.Day.Format(time.DateOnly),
+			SalesResponse: mapToSalesResponse(r.Sales),
+		})
+	}
+	res.Totals = mapToSalesResponse(totals)
+	return res
+}
+
+func MapToHourlySalesReport(p report.Period, rows []*report.HourlySales) HourlySalesReportResponse {
+	res := HourlySalesReportResponse{
+		ReportPeriodResponse: mapToReportPeriod(p),
+		Hours:                make([]HourlySalesResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		res.Hours = append(res.Hours, HourlySalesResponse{
+			Hour:          r.Hour,
+			SalesResponse: mapToSalesResponse(r.Sales),
+		})
+	}
+	return res
+}
+
+func MapToItemSalesReport(p report.Period, rows []*report.ItemSales) ItemSalesReportResponse {
+	res := ItemSalesReportResponse{
+		ReportPeriodResponse: mapToReportPeriod(p),
+		Items:                make([]ItemSalesResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		res.Items = append(res.Items, ItemSalesResponse{
+			MenuItemID:   r.MenuItemID.String(),
+			ItemName:     r.ItemName,
+			CategoryID:   uuidString(r.CategoryID),
+			CategoryName: r.CategoryName,
+			Quantity:     r.Quantity,
+			Orders:       r.Orders,
+			Revenue:      r.Revenue,
+		})
+	}
+	return res
+}
+
+func MapToCategorySalesReport(p report.Period, rows []*report.CategorySales) CategorySalesReportResponse {
+	res := CategorySalesReportResponse{
+		ReportPeriodResponse: mapToReportPeriod(p),
+		Categories:           make([]CategorySalesResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		res.Categories = append(res.Categories, CategorySalesResponse{
+			CategoryID:   uuidString(r.CategoryID),
+			CategoryName: r.CategoryName,
+			Quantity:     r.Quantity,
+			Orders:       r.Orders,
+			Revenue:      r.Revenue,
+		})
+	}
+	return res
+}
+
+func MapToOrderSummaryResponse(s *report.OrderSummary) OrderSummaryResponse {
+	res := OrderSummaryResponse{
+		ReportPeriodResponse: mapToReportPeriod(s.Period),
+		Totals:               mapToSalesResponse(s.Totals),
+		OrderTypes:           make([]OrderTypeSalesResponse, 0, len(s.Types)),
+	}
+	for _, t := range s.Types {
+		res.OrderTypes = append(res.OrderTypes, OrderTypeSalesResponse{
+			OrderType:     t.OrderType,
+			SalesResponse: mapToSalesResponse(t.Sales),
+			Share:         math.Round(s.Share(t)*1000) / 1000,
+		})
+	}
+	return res
+}
+
+func MapToCancellationsResponse(c *report.Cancellations) CancellationsResponse {
+	res := CancellationsResponse{
+		ReportPeriodResponse: mapToReportPeriod(c.Period),
+		Placed:               c.Placed,
+		Cancelled:            c.Cancelled,
+		Rate:                 math.Round(c.Rate()*1000) / 1000,
+		Value:                c.Value,
+		Reasons:              make([]CancellationReasonResponse, 0, len(c.Reasons)),
+	}
+	for _, r := range c.Reasons {
+		res.Reasons = append(res.Reasons, CancellationReasonResponse{
+			Reason: r.Reason,
+			Orders: r.Orders,
+			Value:  r.Value,
+		})
+	}
+	return res
+}
+
+func MapToInventoryValueResponse(restaurantID string, rows []*report.StockValue) InventoryValueResponse {
+	res := InventoryValueResponse{
+		RestaurantID: restaurantID,
+		Items:        make([]StockValueResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		value := money.Round(r.TotalValue)
+		res.TotalValue = money.Sum(res.TotalValue, value)
+		res.Items = append(res.Items, StockValueResponse{
+			InventoryItemID: r.InventoryItemID.String(),
+			Name:            r.Name,
+			CurrentStock:    r.CurrentStock,
+			Unit:            r.Unit,
+			AverageCost:     r.AverageCost,
+			TotalValue:      value,
+			CategoryName:    r.CategoryName,
+			SupplierName:    r.SupplierName,
+			LowStock:        r.LowStock,
+		})
+	}
+	return res
+}
+
+func MapToLowStockResponse(restaurantID string, rows []*report.LowStockItem) LowStockResponse {
+	res := LowStockResponse{
+		RestaurantID: restaurantID,
+		Items:        make([]LowStockItemResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		res.Items = append(res.Items, LowStockItemResponse{
+			InventoryItemID: r.InventoryItemID.String(),
+			Name:            r.Name,
+			CurrentStock:    r.CurrentStock,
+			MinimumStock:    r.MinimumStock,
+			ReorderPoint:    r.ReorderPoint,
+			ReorderQuantity: r.ReorderQuantity,
+			Unit:            r.Unit,
+			SupplierName:    r.SupplierName,
+			SupplierPhone:   r.SupplierPhone,
+		})
+	}
+	return res
+}
+
+func MapToInventoryUsageResponse(p report.Period, rows []*report.Usage) InventoryUsageResponse {
+	res := InventoryUsageResponse{
+		ReportPeriodResponse: mapToReportPeriod(p),
+		Usage:                make([]UsageResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		res.TotalCost = money.Sum(res.TotalCost, r.Cost)
+		res.Usage = append(res.Usage, UsageResponse{
+			Date:            r.Day.Format(time.DateOnly),
+			InventoryItemID: r.InventoryItemID.String(),
+			ItemName:        r.ItemName,
+			Used:            r.Used,
+			Unit:            r.Unit,
+			Cost:            r.Cost,
+		})
+	}
+	return res
+}
+
+func MapToMenuEngineeringResponse(me *report.MenuEngineering) MenuEngineeringResponse {
+	res := MenuEngineeringResponse{
+		ReportPeriodResponse: mapToReportPeriod(me.Period),
+		Quantity:             me.Quantity,
+		Revenue:              me.Revenue,
+		TotalMargin:          me.TotalMargin,
+		AverageMargin:        me.AverageMargin,
+		PopularityThreshold:  report.PopularityFactor,
+		Quadrants:            map[string]int{},
+		Items:                make([]EngineeredItemResponse, 0, len(me.Items)),
+	}
+	for _, e := range me.Items {
+		res.Quadrants[string(e.Quadrant)]++
+		res.Items = append(res.Items, EngineeredItemResponse{
+			MenuItemID:         e.MenuItem.ID.String(),
+			ItemName:           e.MenuItem.Name,
+			CategoryID:         uuidString(e.MenuItem.CategoryID),
+			Quantity:           e.Quantity,
+			Revenue:            e.Revenue,
+			SellingPrice:       e.SellingPrice,
+			PlateCost:          e.PlateCost,
+			ContributionMargin: e.ContributionMargin,
+			TotalMargin:        e.TotalMargin,
+			MenuMix:            math.Round(e.MenuMix*10000) / 10000,
+			PopularityIndex:    math.Round(e.PopularityIndex*100) / 100,
+			Quadrant:           string(e.Quadrant),
+			Actions:            append([]string{}, e.Actions...),
+		})
+	}
+	return res
+}
+
+var salesHeader = []string{"orders", "items", "subtotal", "discount", "total", "average_order_value"}
+
+func (s SalesResponse) csv() []string {
+	return []string{
+		strconv.Itoa(s.Orders), strconv.Itoa(s.Items),
+		csvMoney(s.Subtotal), csvMoney(s.Discount), csvMoney(s.Total), csvMoney(s.AverageOrderValue),
+	}
+}
+
+func (r DailySalesReportResponse) CSVHeader() []string {
+	return append([]string{"date"}, salesHeader...)
+}
+
+func (r DailySalesReportResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Days))
+	for _, d := range r.Days {
+		records = append(records, append([]string{d.Date}, d.csv()...))
+	}
+	return records
+}
+
+func (r HourlySalesReportResponse) CSVHeader() []string {
+	return append([]string{"hour"}, salesHeader...)
+}
+
+func (r HourlySalesReportResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Hours))
+	for _, h := range r.Hours {
+		records = append(records, append([]string{strconv.Itoa(h.Hour)}, h.csv()...))
+	}
+	return records
+}
+
+func (r ItemSalesReportResponse) CSVHeader() []string {
+	return []string{"menu_item_id", "item_name", "category_name", "quantity", "orders", "revenue"}
+}
+
+func (r ItemSalesReportResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Items))
+	for _, i := range r.Items {
+		records = append(records, []string{
+			i.MenuItemID, i.ItemName, i.CategoryName,
+			strconv.Itoa(i.Quantity), strconv.Itoa(i.Orders), csvMoney(i.Revenue),
+		})
+	}
+	return records
+}
+
+func (r CategorySalesReportResponse) CSVHeader() []string {
+	return []string{"category_id", "category_name", "quantity", "orders", "revenue"}
+}
+
+func (r CategorySalesReportResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Categories))
+	for _, c := range r.Categories {
+		var id string
+		if c.CategoryID != nil {
+			id = *c.CategoryID
+		}
+		records = append(records, []string{
+			id, c.CategoryName, strconv.Itoa(c.Quantity), strconv.Itoa(c.Orders), csvMoney(c.Revenue),
+		})
+	}
+	return records
+}
+
+func (r OrderSummaryResponse) CSVHeader() []string {
+	header := append([]string{"order_type"}, salesHeader...)
+	return append(header, "share")
+}
+
+// CSVRecords ends with an "all" row holding the totals
+func (r OrderSummaryResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.OrderTypes)+1)
+	for _, t := range r.OrderTypes {
+		record := append([]string{t.OrderType}, t.csv()...)
+		records = append(records, append(record, csvRatio(t.Share)))
+	}
+	all := append([]string{"all"}, r.Totals.csv()...)
+	return append(records, append(all, csvRatio(1)))
+}
+
+func (r CancellationsResponse) CSVHeader() []string {
+	return []string{"reason", "orders", "value", "share"}
+}
+
+func (r CancellationsResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Reasons))
+	for _, c := range r.Reasons {
+		var share float64
+		if r.Cancelled > 0 {
+			share = float64(c.Orders) / float64(r.Cancelled)
+		}
+		records = append(records, []string{c.Reason, strconv.Itoa(c.Orders), csvMoney(c.Value), csvRatio(share)})
+	}
+	return records
+}
+
+func (r InventoryValueResponse) CSVHeader() []string {
+	return []string{
+		"inventory_item_id", "name", "current_stock", "unit", "average_cost", "total_value",
+		"category_name", "supplier_name", "low_stock",
+	}
+}
+
+func (r InventoryValueResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Items))
+	for _, i := range r.Items {
+		records = append(records, []string{
+			i.InventoryItemID, i.Name, csvQuantity(i.CurrentStock), i.Unit, csvMoney(i.AverageCost),
+			csvMoney(i.TotalValue), i.CategoryName, i.SupplierName, strconv.FormatBool(i.LowStock),
+		})
+	}
+	return records
+}
+
+func (r LowStockResponse) CSVHeader() []string {
+	return []string{
+		"inventory_item_id", "name", "current_stock", "minimum_stock", "reorder_point", "reorder_quantity",
+		"unit", "supplier_name", "supplier_phone",
+	}
+}
+
+func (r LowStockResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Items))
+	for _, i := range r.Items {
+		var point, qty string
+		if i.ReorderPoint != nil {
+			point = csvQuantity(*i.ReorderPoint)
+		}
+		if i.ReorderQuantity != nil {
+			qty = csvQuantity(*i.ReorderQuantity)
+		}
+		records = append(records, []string{
+			i.InventoryItemID, i.Name, csvQuantity(i.CurrentStock), csvQuantity(i.MinimumStock), point, qty,
+			i.Unit, i.SupplierName, i.SupplierPhone,
+		})
+	}
+	return records
+}
+
+func (r InventoryUsageResponse) CSVHeader() []string {
+	return []string{"date", "inventory_item_id", "item_name", "used", "unit", "cost"}
+}
+
+func (r InventoryUsageResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Usage))
+	for _, u := range r.Usage {
+		records = append(records, []string{
+			u.Date, u.InventoryItemID, u.ItemName, csvQuantity(u.Used), u.Unit, csvMoney(u.Cost),
+		})
+	}
+	return records
+}
+
+func (r MenuEngineeringResponse) CSVHeader() []string {
+	return []string{
+		"menu_item_id", "item_name", "quantity", "revenue", "selling_price", "plate_cost",
+		"contribution_margin", "total_margin", "menu_mix", "popularity_index", "quadrant", "suggested_actions",
+	}
+}
+
+// CSVRecords joins the suggested actions with "; "
+func (r MenuEngineeringResponse) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Items))
+	for _, i := range r.Items {
+		var cost, margin string
+		if i.PlateCost != nil {
+			cost = csvMoney(*i.PlateCost)
+		}
+		if i.ContributionMargin != nil {
+			margin = csvMoney(*i.ContributionMargin)
+		}
+		records = append(records, []string{
+			i.MenuItemID, i.ItemName, strconv.Itoa(i.Quantity), csvMoney(i.Revenue), csvMoney(i.SellingPrice),
+			cost, margin, csvMoney(i.TotalMargin), strconv.FormatFloat(i.MenuMix, 'f', 4, 64),
+			strconv.FormatFloat(i.PopularityIndex, 'f', 2, 64), i.Quadrant, strings.Join(i.Actions, "; "),
+		})
+	}
+	return records
+}
+
+func csvMoney(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func csvQuantity(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func csvRatio(v float64) string {
+	return strconv.FormatFloat(v, 'f', 3, 64)
+}
@@ -28,6 +28,8 @@ type CreateRestaurantRequest struct {
 	DeliveryFee           *float64 `json:"delivery_fee"`
 	MinimumOrder          *float64 `json:"minimum_order"`
 	EstimatedDeliveryTime *int     `json:"estimated_delivery_time"`
+	PricesIncludeTax      *bool    `json:"prices_include_tax"`
+	ValuationMethod       *string  `json:"valuation_method" binding:"omitempty,oneof=fifo weighted_average"`
 
 	LogoURL   string `json:"logo_url"`
 	BannerURL string `json:"banner_url"`
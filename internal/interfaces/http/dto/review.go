@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/review"
+)
+
+// CreateReviewRequest reviews a delivered order (POST /orders/:id/review).
+// Items optionally rate the dishes on it, each order line at most once.
+type CreateReviewRequest struct {
+	Rating  int                 `json:"rating" binding:"required,min=1,max=5"`
+	Comment string              `json:"comment" binding:"max=2000"`
+	Items   []ReviewItemRequest `json:"items" binding:"omitempty,dive"`
+}
+
+type ReviewItemRequest struct {
+	OrderItemID string `json:"order_item_id" binding:"required,uuid"`
+	Rating      int    `json:"rating" binding:"required,min=1,max=5"`
+	Comment     string `json:"comment" binding:"max=1000"`
+}
+
+// ReplyReviewRequest is the restaurant's public reply
+// (POST /reviews/:id/reply); replying again replaces it.
+type ReplyReviewRequest struct {
+	Reply string `json:"reply" binding:"required,max=2000"`
+}
+
+// HideReviewRequest takes a review down (POST /reviews/:id/hide).
+type HideReviewRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type ReviewItemResponse struct {
+	OrderItemID string `json:"order_item_id"`
+	MenuItemID  string `json:"menu_item_id"`
+	ItemName    string `json:"item_name,omitempty"`
+	Rating      int    `json:"rating"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// ReviewResponse is a review with the restaurant's reply. Moderation
+// fields are only filled in for staff.
+type ReviewResponse struct {
+	ID               string               `json:"id"`
+	RestaurantID     string               `json:"restaurant_id"`
+	OrderID          string               `json:"order_id"`
+	Rating           int                  `json:"rating"`
+	Comment          string               `json:"comment,omitempty"`
+	Items            []ReviewItemResponse `json:"items"`
+	Reply            string               `json:"reply,omitempty"`
+	RepliedAt        *string              `json:"replied_at"`
+	Status           string               `json:"status"`
+	ModerationReason string               `json:"moderation_reason,omitempty"`
+	ModeratedBy      *string              `json:"moderated_by,omitempty"`
+	ModeratedAt      *string              `json:"moderated_at,omitempty"`
+	CreatedAt        string               `json:"created_at"`
+}
+
+// ReviewSummaryResponse is the restaurant's visible reviews: the average
+// rating, how many there are and how many gave each number of stars.
+type ReviewSummaryResponse struct {
+	Rating       float64        `json:"rating"`
+	TotalReviews int            `json:"total_reviews"`
+	Stars        map[string]int `json:"stars"`
+}
+
+type ReviewListResponse struct {
+	RestaurantID string                `json:"restaurant_id"`
+	Summary      ReviewSummaryResponse `json:"summary"`
+	Reviews      []ReviewResponse      `json:"reviews"`
+}
+
+// MapToReviewResponse maps a review; moderation details are left out
+// unless staff is set.
+func MapToReviewResponse(entity *review.Review, staff bool) ReviewResponse {
+	res := ReviewResponse{
+		ID:           entity.ID.String(),
+		RestaurantID: entity.RestaurantID.String(),
+		OrderID:      entity.OrderID.String(),
+		Rating:       entity.Rating,
+		Comment:      entity.Comment,
+		Items:        make([]ReviewItemResponse, 0, len(entity.Items)),
+		Reply:        entity.Reply,
+		RepliedAt:    timeString(entity.RepliedAt),
+		Status:       string(entity.Status),
+		CreatedAt:    entity.CreatedAt.Format(time.RFC3339),
+	}
+	if staff {
+		res.ModerationReason = entity.ModerationReason
+		res.ModeratedBy = uuidString(entity.ModeratedBy)
+		res.ModeratedAt = timeString(entity.ModeratedAt)
+	}
+	for _, i := range entity.Items {
+		item := ReviewItemResponse{
+			OrderItemID: i.OrderItemID.String(),
+			MenuItemID:  i.MenuItemID.String(),
+			Rating:      i.Rating,
+			Comment:     i.Comment,
+		}
+		if i.OrderItem != nil {
+			item.ItemName = i.OrderItem.ItemName
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res
+}
+
+func MapToReviewListResponse(
+	restaurantID string, summary *review.Summary, reviews []*review.Review, staff bool,
+) ReviewListResponse {
+	res := ReviewListResponse{
+		RestaurantID: restaurantID,
+		Summary: ReviewSummaryResponse{
+			Rating:       math.Round(summary.Rating*100) / 100,
+			TotalReviews: summary.TotalReviews,
+			Stars:        make(map[string]int, len(summary.Stars)),
+		},
+		Reviews: make([]ReviewResponse, 0, len(reviews)),
+	}
+	for stars, n := range summary.Stars {
+		res.Summary.Stars[strconv.Itoa(stars)] = n
+	}
+	for _, rv := range reviews {
+		res.Reviews = append(res.Reviews, MapToReviewResponse(rv, staff))
+	}
+	return res
+}
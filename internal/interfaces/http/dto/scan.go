@@ -0,0 +1,220 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+// StartScanSessionRequest opens a scan session
+// (POST /inventory/scan-sessions): receiving scans what arrives against an
+// ordered purchase order, count scans the shelves for an open stocktake.
+type StartScanSessionRequest struct {
+	Type            string `json:"type" binding:"required,oneof=receiving count"`
+	PurchaseOrderID string `json:"purchase_order_id" binding:"omitempty,uuid"`
+	StocktakeID     string `json:"stocktake_id" binding:"omitempty,uuid"`
+	Notes           string `json:"notes"`
+}
+
+// RecordScanRequest is one scan (POST /inventory/scan-sessions/:id/scans).
+// Code is what the scanner sent, a plain EAN/UPC or a GS1-128 element
+// string. Quantity is in the item's unit and defaults to the pack's net
+// weight or count from the barcode, else 1; a negative quantity takes back
+// a scan.
+type RecordScanRequest struct {
+	Code     string   `json:"code" binding:"required,max=200"`
+	Quantity *float64 `json:"quantity" binding:"omitempty,ne=0"`
+}
+
+// MapBarcodeRequest says which item an unknown barcode is
+// (POST /inventory/unknown-barcodes/:id/map). The barcode becomes the
+// item's; Replace overwrites a barcode the item already has.
+type MapBarcodeRequest struct {
+	InventoryItemID string `json:"inventory_item_id" binding:"required,uuid"`
+	Replace         bool   `json:"replace"`
+}
+
+// BarcodeResponse is what a barcode says, parsed from GS1-128 where it is.
+type BarcodeResponse struct {
+	Raw        string   `json:"raw"`
+	GTIN       string   `json:"gtin,omitempty"`
+	SSCC       string   `json:"sscc,omitempty"`
+	Batch      string   `json:"batch_number,omitempty"`
+	Serial     string   `json:"serial,omitempty"`
+	ExpiryDate *string  `json:"expiry_date"`
+	BestBefore *string  `json:"best_before"`
+	Count      *float64 `json:"count"`
+	NetWeight  *float64 `json:"net_weight_kg"`
+}
+
+// BarcodeLookupResponse is the item a scanned barcode belongs to
+// (GET /inventory/barcodes/lookup).
+type BarcodeLookupResponse struct {
+	Barcode BarcodeResponse       `json:"barcode"`
+	Item    InventoryItemResponse `json:"item"`
+}
+
+type ScanLineResponse struct {
+	ID              string  `json:"id"`
+	InventoryItemID string  `json:"inventory_item_id"`
+	ItemName        string  `json:"item_name"`
+	Unit            string  `json:"unit,omitempty"`
+	BatchNumber     string  `json:"batch_number,omitempty"`
+	ExpiryDate      *string `json:"expiry_date"`
+	Quantity        float64 `json:"quantity"`
+	Scans           int     `json:"scans"`
+	LastScannedBy   *string `json:"last_scanned_by"`
+	LastScannedAt   *string `json:"last_scanned_at"`
+}
+
+type UnknownBarcodeResponse struct {
+	ID              string   `json:"id"`
+	RestaurantID    string   `json:"restaurant_id"`
+	ScanSessionID   *string  `json:"scan_session_id"`
+	Barcode         string   `json:"barcode"`
+	RawCode         string   `json:"raw_code"`
+	BatchNumber     string   `json:"batch_number,omitempty"`
+	ExpiryDate      *string  `json:"expiry_date"`
+	Quantity        *float64 `json:"quantity"`
+	Status          string   `json:"status"`
+	InventoryItemID *string  `json:"inventory_item_id"`
+	ScannedBy       *string  `json:"scanned_by"`
+	ResolvedBy      *string  `json:"resolved_by"`
+	ResolvedAt      *string  `json:"resolved_at"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+type ScanSessionResponse struct {
+	ID              string                   `json:"id"`
+	RestaurantID    string                   `json:"restaurant_id"`
+	Type            string                   `json:"type"`
+	PurchaseOrderID *string                  `json:"purchase_order_id"`
+	StocktakeID     *string                  `json:"stocktake_id"`
+	Status          string                   `json:"status"`
+	Notes           string                   `json:"notes,omitempty"`
+	StartedBy       *string                  `json:"started_by"`
+	CommittedBy     *string                  `json:"committed_by"`
+	CommittedAt     *string                  `json:"committed_at"`
+	Lines           []ScanLineResponse       `json:"lines"`
+	Unknown         []UnknownBarcodeResponse `json:"unknown_barcodes"`
+	CreatedAt       string                   `json:"created_at"`
+}
+
+// ScanResponse is what a scan did: Line is the line it added to, or Unknown
+// the queue entry when no item has the barcode.
+type ScanResponse struct {
+	Barcode BarcodeResponse         `json:"barcode"`
+	Line    *ScanLineResponse       `json:"line"`
+	Unknown *UnknownBarcodeResponse `json:"unknown_barcode"`
+}
+
+// MapBarcodeResponse is the item the barcode now belongs to and the lines
+// of open sessions its queued scans were added to.
+type MapBarcodeResponse struct {
+	Item  InventoryItemResponse `json:"item"`
+	Lines []ScanLineResponse    `json:"lines"`
+}
+
+func MapToBarcodeResponse(code gs1.Code) BarcodeResponse {
+	return BarcodeResponse{
+		Raw:        code.Raw,
+		GTIN:       code.GTIN,
+		SSCC:       code.SSCC,
+		Batch:      code.Batch,
+		Serial:     code.Serial,
+		ExpiryDate: dateString(code.Expiry),
+		BestBefore: dateString(code.BestBefore),
+		Count:      code.Count,
+		NetWeight:  code.NetWeight,
+	}
+}
+
+func MapToScanLineResponse(entity *scan.Line) ScanLineResponse {
+	res := ScanLineResponse{
+		ID:              entity.ID.String(),
+		InventoryItemID: entity.InventoryItemID.String(),
+		BatchNumber:     entity.BatchNumber,
+		ExpiryDate:      dateString(entity.ExpiryDate),
+		Quantity:        entity.Quantity,
+		Scans:           entity.Scans,
+		LastScannedBy:   uuidString(entity.LastScannedBy),
+		LastScannedAt:   timeString(entity.LastScannedAt),
+	}
+	if entity.Item != nil {
+		res.ItemName = entity.Item.Name
+		if entity.Item.Unit != nil {
+			res.Unit = entity.Item.Unit.Abbreviation
+		}
+	}
+	return res
+}
+
+func MapToUnknownBarcodeResponse(entity *scan.UnknownBarcode) UnknownBarcodeResponse {
+	return UnknownBarcodeResponse{
+		ID:              entity.ID.String(),
+		RestaurantID:    entity.RestaurantID.String(),
+		ScanSessionID:   uuidString(entity.ScanSessionID),
+		Barcode:         entity.Barcode,
+		RawCode:         entity.RawCode,
+		BatchNumber:     entity.BatchNumber,
+		ExpiryDate:      dateString(entity.ExpiryDate),
+		Quantity:        entity.Quantity,
+		Status:          string(entity.Status),
+		InventoryItemID: uuidString(entity.InventoryItemID),
+		ScannedBy:       uuidString(entity.ScannedBy),
+		ResolvedBy:      uuidString(entity.ResolvedBy),
+		ResolvedAt:      timeString(entity.ResolvedAt),
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func MapToScanSessionResponse(entity *scan.Session) ScanSessionResponse {
+	res := ScanSessionResponse{
+		ID:              entity.ID.String(),
+		RestaurantID:    entity.RestaurantID.String(),
+		Type:            string(entity.Type),
+		PurchaseOrderID: uuidString(entity.PurchaseOrderID),
+		StocktakeID:     uuidString(entity.StocktakeID),
+		Status:          string(entity.Status),
+		Notes:           entity.Notes,
+		StartedBy:       uuidString(entity.StartedBy),
+		CommittedBy:     uuidString(entity.CommittedBy),
+		CommittedAt:     timeString(entity.CommittedAt),
+		Lines:           make([]ScanLineResponse, 0, len(entity.Lines)),
+		Unknown:         make([]UnknownBarcodeResponse, 0, len(entity.Unknown)),
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+	for _, l := range entity.Lines {
+		res.Lines = append(res.Lines, MapToScanLineResponse(l))
+	}
+	for _, u := range entity.Unknown {
+		res.Unknown = append(res.Unknown, MapToUnknownBarcodeResponse(u))
+	}
+	return res
+}
+
+func MapToScanResponse(outcome *scan.Outcome) ScanResponse {
+	res := ScanResponse{Barcode: MapToBarcodeResponse(outcome.Code)}
+	if outcome.Line != nil {
+		line := MapToScanLineResponse(outcome.Line)
+		res.Line = &line
+	}
+	if outcome.Unknown != nil {
+		unknown := MapToUnknownBarcodeResponse(outcome.Unknown)
+		res.Unknown = &unknown
+	}
+	return res
+}
+
+func MapToMapBarcodeResponse(item *inventory.Item, lines []*scan.Line) MapBarcodeResponse {
+	res := MapBarcodeResponse{
+		Item:  MapToInventoryItemResponse(item),
+		Lines: make([]ScanLineResponse, 0, len(lines)),
+	}
+	for _, l := range lines {
+		res.Lines = append(res.Lines, MapToScanLineResponse(l))
+	}
+	return res
+}
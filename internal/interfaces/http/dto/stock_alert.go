@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ResolveAlertRequest closes an alert (POST /inventory/alerts/:id/resolve)
+type ResolveAlertRequest struct {
+	Notes string `json:"notes"`
+}
+
+// CreateAlertSubscriptionRequest sends a restaurant's new stock alerts to an
+// email address or webhook URL (POST /inventory/alert-subscriptions)
+type CreateAlertSubscriptionRequest struct {
+	RestaurantID string `json:"restaurant_id" binding:"required,uuid"`
+	Channel      string `json:"channel" binding:"required,oneof=email webhook"`
+	Target       string `json:"target" binding:"required,max=2048"`
+}
+
+type StockAlertResponse struct {
+	ID              string   `json:"id"`
+	InventoryItemID string   `json:"inventory_item_id"`
+	ItemName        string   `json:"item_name,omitempty"`
+	Type            string   `json:"type"`
+	CurrentStock    *float64 `json:"current_stock"`
+	ThresholdValue  *float64 `json:"threshold_value"`
+	ExpiryDate      *string  `json:"expiry_date"`
+	IsResolved      bool     `json:"is_resolved"`
+	ResolvedAt      *string  `json:"resolved_at"`
+	ResolvedBy      *string  `json:"resolved_by"`
+	AcknowledgedAt  *string  `json:"acknowledged_at"`
+	AcknowledgedBy  *string  `json:"acknowledged_by"`
+	Notes           string   `json:"notes,omitempty"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+type AlertSubscriptionResponse struct {
+	ID           string `json:"id"`
+	RestaurantID string `json:"restaurant_id"`
+	Channel      string `json:"channel"`
+	Target       string `json:"target"`
+	IsActive     bool   `json:"is_active"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func MapToStockAlertResponse(entity *inventory.Alert) StockAlertResponse {
+	res := StockAlertResponse{
+		ID:              entity.ID.String(),
+		InventoryItemID: entity.InventoryItemID.String(),
+		Type:            string(entity.AlertType),
+		CurrentStock:    entity.CurrentStock,
+		ThresholdValue:  entity.ThresholdValue,
+		ExpiryDate:      dateString(entity.ExpiryDate),
+		IsResolved:      entity.IsResolved,
+		ResolvedAt:      timeString(entity.ResolvedAt),
+		ResolvedBy:      uuidString(entity.ResolvedBy),
+		AcknowledgedAt:  timeString(entity.AcknowledgedAt),
+		AcknowledgedBy:  uuidString(entity.AcknowledgedBy),
+		Notes:           entity.Notes,
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+	if entity.Item != nil {
+		res.ItemName = entity.Item.Name
+	}
+	return res
+}
+
+func MapToAlertSubscriptionResponse(entity *inventory.AlertSubscription) AlertSubscriptionResponse {
+	return AlertSubscriptionResponse{
+		ID:           entity.ID.String(),
+		RestaurantID: entity.RestaurantID.String(),
+		Channel:      string(entity.Channel),
+		Target:       entity.Target,
+		IsActive:     entity.IsActive,
+		CreatedAt:    entity.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// timeString formats an optional timestamp, keeping nil as JSON null
+func timeString(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+)
+
+// OpenStocktakeRequest starts a count (POST /inventory/stocktakes). Without
+// a storage location or category every active item is counted.
+type OpenStocktakeRequest struct {
+	RestaurantID    string `json:"restaurant_id" binding:"required,uuid"`
+	StorageLocation string `json:"storage_location" binding:"omitempty,max=255"`
+	CategoryID      string `json:"category_id" binding:"omitempty,uuid"`
+	Notes           string `json:"notes"`
+}
+
+// RecordCountsRequest records what is on the shelf
+// (PUT /inventory/stocktakes/:id/counts). Counting an item again replaces
+// its earlier count.
+type RecordCountsRequest struct {
+	Counts []StockCountRequest `json:"counts" binding:"required,min=1,dive"`
+}
+
+// StockCountRequest is Quantity of an item in Unit, which defaults to the
+// item's unit. Reason defaults to miscounted and is used for the adjustment.
+type StockCountRequest struct {
+	InventoryItemID string   `json:"inventory_item_id" binding:"required,uuid"`
+	Quantity        *float64 `json:"quantity" binding:"required,min=0"`
+	Unit            string   `json:"unit"`
+	Reason          string   `json:"reason" binding:"omitempty,oneof=damaged expired theft miscounted spoiled returned_to_supplier other"`
+	Notes           string   `json:"notes"`
+}
+
+type StocktakeLineResponse struct {
+	ID               string   `json:"id"`
+	InventoryItemID  string   `json:"inventory_item_id"`
+	ItemName         string   `json:"item_name"`
+	Unit             string   `json:"unit,omitempty"`
+	ExpectedQuantity float64  `json:"expected_quantity"`
+	CountedQuantity  *float64 `json:"counted_quantity"`
+	Variance         float64  `json:"variance"`
+	UnitCost         float64  `json:"unit_cost"`
+	ValueImpact      float64  `json:"value_impact"`
+	Reason           string   `json:"reason"`
+	CountedBy        *string  `json:"counted_by"`
+	CountedAt        *string  `json:"counted_at"`
+	AdjustmentID     *string  `json:"adjustment_id,omitempty"`
+	Notes            string   `json:"notes,omitempty"`
+}
+
+type StocktakeResponse struct {
+	ID              string                  `json:"id"`
+	RestaurantID    string                  `json:"restaurant_id"`
+	StorageLocation string                  `json:"storage_location,omitempty"`
+	CategoryID      *string                 `json:"category_id"`
+	Status          string                  `json:"status"`
+	Notes           string                  `json:"notes,omitempty"`
+	OpenedBy        *string                 `json:"opened_by"`
+	SubmittedBy     *string                 `json:"submitted_by"`
+	SubmittedAt     *string                 `json:"submitted_at"`
+	ApprovedBy      *string                 `json:"approved_by"`
+	ApprovedAt      *string                 `json:"approved_at"`
+	ItemCount       int                     `json:"item_count"`
+	CountedCount    int                     `json:"counted_count"`
+	ValueImpact     float64                 `json:"value_impact"`
+	Lines           []StocktakeLineResponse `json:"lines"`
+	CreatedAt       string                  `json:"created_at"`
+}
+
+func MapToStocktakeResponse(entity *stocktake.Stocktake) StocktakeResponse {
+	res := StocktakeResponse{
+		ID:              entity.ID.String(),
+		RestaurantID:    entity.RestaurantID.String(),
+		StorageLocation: entity.StorageLocation,
+		CategoryID:      uuidString(entity.CategoryID),
+		Status:          string(entity.Status),
+		Notes:           entity.Notes,
+		OpenedBy:        uuidString(entity.OpenedBy),
+		SubmittedBy:     uuidString(entity.SubmittedBy),
+		SubmittedAt:     timeString(entity.SubmittedAt),
+		ApprovedBy:      uuidString(entity.ApprovedBy),
+		ApprovedAt:      timeString(entity.ApprovedAt),
+		ItemCount:       len(entity.Lines),
+		CountedCount:    len(entity.CountedLines()),
+		ValueImpact:     entity.ValueImpact(),
+		Lines:           make([]StocktakeLineResponse, 0, len(entity.Lines)),
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+	for _, l := range entity.Lines {
+		line := StocktakeLineResponse{
+			ID:               l.ID.String(),
+			InventoryItemID:  l.InventoryItemID.String(),
+			ExpectedQuantity: l.ExpectedQuantity,
+			CountedQuantity:  l.CountedQuantity,
+			Variance:         l.Variance(),
+			UnitCost:         l.UnitCost,
+			ValueImpact:      l.ValueImpact(),
+			Reason:           string(l.Reason),
+			CountedBy:        uuidString(l.CountedBy),
+			CountedAt:        timeString(l.CountedAt),
+			AdjustmentID:     uuidString(l.AdjustmentID),
+			Notes:            l.Notes,
+		}
+		if l.Item != nil {
+			line.ItemName = l.Item.Name
+			if l.Item.Unit != nil {
+				line.Unit = l.Item.Unit.Abbreviation
+			}
+		}
+		res.Lines = append(res.Lines, line)
+	}
+	return res
+}
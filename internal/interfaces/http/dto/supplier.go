@@ -0,0 +1,257 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// CreateSupplierRequest is what the client sends (POST /suppliers).
+// DeliveryDays is free text such as "Mon, Wed, Fri" or "weekdays".
+type CreateSupplierRequest struct {
+	Name               string   `json:"name" binding:"required,max=255"`
+	ContactPerson      string   `json:"contact_person" binding:"omitempty,max=255"`
+	Email              string   `json:"email" binding:"omitempty,email,max=255"`
+	Phone              string   `json:"phone" binding:"omitempty,max=20"`
+	Address            string   `json:"address"`
+	City               string   `json:"city" binding:"omitempty,max=100"`
+	State              string   `json:"state" binding:"omitempty,max=100"`
+	PostalCode         string   `json:"postal_code" binding:"omitempty,max=20"`
+	Country            string   `json:"country" binding:"omitempty,max=100"`
+	PaymentTerms       string   `json:"payment_terms" binding:"omitempty,max=255"`
+	DeliveryDays       string   `json:"delivery_days" binding:"omitempty,max=100"`
+	MinimumOrderAmount *float64 `json:"minimum_order_amount" binding:"omitempty,min=0"`
+	Rating             float64  `json:"rating" binding:"min=0,max=5"`
+	Notes              string   `json:"notes"`
+}
+
+// UpdateSupplierRequest is what the client sends (PUT /suppliers/:id).
+// Nil fields are left unchanged; a MinimumOrderAmount of 0 removes it.
+type UpdateSupplierRequest struct {
+	Name               *string  `json:"name" binding:"omitempty,min=1,max=255"`
+	ContactPerson      *string  `json:"contact_person" binding:"omitempty,max=255"`
+	Email              *string  `json:"email" binding:"omitempty,email,max=255"`
+	Phone              *string  `json:"phone" binding:"omitempty,max=20"`
+	Address            *string  `json:"address"`
+	City               *string  `json:"city" binding:"omitempty,max=100"`
+	State              *string  `json:"state" binding:"omitempty,max=100"`
+	PostalCode         *string  `json:"postal_code" binding:"omitempty,max=20"`
+	Country            *string  `json:"country" binding:"omitempty,max=100"`
+	PaymentTerms       *string  `json:"payment_terms" binding:"omitempty,max=255"`
+	DeliveryDays       *string  `json:"delivery_days" binding:"omitempty,max=100"`
+	MinimumOrderAmount *float64 `json:"minimum_order_amount" binding:"omitempty,min=0"`
+	Rating             *float64 `json:"rating" binding:"omitempty,min=0,max=5"`
+	IsActive           *bool    `json:"is_active"`
+	Notes              *string  `json:"notes"`
+}
+
+// SetSupplierPricesRequest adds to or changes a supplier's price list
+// (PUT /suppliers/:id/prices). Items left out keep their price.
+type SetSupplierPricesRequest struct {
+	Prices []SupplierPriceRequest `json:"prices" binding:"required,min=1,dive"`
+}
+
+// SupplierPriceRequest prices an inventory item, in its unit.
+type SupplierPriceRequest struct {
+	InventoryItemID string   `json:"inventory_item_id" binding:"required,uuid"`
+	UnitPrice       float64  `json:"unit_price" binding:"min=0"`
+	SupplierSKU     *string  `json:"supplier_sku" binding:"omitempty,max=100"`
+	MinimumQuantity *float64 `json:"minimum_quantity" binding:"omitempty,min=0"`
+}
+
+// RecommendSupplierRequest asks which supplier a basket is cheapest from
+// (POST /suppliers/recommendations). Quantities are in each item's unit.
+type RecommendSupplierRequest struct {
+	Items []BasketItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+type BasketItemRequest struct {
+	InventoryItemID string  `json:"inventory_item_id" binding:"required,uuid"`
+	Quantity        float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+type SupplierResponse struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	ContactPerson      string   `json:"contact_person,omitempty"`
+	Email              string   `json:"email,omitempty"`
+	Phone              string   `json:"phone,omitempty"`
+	Address            string   `json:"address,omitempty"`
+	City               string   `json:"city,omitempty"`
+	State              string   `json:"state,omitempty"`
+	PostalCode         string   `json:"postal_code,omitempty"`
+	Country            string   `json:"country,omitempty"`
+	PaymentTerms       string   `json:"payment_terms,omitempty"`
+	DeliveryDays       string   `json:"delivery_days,omitempty"`
+	MinimumOrderAmount *float64 `json:"minimum_order_amount"`
+	IsActive           bool     `json:"is_active"`
+	Rating             float64  `json:"rating"`
+	Notes              string   `json:"notes,omitempty"`
+	CreatedAt          string   `json:"created_at"`
+	UpdatedAt          string   `json:"updated_at"`
+}
+
+type SupplierPriceResponse struct {
+	ID              string   `json:"id"`
+	SupplierID      string   `json:"supplier_id"`
+	SupplierName    string   `json:"supplier_name,omitempty"`
+	InventoryItemID string   `json:"inventory_item_id"`
+	ItemName        string   `json:"item_name,omitempty"`
+	Unit            string   `json:"unit,omitempty"`
+	SupplierSKU     string   `json:"supplier_sku,omitempty"`
+	UnitPrice       float64  `json:"unit_price"`
+	MinimumQuantity float64  `json:"minimum_quantity"`
+	LastPaidPrice   *float64 `json:"last_paid_price"`
+	LastPaidAt      *string  `json:"last_paid_at"`
+	UpdatedAt       string   `json:"updated_at"`
+}
+
+type SupplierPriceChangeResponse struct {
+	ID              string  `json:"id"`
+	SupplierID      string  `json:"supplier_id"`
+	SupplierName    string  `json:"supplier_name,omitempty"`
+	InventoryItemID string  `json:"inventory_item_id"`
+	UnitPrice       float64 `json:"unit_price"`
+	Source          string  `json:"source"`
+	ReferenceID     *string `json:"reference_id"`
+	RecordedBy      *string `json:"recorded_by"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+type SupplierQuoteLineResponse struct {
+	InventoryItemID string  `json:"inventory_item_id"`
+	Quantity        float64 `json:"quantity"`
+	UnitPrice       float64 `json:"unit_price"`
+	Total           float64 `json:"total"`
+	BelowMinimum    bool    `json:"below_minimum"`
+}
+
+type SupplierQuoteResponse struct {
+	SupplierID         string                      `json:"supplier_id"`
+	SupplierName       string                      `json:"supplier_name"`
+	MinimumOrderAmount *float64                    `json:"minimum_order_amount"`
+	Total              float64                     `json:"total"`
+	Complete           bool                        `json:"complete"`
+	BelowMinimum       bool                        `json:"below_minimum"`
+	Missing            []string                    `json:"missing_items"`
+	Lines              []SupplierQuoteLineResponse `json:"lines"`
+}
+
+// SupplierRecommendationResponse ranks the suppliers for a basket.
+// Cheapest is nil when no single supplier can fill it within its minimums.
+// Split buys each item from its cheapest supplier, for comparison.
+type SupplierRecommendationResponse struct {
+	Cheapest   *SupplierQuoteResponse  `json:"cheapest"`
+	Quotes     []SupplierQuoteResponse `json:"quotes"`
+	Split      []SupplierQuoteResponse `json:"split"`
+	SplitTotal float64                 `json:"split_total"`
+}
+
+func MapToSupplierResponse(entity *supplier.Supplier) SupplierResponse {
+	return SupplierResponse{
+		ID:                 entity.ID.String(),
+		Name:               entity.Name,
+		ContactPerson:      entity.ContactPerson,
+		Email:              entity.Email,
+		Phone:              entity.Phone,
+		Address:            entity.Address,
+		City:               entity.City,
+		State:              entity.State,
+		PostalCode:         entity.PostalCode,
+		Country:            entity.Country,
+		PaymentTerms:       entity.PaymentTerms,
+		DeliveryDays:       entity.DeliveryDays,
+		MinimumOrderAmount: entity.MinimumOrderAmount,
+		IsActive:           entity.IsActive,
+		Rating:             entity.Rating,
+		Notes:              entity.Notes,
+		CreatedAt:          entity.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:          entity.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func MapToSupplierPriceResponse(entity *supplier.Price) SupplierPriceResponse {
+	res := SupplierPriceResponse{
+		ID:              entity.ID.String(),
+		SupplierID:      entity.SupplierID.String(),
+		InventoryItemID: entity.InventoryItemID.String(),
+		SupplierSKU:     entity.SupplierSKU,
+		UnitPrice:       entity.UnitPrice,
+		MinimumQuantity: entity.MinimumQuantity,
+		LastPaidPrice:   entity.LastPaidPrice,
+		LastPaidAt:      timeString(entity.LastPaidAt),
+		UpdatedAt:       entity.UpdatedAt.Format(time.RFC3339),
+	}
+	if entity.Supplier != nil {
+		res.SupplierName = entity.Supplier.Name
+	}
+	if entity.Item != nil {
+		res.ItemName = entity.Item.Name
+		if entity.Item.Unit != nil {
+			res.Unit = entity.Item.Unit.Abbreviation
+		}
+	}
+	return res
+}
+
+func MapToSupplierPriceChangeResponse(entity *supplier.PriceChange) SupplierPriceChangeResponse {
+	res := SupplierPriceChangeResponse{
+		ID:              entity.ID.String(),
+		SupplierID:      entity.SupplierID.String(),
+		InventoryItemID: entity.InventoryItemID.String(),
+		UnitPrice:       entity.UnitPrice,
+		Source:          entity.Source,
+		ReferenceID:     uuidString(entity.ReferenceID),
+		RecordedBy:      uuidString(entity.RecordedBy),
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+	if entity.Supplier != nil {
+		res.SupplierName = entity.Supplier.Name
+	}
+	return res
+}
+
+func MapToSupplierRecommendationResponse(rec *supplier.Recommendation) SupplierRecommendationResponse {
+	res := SupplierRecommendationResponse{
+		Quotes: make([]SupplierQuoteResponse, 0, len(rec.Quotes)),
+		Split:  make([]SupplierQuoteResponse, 0, len(rec.Split)),
+	}
+	if rec.Cheapest != nil {
+		q := mapToSupplierQuoteResponse(rec.Cheapest)
+		res.Cheapest = &q
+	}
+	for _, q := range rec.Quotes {
+		res.Quotes = append(res.Quotes, mapToSupplierQuoteResponse(q))
+	}
+	for _, q := range rec.Split {
+		res.Split = append(res.Split, mapToSupplierQuoteResponse(q))
+	}
+	res.SplitTotal = rec.SplitTotal()
+	return res
+}
+
+func mapToSupplierQuoteResponse(q *supplier.Quote) SupplierQuoteResponse {
+	res := SupplierQuoteResponse{
+		SupplierID:         q.Supplier.ID.String(),
+		SupplierName:       q.Supplier.Name,
+		MinimumOrderAmount: q.Supplier.MinimumOrderAmount,
+		Total:              q.Total,
+		Complete:           q.Complete(),
+		BelowMinimum:       q.BelowMinimum,
+		Missing:            make([]string, 0, len(q.Missing)),
+		Lines:              make([]SupplierQuoteLineResponse, 0, len(q.Lines)),
+	}
+	for _, id := range q.Missing {
+		res.Missing = append(res.Missing, id.String())
+	}
+	for _, l := range q.Lines {
+		res.Lines = append(res.Lines, SupplierQuoteLineResponse{
+			InventoryItemID: l.InventoryItemID.String(),
+			Quantity:        l.Quantity,
+			UnitPrice:       l.UnitPrice,
+			Total:           l.Total,
+			BelowMinimum:    l.BelowMinimum,
+		})
+	}
+	return res
+}
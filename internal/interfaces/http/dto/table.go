@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/table"
+)
+
+// CreateTableRequest adds a dine-in table (POST /restaurants/:id/tables)
+type CreateTableRequest struct {
+	Number   string `json:"number" binding:"required,max=20"`
+	Capacity int    `json:"capacity" binding:"required,min=1,max=100"`
+}
+
+// UpdateTableRequest changes only the fields that are set (PATCH /tables/:id)
+type UpdateTableRequest struct {
+	Number   *string `json:"number" binding:"omitempty,min=1,max=20"`
+	Capacity *int    `json:"capacity" binding:"omitempty,min=1,max=100"`
+	IsActive *bool   `json:"is_active"`
+}
+
+// ScanTableRequest is sent when a guest scans a table's QR code
+// (POST /tables/scan). Guests is the size of the scanning guest's party.
+type ScanTableRequest struct {
+	Token  string `json:"token" binding:"required,max=128"`
+	Guests int    `json:"guests" binding:"omitempty,min=1,max=100"`
+}
+
+// TableOrderRequest adds an order to the tab of the guest's table
+// (POST /tab/orders)
+type TableOrderRequest struct {
+	Items               []CheckoutItemRequest `json:"items" binding:"required,min=1,dive"`
+	SpecialInstructions string                `json:"special_instructions" binding:"max=1000"`
+}
+
+// MoveTabRequest moves a tab to another table (POST /tabs/:id/move)
+type MoveTabRequest struct {
+	TableID string `json:"table_id" binding:"required,uuid"`
+}
+
+// CloseTabRequest settles a tab (POST /tabs/:id/close). PaymentMethod is
+// how the amount due was paid and may be left out when nothing is due.
+type CloseTabRequest struct {
+	PaymentMethod string `json:"payment_method" binding:"omitempty,oneof=credit_card debit_card cash online_payment"`
+}
+
+type TableResponse struct {
+	ID           string `json:"id"`
+	RestaurantID string `json:"restaurant_id"`
+	Number       string `json:"number"`
+	Capacity     int    `json:"capacity"`
+	IsActive     bool   `json:"is_active"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// TableQRResponse is what to print on a table's QR code. URL is the order
+// page with the token appended; it is empty when no order URL is set.
+type TableQRResponse struct {
+	TableID string `json:"table_id"`
+	Number  string `json:"number"`
+	Token   string `json:"token"`
+	URL     string `json:"url,omitempty"`
+}
+
+type TableSessionResponse struct {
+	ID            string  `json:"id"`
+	RestaurantID  string  `json:"restaurant_id"`
+	TableID       string  `json:"table_id"`
+	TableNumber   string  `json:"table_number"`
+	Status        string  `json:"status"`
+	GuestCount    int     `json:"guest_count"`
+	PaymentMethod string  `json:"payment_method,omitempty"`
+	ClosedAt      *string `json:"closed_at"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// TabResponse is a table session with its orders. Cancelled orders are
+// listed but not counted in the totals.
+type TabResponse struct {
+	TableSessionResponse
+	Orders []OrderResponse `json:"orders"`
+	Total  float64         `json:"total"`
+	Paid   float64         `json:"paid"`
+	Due    float64         `json:"due"`
+}
+
+// ScanTableResponse carries the session token guests send in the
+// X-Table-Session header to view and order on the tab
+type ScanTableResponse struct {
+	SessionToken string      `json:"session_token"`
+	Tab          TabResponse `json:"tab"`
+}
+
+func MapToTableResponse(entity *table.Table) TableResponse {
+	return TableResponse{
+		ID:           entity.ID.String(),
+		RestaurantID: entity.RestaurantID.String(),
+		Number:       entity.Number,
+		Capacity:     entity.Capacity,
+		IsActive:     entity.IsActive,
+		CreatedAt:    entity.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func MapToTableSessionResponse(entity *table.Session) TableSessionResponse {
+	res := TableSessionResponse{
+		ID:           entity.ID.String(),
+		RestaurantID: entity.RestaurantID.String(),
+		TableID:      entity.TableID.String(),
+		Status:       string(entity.Status),
+		GuestCount:   entity.GuestCount,
+		CreatedAt:    entity.CreatedAt.Format(time.RFC3339),
+	}
+	if entity.Table != nil {
+		res.TableNumber = entity.Table.Number
+	}
+	if entity.PaymentMethod != nil {
+		res.PaymentMethod = string(*entity.PaymentMethod)
+	}
+	if entity.ClosedAt != nil {
+		s := entity.ClosedAt.Format(time.RFC3339)
+		res.ClosedAt = &s
+	}
+	return res
+}
+
+func MapToTabResponse(tab *table.Tab) TabResponse {
+	res := TabResponse{
+		TableSessionResponse: MapToTableSessionResponse(tab.Session),
+		Orders:               make([]OrderResponse, 0, len(tab.Orders)),
+		Total:                tab.Total,
+		Paid:                 tab.Paid,
+		Due:                  tab.Due,
+	}
+	for _, o := range tab.Orders {
+		res.Orders = append(res.Orders, MapToOrderResponse(o))
+	}
+	return res
+}
@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+)
+
+// RequestTransferRequest asks for stock to be moved between restaurants
+// (POST /inventory/transfers). Lines name source items, which the
+// destination must stock under the same SKU.
+type RequestTransferRequest struct {
+	SourceRestaurantID      string                `json:"source_restaurant_id" binding:"required,uuid"`
+	DestinationRestaurantID string                `json:"destination_restaurant_id" binding:"required,uuid"`
+	Notes                   string                `json:"notes"`
+	Lines                   []TransferLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// TransferLineRequest requests Quantity of a source item, in its unit.
+type TransferLineRequest struct {
+	InventoryItemID string  `json:"inventory_item_id" binding:"required,uuid"`
+	Quantity        float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+// ShipTransferRequest sends the transfer (POST /inventory/transfers/:id/ship).
+// Lines left out ship in full.
+type ShipTransferRequest struct {
+	Lines []ShipmentLineRequest `json:"lines" binding:"dive"`
+}
+
+type ShipmentLineRequest struct {
+	LineID   string   `json:"line_id" binding:"required,uuid"`
+	Quantity *float64 `json:"quantity" binding:"required,min=0"`
+}
+
+// ReceiveTransferRequest books what arrived
+// (POST /inventory/transfers/:id/receive). Lines left out arrived in full;
+// a shortfall is recorded as a discrepancy with Reason.
+type ReceiveTransferRequest struct {
+	Lines []TransferReceiptLineRequest `json:"lines" binding:"dive"`
+}
+
+type TransferReceiptLineRequest struct {
+	LineID   string   `json:"line_id" binding:"required,uuid"`
+	Quantity *float64 `json:"quantity" binding:"omitempty,min=0"`
+	Reason   string   `json:"reason" binding:"omitempty,oneof=damaged expired theft miscounted spoiled other"`
+	Notes    string   `json:"notes"`
+}
+
+type TransferLineResponse struct {
+	ID                string   `json:"id"`
+	SourceItemID      string   `json:"source_item_id"`
+	DestinationItemID *string  `json:"destination_item_id"`
+	SKU               string   `json:"sku"`
+	ItemName          string   `json:"item_name,omitempty"`
+	Unit              string   `json:"unit,omitempty"`
+	QuantityRequested float64  `json:"quantity_requested"`
+	QuantityShipped   *float64 `json:"quantity_shipped"`
+	QuantityReceived  *float64 `json:"quantity_received"`
+	UnitCost          *float64 `json:"unit_cost"`
+	ExpiryDate        *string  `json:"expiry_date"`
+	ValueShipped      float64  `json:"value_shipped"`
+	Discrepancy       float64  `json:"discrepancy"`
+	DiscrepancyValue  float64  `json:"discrepancy_value"`
+	DiscrepancyReason *string  `json:"discrepancy_reason"`
+	Notes             string   `json:"notes,omitempty"`
+}
+
+type TransferResponse struct {
+	ID                      string                 `json:"id"`
+	TransferNumber          string                 `json:"transfer_number"`
+	SourceRestaurantID      string                 `json:"source_restaurant_id"`
+	DestinationRestaurantID string                 `json:"destination_restaurant_id"`
+	Status                  string                 `json:"status"`
+	Notes                   string                 `json:"notes,omitempty"`
+	ValueShipped            float64                `json:"value_shipped"`
+	ValueInTransit          float64                `json:"value_in_transit"`
+	DiscrepancyValue        float64                `json:"discrepancy_value"`
+	RequestedBy             *string                `json:"requested_by"`
+	ShippedBy               *string                `json:"shipped_by"`
+	ShippedAt               *string                `json:"shipped_at"`
+	ReceivedBy              *string                `json:"received_by"`
+	ReceivedAt              *string                `json:"received_at"`
+	CreatedAt               string                 `json:"created_at"`
+	Lines                   []TransferLineResponse `json:"lines"`
+}
+
+// TransferMovementResponse is a transfer with the ledger entries it just wrote
+type TransferMovementResponse struct {
+	Transfer     TransferResponse               `json:"transfer"`
+	Transactions []InventoryTransactionResponse `json:"transactions"`
+}
+
+func MapToTransferResponse(entity *transfer.Transfer) TransferResponse {
+	res := TransferResponse{
+		ID:                      entity.ID.String(),
+		TransferNumber:          entity.TransferNumber,
+		SourceRestaurantID:      entity.SourceRestaurantID.String(),
+		DestinationRestaurantID: entity.DestinationRestaurantID.String(),
+		Status:                  string(entity.Status),
+		Notes:                   entity.Notes,
+		ValueShipped:            entity.ValueShipped(),
+		ValueInTransit:          entity.ValueInTransit(),
+		DiscrepancyValue:        entity.DiscrepancyValue(),
+		RequestedBy:             uuidString(entity.RequestedBy),
+		ShippedBy:               uuidString(entity.ShippedBy),
+		ShippedAt:               timeString(entity.ShippedAt),
+		ReceivedBy:              uuidString(entity.ReceivedBy),
+		ReceivedAt:              timeString(entity.ReceivedAt),
+		CreatedAt:               entity.CreatedAt.Format(time.RFC3339),
+		Lines:                   make([]TransferLineResponse, 0, len(entity.Lines)),
+	}
+	for _, l := range entity.Lines {
+		line := TransferLineResponse{
+			ID:                l.ID.String(),
+			SourceItemID:      l.SourceItemID.String(),
+			DestinationItemID: uuidString(l.DestinationItemID),
+			SKU:               l.SKU,
+			QuantityRequested: l.QuantityRequested,
+			QuantityShipped:   l.QuantityShipped,
+			QuantityReceived:  l.QuantityReceived,
+			UnitCost:          l.UnitCost,
+			ExpiryDate:        dateString(l.ExpiryDate),
+			ValueShipped:      l.ValueShipped(),
+			Discrepancy:       l.Discrepancy(),
+			DiscrepancyValue:  l.DiscrepancyValue(),
+			Notes:             l.Notes,
+		}
+		if l.SourceItem != nil {
+			line.ItemName = l.SourceItem.Name
+			if l.SourceItem.Unit != nil {
+				line.Unit = l.SourceItem.Unit.Abbreviation
+			}
+		}
+		if l.DiscrepancyReason != nil {
+			reason := string(*l.DiscrepancyReason)
+			line.DiscrepancyReason = &reason
+		}
+		res.Lines = append(res.Lines, line)
+	}
+	return res
+}
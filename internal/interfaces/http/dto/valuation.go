@@ -0,0 +1,136 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// SetValuationMethodRequest chooses how a restaurant values its inventory
+// (PUT /restaurants/:id/valuation-method).
+type SetValuationMethodRequest struct {
+	Method string `json:"method" binding:"required,oneof=fifo weighted_average"`
+}
+
+type ValuationMethodResponse struct {
+	RestaurantID string `json:"restaurant_id"`
+	Method       string `json:"method"`
+}
+
+// MovementResponse is a quantity in the item's unit, its value under the
+// valuation method and the cost the ledger booked for it.
+type MovementResponse struct {
+	Quantity float64 `json:"quantity"`
+	Value    float64 `json:"value"`
+	Booked   float64 `json:"booked"`
+}
+
+// MovementValueResponse is a MovementResponse summed across items
+type MovementValueResponse struct {
+	Value  float64 `json:"value"`
+	Booked float64 `json:"booked"`
+}
+
+// ItemValuationResponse rolls an item forward: closing is opening plus
+// purchases and transfers in, less usage, waste, transfers out and returns,
+// plus the signed adjustments, unledgered changes and revaluation.
+type ItemValuationResponse struct {
+	InventoryItemID string           `json:"inventory_item_id"`
+	ItemName        string           `json:"item_name"`
+	Unit            string           `json:"unit,omitempty"`
+	Opening         MovementResponse `json:"opening"`
+	Purchases       MovementResponse `json:"purchases"`
+	TransfersIn     MovementResponse `json:"transfers_in"`
+	Usage           MovementResponse `json:"usage"`
+	Waste           MovementResponse `json:"waste"`
+	TransfersOut    MovementResponse `json:"transfers_out"`
+	Returns         MovementResponse `json:"returns"`
+	Adjustments     MovementResponse `json:"adjustments"`
+	Unledgered      MovementResponse `json:"unledgered"`
+	Revaluation     float64          `json:"revaluation"`
+	Closing         MovementResponse `json:"closing"`
+	UnitCost        float64          `json:"unit_cost"`
+	CostOfGoodsUsed float64          `json:"cost_of_goods_used"`
+}
+
+type ValuationTotalsResponse struct {
+	Opening         MovementValueResponse `json:"opening"`
+	Purchases       MovementValueResponse `json:"purchases"`
+	TransfersIn     MovementValueResponse `json:"transfers_in"`
+	Usage           MovementValueResponse `json:"usage"`
+	Waste           MovementValueResponse `json:"waste"`
+	TransfersOut    MovementValueResponse `json:"transfers_out"`
+	Returns         MovementValueResponse `json:"returns"`
+	Adjustments     MovementValueResponse `json:"adjustments"`
+	Unledgered      MovementValueResponse `json:"unledgered"`
+	Revaluation     float64               `json:"revaluation"`
+	Closing         MovementValueResponse `json:"closing"`
+	CostOfGoodsUsed float64               `json:"cost_of_goods_used"`
+}
+
+// ValuationResponse is a period-close report; To is exclusive.
+type ValuationResponse struct {
+	RestaurantID string                  `json:"restaurant_id"`
+	Method       string                  `json:"method"`
+	From         string                  `json:"from"`
+	To           string                  `json:"to"`
+	Totals       ValuationTotalsResponse `json:"totals"`
+	Items        []ItemValuationResponse `json:"items"`
+}
+
+func MapToValuationResponse(report *inventory.Valuation) ValuationResponse {
+	t := &report.Totals
+	res := ValuationResponse{
+		RestaurantID: report.RestaurantID.String(),
+		Method:       string(report.Method),
+		From:         report.From.Format(time.RFC3339),
+		To:           report.To.Format(time.RFC3339),
+		Totals: ValuationTotalsResponse{
+			Opening:         movementValue(t.Opening),
+			Purchases:       movementValue(t.Purchases),
+			TransfersIn:     movementValue(t.TransfersIn),
+			Usage:           movementValue(t.Usage),
+			Waste:           movementValue(t.Waste),
+			TransfersOut:    movementValue(t.TransfersOut),
+			Returns:         movementValue(t.Returns),
+			Adjustments:     movementValue(t.Adjustments),
+			Unledgered:      movementValue(t.Unledgered),
+			Revaluation:     t.Revaluation,
+			Closing:         movementValue(t.Closing),
+			CostOfGoodsUsed: t.CostOfGoodsUsed(),
+		},
+		Items: make([]ItemValuationResponse, 0, len(report.Items)),
+	}
+	for _, v := range report.Items {
+		item := ItemValuationResponse{
+			InventoryItemID: v.Item.ID.String(),
+			ItemName:        v.Item.Name,
+			Opening:         movement(v.Opening),
+			Purchases:       movement(v.Purchases),
+			TransfersIn:     movement(v.TransfersIn),
+			Usage:           movement(v.Usage),
+			Waste:           movement(v.Waste),
+			TransfersOut:    movement(v.TransfersOut),
+			Returns:         movement(v.Returns),
+			Adjustments:     movement(v.Adjustments),
+			Unledgered:      movement(v.Unledgered),
+			Revaluation:     v.Revaluation,
+			Closing:         movement(v.Closing),
+			UnitCost:        v.UnitCost,
+			CostOfGoodsUsed: v.CostOfGoodsUsed(),
+		}
+		if v.Item.Unit != nil {
+			item.Unit = v.Item.Unit.Abbreviation
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res
+}
+
+func movement(m inventory.Movement) MovementResponse {
+	return MovementResponse{Quantity: m.Quantity, Value: m.Value, Booked: m.Booked}
+}
+
+func movementValue(m inventory.Movement) MovementValueResponse {
+	return MovementValueResponse{Value: m.Value, Booked: m.Booked}
+}
@@ -0,0 +1,158 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+)
+
+// TopUpWalletRequest adds money charged to a card to the wallet
+// (POST /wallet/top-ups)
+type TopUpWalletRequest struct {
+	Amount       float64 `json:"amount" binding:"required,gt=0,max=1000"`
+	PaymentToken string  `json:"payment_token" binding:"required"`
+}
+
+// PurchaseGiftCardRequest buys a gift card (POST /gift-cards). It is paid
+// by card unless pay_with is wallet. When recipient_email is set the code
+// is emailed there.
+type PurchaseGiftCardRequest struct {
+	Amount         float64 `json:"amount" binding:"required,min=5,max=500"`
+	PayWith        string  `json:"pay_with" binding:"omitempty,oneof=card wallet"`
+	PaymentToken   string  `json:"payment_token" binding:"required_unless=PayWith wallet"`
+	RecipientName  string  `json:"recipient_name" binding:"max=255"`
+	RecipientEmail string  `json:"recipient_email" binding:"omitempty,email,max=255"`
+	Message        string  `json:"message" binding:"max=1000"`
+}
+
+// RedeemGiftCardRequest adds a gift card to the wallet
+// (POST /gift-cards/redeem)
+type RedeemGiftCardRequest struct {
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+type WalletResponse struct {
+	Balance   float64 `json:"balance"`
+	UpdatedAt string  `json:"updated_at,omitempty"`
+}
+
+// WalletPostingResponse is one line of the wallet statement. Amount is
+// negative when money left the wallet.
+type WalletPostingResponse struct {
+	ID                string  `json:"id"`
+	TransactionID     string  `json:"transaction_id"`
+	Type              string  `json:"type"`
+	Amount            float64 `json:"amount"`
+	BalanceAfter      float64 `json:"balance_after"`
+	OrderID           *string `json:"order_id"`
+	GiftCardID        *string `json:"gift_card_id"`
+	ProviderReference string  `json:"provider_reference,omitempty"`
+	Description       string  `json:"description,omitempty"`
+	CreatedAt         string  `json:"created_at"`
+}
+
+// TopUpResponse is the top-up and the wallet balance after it
+type TopUpResponse struct {
+	ID                string  `json:"id"`
+	Type              string  `json:"type"`
+	Amount            float64 `json:"amount"`
+	ProviderReference string  `json:"provider_reference,omitempty"`
+	Balance           float64 `json:"balance"`
+	CreatedAt         string  `json:"created_at"`
+}
+
+// GiftCardResponse never carries the code, except once in the purchase
+// response.
+type GiftCardResponse struct {
+	ID             string  `json:"id"`
+	Code           string  `json:"code,omitempty"`
+	CodeLast4      string  `json:"code_last4"`
+	Amount         float64 `json:"amount"`
+	Status         string  `json:"status"`
+	RecipientName  string  `json:"recipient_name,omitempty"`
+	RecipientEmail string  `json:"recipient_email,omitempty"`
+	Message        string  `json:"message,omitempty"`
+	SentAt         *string `json:"sent_at"`
+	RedeemedAt     *string `json:"redeemed_at"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+// RedeemGiftCardResponse is the redeemed card's value and the wallet
+// balance it was added to
+type RedeemGiftCardResponse struct {
+	GiftCardID string  `json:"gift_card_id"`
+	Amount     float64 `json:"amount"`
+	Balance    float64 `json:"balance"`
+}
+
+func MapToWalletResponse(entity *wallet.Account) WalletResponse {
+	res := WalletResponse{Balance: entity.Balance}
+	if !entity.UpdatedAt.IsZero() {
+		res.UpdatedAt = entity.UpdatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func MapToWalletPostingResponse(entity *wallet.Posting) WalletPostingResponse {
+	res := WalletPostingResponse{
+		ID:            entity.ID.String(),
+		TransactionID: entity.TransactionID.String(),
+		Amount:        entity.Amount,
+		BalanceAfter:  entity.BalanceAfter,
+		CreatedAt:     entity.CreatedAt.Format(time.RFC3339),
+	}
+	if t := entity.Transaction; t != nil {
+		res.Type = string(t.Type)
+		res.ProviderReference = t.ProviderReference
+		res.Description = t.Description
+		if t.OrderID != nil {
+			id := t.OrderID.String()
+			res.OrderID = &id
+		}
+		if t.GiftCardID != nil {
+			id := t.GiftCardID.String()
+			res.GiftCardID = &id
+		}
+	}
+	return res
+}
+
+func MapToTopUpResponse(entity *wallet.Transaction) TopUpResponse {
+	res := TopUpResponse{
+		ID:                entity.ID.String(),
+		Type:              string(entity.Type),
+		ProviderReference: entity.ProviderReference,
+		CreatedAt:         entity.CreatedAt.Format(time.RFC3339),
+	}
+	// The wallet's is the only posting that adds money
+	for _, p := range entity.Postings {
+		if p.Amount > 0 {
+			res.Amount = p.Amount
+			res.Balance = p.BalanceAfter
+		}
+	}
+	return res
+}
+
+func MapToGiftCardResponse(entity *wallet.GiftCard, code string) GiftCardResponse {
+	res := GiftCardResponse{
+		ID:             entity.ID.String(),
+		Code:           code,
+		CodeLast4:      entity.CodeLast4,
+		Amount:         entity.Amount,
+		Status:         string(entity.Status),
+		RecipientName:  entity.RecipientName,
+		RecipientEmail: entity.RecipientEmail,
+		Message:        entity.Message,
+		CreatedAt:      entity.CreatedAt.Format(time.RFC3339),
+	}
+	if entity.SentAt != nil {
+		s := entity.SentAt.Format(time.RFC3339)
+		res.SentAt = &s
+	}
+	if entity.RedeemedAt != nil {
+		s := entity.RedeemedAt.Format(time.RFC3339)
+		res.RedeemedAt = &s
+	}
+	return res
+}
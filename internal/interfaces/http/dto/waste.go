@@ -0,0 +1,119 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// LogWasteRequest logs waste (POST /inventory/waste): either Quantity of an
+// inventory item in Unit, which defaults to the item's unit, or Quantity
+// portions of a menu item, which expand through its recipe.
+type LogWasteRequest struct {
+	InventoryItemID string  `json:"inventory_item_id" binding:"omitempty,uuid"`
+	MenuItemID      string  `json:"menu_item_id" binding:"omitempty,uuid"`
+	Quantity        float64 `json:"quantity" binding:"required,gt=0"`
+	Unit            string  `json:"unit"`
+	Reason          string  `json:"reason" binding:"required,oneof=damaged expired spoiled other"`
+	Notes           string  `json:"notes"`
+}
+
+type WasteEntryResponse struct {
+	ID              string                         `json:"id"`
+	RestaurantID    string                         `json:"restaurant_id"`
+	InventoryItemID *string                        `json:"inventory_item_id"`
+	ItemName        string                         `json:"item_name,omitempty"`
+	MenuItemID      *string                        `json:"menu_item_id"`
+	Quantity        float64                        `json:"quantity"`
+	Unit            string                         `json:"unit,omitempty"`
+	Reason          string                         `json:"reason"`
+	TotalCost       float64                        `json:"total_cost"`
+	Notes           string                         `json:"notes,omitempty"`
+	LoggedBy        *string                        `json:"logged_by"`
+	CreatedAt       string                         `json:"created_at"`
+	Transactions    []InventoryTransactionResponse `json:"transactions,omitempty"`
+}
+
+type WasteReasonCostResponse struct {
+	Reason string  `json:"reason"`
+	Cost   float64 `json:"cost"`
+}
+
+type WasteDayCostResponse struct {
+	Date string  `json:"date"`
+	Cost float64 `json:"cost"`
+}
+
+type WasteItemCostResponse struct {
+	InventoryItemID string  `json:"inventory_item_id"`
+	ItemName        string  `json:"item_name"`
+	Unit            string  `json:"unit,omitempty"`
+	Quantity        float64 `json:"quantity"`
+	Cost            float64 `json:"cost"`
+}
+
+// WasteReportResponse covers From through To, both inclusive.
+type WasteReportResponse struct {
+	RestaurantID string                    `json:"restaurant_id"`
+	From         string                    `json:"from"`
+	To           string                    `json:"to"`
+	TotalCost    float64                   `json:"total_cost"`
+	ByReason     []WasteReasonCostResponse `json:"by_reason"`
+	ByItem       []WasteItemCostResponse   `json:"by_item"`
+	ByDay        []WasteDayCostResponse    `json:"by_day"`
+}
+
+// MapToWasteEntryResponse includes the ledger entries when given
+func MapToWasteEntryResponse(entity *inventory.WasteEntry, entries []*inventory.Transaction) WasteEntryResponse {
+	res := WasteEntryResponse{
+		ID:              entity.ID.String(),
+		RestaurantID:    entity.RestaurantID.String(),
+		InventoryItemID: uuidString(entity.InventoryItemID),
+		MenuItemID:      uuidString(entity.MenuItemID),
+		Quantity:        entity.Quantity,
+		Reason:          string(entity.Reason),
+		TotalCost:       entity.TotalCost,
+		Notes:           entity.Notes,
+		LoggedBy:        uuidString(entity.LoggedBy),
+		CreatedAt:       entity.CreatedAt.Format(time.RFC3339),
+	}
+	if entity.Item != nil {
+		res.ItemName = entity.Item.Name
+	}
+	if entity.Unit != nil {
+		res.Unit = entity.Unit.Abbreviation
+	}
+	for _, e := range entries {
+		res.Transactions = append(res.Transactions, MapToInventoryTransactionResponse(e))
+	}
+	return res
+}
+
+func MapToWasteReportResponse(restaurantID string, report *inventory.WasteReport) WasteReportResponse {
+	res := WasteReportResponse{
+		RestaurantID: restaurantID,
+		From:         report.From.Format(time.DateOnly),
+		// To is exclusive; report the last day covered
+		To:        report.To.Add(-time.Nanosecond).Format(time.DateOnly),
+		TotalCost: report.Total,
+		ByReason:  make([]WasteReasonCostResponse, 0, len(report.ByReason)),
+		ByItem:    make([]WasteItemCostResponse, 0, len(report.ByItem)),
+		ByDay:     make([]WasteDayCostResponse, 0, len(report.ByDay)),
+	}
+	for _, r := range report.ByReason {
+		res.ByReason = append(res.ByReason, WasteReasonCostResponse{Reason: string(r.Reason), Cost: r.Cost})
+	}
+	for _, it := range report.ByItem {
+		res.ByItem = append(res.ByItem, WasteItemCostResponse{
+			InventoryItemID: it.InventoryItemID.String(),
+			ItemName:        it.ItemName,
+			Unit:            it.Unit,
+			Quantity:        it.Quantity,
+			Cost:            it.Cost,
+		})
+	}
+	for _, d := range report.ByDay {
+		res.ByDay = append(res.ByDay, WasteDayCostResponse{Date: d.Day.Format(time.DateOnly), Cost: d.Cost})
+	}
+	return res
+}
@@ -0,0 +1,148 @@
+// Package handlers contains HTTP handlers for the delivery address book.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/address"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	addressUC "github.com/james-wukong/orders-api/internal/usecase/address"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AddressHandler struct {
+	auth            gin.HandlerFunc
+	createAddressUC *addressUC.CreateAddressUseCase
+	getAddressUC    *addressUC.GetAddressUseCase
+	listAddressesUC *addressUC.ListAddressesUseCase
+	updateAddressUC *addressUC.UpdateAddressUseCase
+	deleteAddressUC *addressUC.DeleteAddressUseCase
+}
+
+func NewAddressHandler(
+	auth gin.HandlerFunc,
+	c *addressUC.CreateAddressUseCase,
+	g *addressUC.GetAddressUseCase,
+	l *addressUC.ListAddressesUseCase,
+	u *addressUC.UpdateAddressUseCase,
+	d *addressUC.DeleteAddressUseCase,
+) *AddressHandler {
+	return &AddressHandler{
+		auth:            auth,
+		createAddressUC: c,
+		getAddressUC:    g,
+		listAddressesUC: l,
+		updateAddressUC: u,
+		deleteAddressUC: d,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *AddressHandler) Register(v1 *gin.RouterGroup) {
+	addressGroup := v1.Group("/me/addresses", h.auth)
+	{
+		addressGroup.GET("", h.List)
+		addressGroup.POST("", h.Create)
+		addressGroup.GET("/:id", h.Get)
+		addressGroup.PUT("/:id", h.Update)
+		addressGroup.DELETE("/:id", h.Delete)
+	}
+}
+
+func (h *AddressHandler) Create(c *gin.Context) {
+	var req dto.CreateAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	a, err := h.createAddressUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(addressErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToAddressResponse(a))
+}
+
+func (h *AddressHandler) List(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	addresses, err := h.listAddressesUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(addressErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.AddressResponse, 0, len(addresses))
+	for _, a := range addresses {
+		res = append(res, dto.MapToAddressResponse(a))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AddressHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid address id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	a, err := h.getAddressUC.Execute(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(addressErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToAddressResponse(a))
+}
+
+func (h *AddressHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid address id"})
+		return
+	}
+	var req dto.UpdateAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	a, err := h.updateAddressUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(addressErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToAddressResponse(a))
+}
+
+func (h *AddressHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid address id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	if err := h.deleteAddressUC.Execute(c.Request.Context(), userID, id); err != nil {
+		c.JSON(addressErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func addressErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, address.ErrAddressNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, address.ErrAddressNotGeocoded):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
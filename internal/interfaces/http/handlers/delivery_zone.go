@@ -0,0 +1,122 @@
+// Package handlers contains HTTP handlers for delivery zone endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	deliveryUC "github.com/james-wukong/orders-api/internal/usecase/delivery"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DeliveryZoneHandler struct {
+	auth         gin.HandlerFunc
+	createZoneUC *deliveryUC.CreateZoneUseCase
+	listZonesUC  *deliveryUC.ListZonesUseCase
+	deleteZoneUC *deliveryUC.DeleteZoneUseCase
+}
+
+func NewDeliveryZoneHandler(
+	auth gin.HandlerFunc,
+	c *deliveryUC.CreateZoneUseCase,
+	l *deliveryUC.ListZonesUseCase,
+	d *deliveryUC.DeleteZoneUseCase,
+) *DeliveryZoneHandler {
+	return &DeliveryZoneHandler{
+		auth:         auth,
+		createZoneUC: c,
+		listZonesUC:  l,
+		deleteZoneUC: d,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *DeliveryZoneHandler) Register(v1 *gin.RouterGroup) {
+	zoneGroup := v1.Group("/restaurants/:id/delivery-zones")
+	{
+		zoneGroup.GET("", h.List)
+		admin := zoneGroup.Group("", h.auth, middleware.RequireRoles(user.RoleAdmin.String()))
+		admin.POST("", h.Create)
+		admin.DELETE("/:zone_id", h.Delete)
+	}
+}
+
+func (h *DeliveryZoneHandler) Create(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	var req dto.CreateDeliveryZoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	zone, err := h.createZoneUC.Execute(c.Request.Context(), restaurantID, req)
+	if err != nil {
+		c.JSON(deliveryZoneErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToDeliveryZoneResponse(zone))
+}
+
+func (h *DeliveryZoneHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+
+	zones, err := h.listZonesUC.Execute(c.Request.Context(), restaurantID)
+	if err != nil {
+		c.JSON(deliveryZoneErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.DeliveryZoneResponse, 0, len(zones))
+	for _, z := range zones {
+		res = append(res, dto.MapToDeliveryZoneResponse(z))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *DeliveryZoneHandler) Delete(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	zoneID, err := uuid.Parse(c.Param("zone_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid zone id"})
+		return
+	}
+
+	if err := h.deleteZoneUC.Execute(c.Request.Context(), restaurantID, zoneID); err != nil {
+		c.JSON(deliveryZoneErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func deliveryZoneErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, delivery.ErrZoneNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, delivery.ErrInvalidGeometry),
+		errors.Is(err, delivery.ErrInvalidRadius),
+		errors.Is(err, delivery.ErrInvalidZoneType),
+		errors.Is(err, delivery.ErrRestaurantNotLocated):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
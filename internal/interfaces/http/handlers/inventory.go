@@ -0,0 +1,338 @@
+// Package handlers contains HTTP handlers for inventory endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+	inventoryUC "github.com/james-wukong/orders-api/internal/usecase/inventory"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InventoryHandler struct {
+	auth               gin.HandlerFunc
+	listUnitsUC        *inventoryUC.ListUnitsUseCase
+	createItemUC       *inventoryUC.CreateItemUseCase
+	getItemUC          *inventoryUC.GetItemUseCase
+	listItemsUC        *inventoryUC.ListItemsUseCase
+	updateItemUC       *inventoryUC.UpdateItemUseCase
+	archiveItemUC      *inventoryUC.ArchiveItemUseCase
+	receiveStockUC     *inventoryUC.ReceiveStockUseCase
+	consumeStockUC     *inventoryUC.ConsumeStockUseCase
+	adjustStockUC      *inventoryUC.AdjustStockUseCase
+	listTransactionsUC *inventoryUC.ListTransactionsUseCase
+	listExpiringUC     *inventoryUC.ListExpiringUseCase
+}
+
+func NewInventoryHandler(
+	auth gin.HandlerFunc,
+	lu *inventoryUC.ListUnitsUseCase,
+	c *inventoryUC.CreateItemUseCase,
+	g *inventoryUC.GetItemUseCase,
+	l *inventoryUC.ListItemsUseCase,
+	u *inventoryUC.UpdateItemUseCase,
+	a *inventoryUC.ArchiveItemUseCase,
+	rs *inventoryUC.ReceiveStockUseCase,
+	cs *inventoryUC.ConsumeStockUseCase,
+	as *inventoryUC.AdjustStockUseCase,
+	lt *inventoryUC.ListTransactionsUseCase,
+	le *inventoryUC.ListExpiringUseCase,
+) *InventoryHandler {
+	return &InventoryHandler{
+		auth:               auth,
+		listUnitsUC:        lu,
+		createItemUC:       c,
+		getItemUC:          g,
+		listItemsUC:        l,
+		updateItemUC:       u,
+		archiveItemUC:      a,
+		receiveStockUC:     rs,
+		consumeStockUC:     cs,
+		adjustStockUC:      as,
+		listTransactionsUC: lt,
+		listExpiringUC:     le,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *InventoryHandler) Register(v1 *gin.RouterGroup) {
+	inventoryGroup := v1.Group("/inventory", h.auth,
+		middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String()),
+	)
+	{
+		inventoryGroup.GET("/units", h.ListUnits)
+		inventoryGroup.GET("/items", h.List)
+		inventoryGroup.POST("/items", h.Create)
+		inventoryGroup.GET("/items/:id", h.Get)
+		inventoryGroup.PUT("/items/:id", h.Update)
+		inventoryGroup.DELETE("/items/:id", h.Archive)
+		inventoryGroup.POST("/items/:id/receive", h.Receive)
+		inventoryGroup.POST("/items/:id/consume", h.Consume)
+		inventoryGroup.POST("/items/:id/adjust", h.Adjust)
+		inventoryGroup.GET("/items/:id/transactions", h.Transactions)
+		inventoryGroup.GET("/expiring", h.Expiring)
+	}
+}
+
+func (h *InventoryHandler) ListUnits(c *gin.Context) {
+	units, err := h.listUnitsUC.Execute(c.Request.Context())
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]*dto.UnitResponse, 0, len(units))
+	for _, u := range units {
+		res = append(res, dto.MapToUnitResponse(u))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *InventoryHandler) Create(c *gin.Context) {
+	var req dto.CreateInventoryItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.createItemUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToInventoryItemResponse(item))
+}
+
+func (h *InventoryHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter := inventory.ItemFilter{
+		Search:          c.Query("q"),
+		StorageLocation: c.Query("storage_location"),
+		IncludeInactive: c.Query("include_inactive") == "true",
+	}
+	if s := c.Query("category_id"); s != "" {
+		categoryID, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id"})
+			return
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	items, err := h.listItemsUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.InventoryItemResponse, 0, len(items))
+	for _, item := range items {
+		res = append(res, dto.MapToInventoryItemResponse(item))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *InventoryHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+
+	item, err := h.getItemUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToInventoryItemResponse(item))
+}
+
+func (h *InventoryHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+	var req dto.UpdateInventoryItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.updateItemUC.Execute(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToInventoryItemResponse(item))
+}
+
+func (h *InventoryHandler) Archive(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+
+	if err := h.archiveItemUC.Execute(c.Request.Context(), id); err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *InventoryHandler) Receive(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+	var req dto.ReceiveStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	item, entry, err := h.receiveStockUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.StockMovementResponse{
+		Item:        dto.MapToInventoryItemResponse(item),
+		Transaction: dto.MapToInventoryTransactionResponse(entry),
+	})
+}
+
+func (h *InventoryHandler) Consume(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+	var req dto.ConsumeStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	item, entries, err := h.consumeStockUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := dto.StockDrawResponse{
+		Item:         dto.MapToInventoryItemResponse(item),
+		Transactions: make([]dto.InventoryTransactionResponse, 0, len(entries)),
+	}
+	for _, e := range entries {
+		res.Transactions = append(res.Transactions, dto.MapToInventoryTransactionResponse(e))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *InventoryHandler) Adjust(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+	var req dto.AdjustStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	item, entry, err := h.adjustStockUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.StockMovementResponse{
+		Item:        dto.MapToInventoryItemResponse(item),
+		Transaction: dto.MapToInventoryTransactionResponse(entry),
+	})
+}
+
+func (h *InventoryHandler) Transactions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	entries, err := h.listTransactionsUC.Execute(c.Request.Context(), id, limit)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.InventoryTransactionResponse, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, dto.MapToInventoryTransactionResponse(e))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// Expiring lists batches that have expired or soon will, with a suggested
+// waste entry for each expired one
+func (h *InventoryHandler) Expiring(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+
+	now := time.Now()
+	batches, err := h.listExpiringUC.Execute(c.Request.Context(), restaurantID, now)
+	if err != nil {
+		c.JSON(inventoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.ExpiringBatchResponse, 0, len(batches))
+	for _, b := range batches {
+		res = append(res, dto.MapToExpiringBatchResponse(b, now))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// inventoryErrorStatus maps inventory domain errors to HTTP status codes
+func inventoryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, inventory.ErrItemNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, inventory.ErrDuplicateSKU),
+		errors.Is(err, inventory.ErrDuplicateBarcode):
+		return http.StatusConflict
+	case errors.Is(err, inventory.ErrUnitNotFound),
+		errors.Is(err, inventory.ErrIncompatibleUnits),
+		errors.Is(err, inventory.ErrItemWithoutUnit),
+		errors.Is(err, inventory.ErrNonPositiveQuantity),
+		errors.Is(err, inventory.ErrNegativeCost),
+		errors.Is(err, inventory.ErrInsufficientStock),
+		errors.Is(err, inventory.ErrInvalidAdjustmentReason),
+		errors.Is(err, inventory.ErrItemInactive),
+		errors.Is(err, gs1.ErrMalformed),
+		errors.Is(err, gs1.ErrUnknownIdentifier),
+		errors.Is(err, gs1.ErrInvalidCheckDigit):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,133 @@
+// Package handlers contains HTTP handlers for loyalty endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	loyaltyUC "github.com/james-wukong/orders-api/internal/usecase/loyalty"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LoyaltyHandler struct {
+	auth          gin.HandlerFunc
+	getAccountUC  *loyaltyUC.GetAccountUseCase
+	listEntriesUC *loyaltyUC.ListEntriesUseCase
+	getRuleUC     *loyaltyUC.GetRuleUseCase
+	saveRuleUC    *loyaltyUC.SaveRuleUseCase
+}
+
+func NewLoyaltyHandler(
+	auth gin.HandlerFunc,
+	a *loyaltyUC.GetAccountUseCase,
+	le *loyaltyUC.ListEntriesUseCase,
+	gr *loyaltyUC.GetRuleUseCase,
+	sr *loyaltyUC.SaveRuleUseCase,
+) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		auth:          auth,
+		getAccountUC:  a,
+		listEntriesUC: le,
+		getRuleUC:     gr,
+		saveRuleUC:    sr,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Points are spent at
+// checkout with redeem_points.
+func (h *LoyaltyHandler) Register(v1 *gin.RouterGroup) {
+	loyaltyGroup := v1.Group("/loyalty", h.auth)
+	{
+		loyaltyGroup.GET("", h.Account)
+		loyaltyGroup.GET("/entries", h.Entries)
+	}
+	v1.GET("/restaurants/:id/loyalty-rule", h.GetRule)
+	v1.PUT("/restaurants/:id/loyalty-rule",
+		h.auth, middleware.RequireRoles(user.RoleAdmin.String()), h.SaveRule,
+	)
+}
+
+func (h *LoyaltyHandler) Account(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	acc, program, err := h.getAccountUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(loyaltyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToLoyaltyAccountResponse(acc, program.PointValue))
+}
+
+// Entries returns the customer's points ledger, newest first
+func (h *LoyaltyHandler) Entries(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	entries, err := h.listEntriesUC.Execute(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		c.JSON(loyaltyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.LoyaltyEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, dto.MapToLoyaltyEntryResponse(e))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *LoyaltyHandler) GetRule(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+
+	rule, err := h.getRuleUC.Execute(c.Request.Context(), restaurantID)
+	if err != nil {
+		c.JSON(loyaltyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToLoyaltyRuleResponse(rule))
+}
+
+func (h *LoyaltyHandler) SaveRule(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	var req dto.SaveLoyaltyRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.saveRuleUC.Execute(c.Request.Context(), restaurantID, req)
+	if err != nil {
+		c.JSON(loyaltyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToLoyaltyRuleResponse(rule))
+}
+
+// loyaltyErrorStatus maps loyalty errors to HTTP status codes
+func loyaltyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, loyalty.ErrRuleNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, loyalty.ErrInvalidRule):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,98 @@
+// Package handlers contains HTTP handlers for menu endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	menuUC "github.com/james-wukong/orders-api/internal/usecase/menu"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type MenuHandler struct {
+	auth           gin.HandlerFunc
+	listMenuUC     *menuUC.ListMenuUseCase
+	availabilityUC *menuUC.ListAvailabilityChangesUseCase
+}
+
+func NewMenuHandler(
+	auth gin.HandlerFunc,
+	l *menuUC.ListMenuUseCase,
+	a *menuUC.ListAvailabilityChangesUseCase,
+) *MenuHandler {
+	return &MenuHandler{
+		auth:           auth,
+		listMenuUC:     l,
+		availabilityUC: a,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *MenuHandler) Register(v1 *gin.RouterGroup) {
+	staff := middleware.RequireRoles(
+		user.RoleAdmin.String(), user.RoleKitchen.String(), user.RoleInventoryManager.String(),
+	)
+	// The menu is public so customers can browse before signing in
+	v1.GET("/restaurants/:id/menu", h.List)
+	v1.GET("/menu-items/:id/availability", h.auth, staff, h.Availability)
+}
+
+// List returns the restaurant's menu. available=true leaves out items that
+// are switched off.
+func (h *MenuHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+
+	items, err := h.listMenuUC.Execute(c.Request.Context(), restaurantID)
+	if err != nil {
+		c.JSON(menuErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	onlyAvailable := c.Query("available") == "true"
+	res := make([]dto.MenuItemResponse, 0, len(items))
+	for _, mi := range items {
+		if onlyAvailable && !mi.IsAvailable {
+			continue
+		}
+		res = append(res, dto.MapToMenuItemResponse(mi))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *MenuHandler) Availability(c *gin.Context) {
+	menuItemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid menu item id"})
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	mi, changes, err := h.availabilityUC.Execute(c.Request.Context(), menuItemID, limit)
+	if err != nil {
+		c.JSON(menuErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToMenuAvailabilityResponse(mi, changes))
+}
+
+// menuErrorStatus maps menu domain errors to HTTP status codes
+func menuErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, menu.ErrMenuItemNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
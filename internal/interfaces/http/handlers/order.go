@@ -0,0 +1,432 @@
+// Package handlers contains HTTP handlers for checkout and order endpoints.
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/address"
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	orderUC "github.com/james-wukong/orders-api/internal/usecase/order"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrderHandler struct {
+	auth           gin.HandlerFunc
+	quoteOrderUC   *orderUC.QuoteOrderUseCase
+	placeOrderUC   *orderUC.PlaceOrderUseCase
+	getOrderUC     *orderUC.GetOrderUseCase
+	getReceiptUC   *orderUC.GetReceiptUseCase
+	cancelOrderUC  *orderUC.CancelOrderUseCase
+	refundOrderUC  *orderUC.RefundOrderUseCase
+	listSlotsUC    *orderUC.ListPreorderSlotsUseCase
+	updateStatusUC *orderUC.UpdateOrderStatusUseCase
+	etaAccuracyUC  *orderUC.GetETAAccuracyUseCase
+}
+
+func NewOrderHandler(
+	auth gin.HandlerFunc,
+	q *orderUC.QuoteOrderUseCase,
+	p *orderUC.PlaceOrderUseCase,
+	g *orderUC.GetOrderUseCase,
+	r *orderUC.GetReceiptUseCase,
+	c *orderUC.CancelOrderUseCase,
+	rf *orderUC.RefundOrderUseCase,
+	ls *orderUC.ListPreorderSlotsUseCase,
+	us *orderUC.UpdateOrderStatusUseCase,
+	ea *orderUC.GetETAAccuracyUseCase,
+) *OrderHandler {
+	return &OrderHandler{
+		auth:           auth,
+		quoteOrderUC:   q,
+		placeOrderUC:   p,
+		getOrderUC:     g,
+		getReceiptUC:   r,
+		cancelOrderUC:  c,
+		refundOrderUC:  rf,
+		listSlotsUC:    ls,
+		updateStatusUC: us,
+		etaAccuracyUC:  ea,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *OrderHandler) Register(v1 *gin.RouterGroup) {
+	checkoutGroup := v1.Group("/checkout", h.auth)
+	{
+		checkoutGroup.POST("/quote", h.Quote)
+		checkoutGroup.POST("", h.Checkout)
+	}
+	orderGroup := v1.Group("/orders", h.auth)
+	{
+		orderGroup.GET("/:id", h.Get)
+		orderGroup.GET("/:id/receipt", h.Receipt)
+		orderGroup.POST("/:id/cancel", h.Cancel)
+		orderGroup.POST("/:id/refund", middleware.RequireRoles(user.RoleAdmin.String()), h.Refund)
+		orderGroup.GET("/:id/track", h.Track)
+		orderGroup.PATCH("/:id/status",
+			middleware.RequireRoles(user.RoleAdmin.String(), user.RoleKitchen.String(), user.RoleDelivery.String()),
+			h.UpdateStatus,
+		)
+	}
+	v1.GET("/restaurants/:id/preorder-slots", h.PreorderSlots)
+	v1.GET("/restaurants/:id/eta-accuracy",
+		h.auth, middleware.RequireRoles(user.RoleAdmin.String()), h.ETAAccuracy,
+	)
+}
+
+func (h *OrderHandler) Quote(c *gin.Context) {
+	var req dto.CheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	o, err := h.quoteOrderUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToOrderResponse(o))
+}
+
+func (h *OrderHandler) Checkout(c *gin.Context) {
+	var req dto.CheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	o, err := h.placeOrderUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToOrderResponse(o))
+}
+
+func (h *OrderHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+	role := user.Role(middleware.CurrentUserRole(c))
+
+	o, err := h.getOrderUC.Execute(c.Request.Context(), userID, role, id)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToOrderResponse(o))
+}
+
+func (h *OrderHandler) Receipt(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+	role := user.Role(middleware.CurrentUserRole(c))
+
+	o, res, err := h.getReceiptUC.Execute(c.Request.Context(), userID, role, id)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReceiptResponse(o, res))
+}
+
+func (h *OrderHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	var req dto.CancelOrderRequest
+	// The body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	userID, _ := middleware.CurrentUserID(c)
+	role := user.Role(middleware.CurrentUserRole(c))
+
+	o, err := h.cancelOrderUC.Execute(c.Request.Context(), userID, role, id, req.Reason)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToOrderResponse(o))
+}
+
+// Refund refunds a delivered or cancelled order and reverses its loyalty
+// points
+func (h *OrderHandler) Refund(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	var req dto.RefundOrderRequest
+	// The body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	o, err := h.refundOrderUC.Execute(c.Request.Context(), id, req.Reason, req.Destination == "wallet")
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToOrderResponse(o))
+}
+
+func (h *OrderHandler) UpdateStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	var req dto.UpdateOrderStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, _ := middleware.CurrentUserID(c)
+	o, err := h.updateStatusUC.Execute(c.Request.Context(), id, order.Status(req.Status), userID)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToOrderResponse(o))
+}
+
+// trackPollInterval is how often the tracking stream looks for changes.
+// Reading from the database keeps the stream correct whichever replica
+// applied the change.
+const trackPollInterval = 5 * time.Second
+
+// Track streams the order's status and ETA as server-sent events until the
+// order is delivered or cancelled, or the client disconnects.
+func (h *OrderHandler) Track(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+	role := user.Role(middleware.CurrentUserRole(c))
+	ctx := c.Request.Context()
+
+	o, err := h.getOrderUC.Execute(ctx, userID, role, id)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+
+	// The stream must outlive the server's write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	last := dto.MapToOrderTracking(o)
+	c.SSEvent("order", last)
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(trackPollInterval)
+	defer ticker.Stop()
+	c.Stream(func(w io.Writer) bool {
+		if isFinalStatus(order.Status(last.Status)) {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+
+		o, err := h.getOrderUC.Execute(ctx, userID, role, id)
+		if err != nil {
+			return false
+		}
+		current := dto.MapToOrderTracking(o)
+		if current == last {
+			// Comment line, keeps proxies from closing an idle stream
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+		last = current
+		c.SSEvent("order", current)
+		return true
+	})
+}
+
+func isFinalStatus(s order.Status) bool {
+	return s == order.StatusDelivered || s == order.StatusCancelled
+}
+
+func (h *OrderHandler) ETAAccuracy(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	from, to, err := parseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accuracy, err := h.etaAccuracyUC.Execute(c.Request.Context(), id, from, to)
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToETAAccuracyResponse(id.String(), accuracy))
+}
+
+// parseDateRange parses optional YYYY-MM-DD bounds. to is inclusive, so the
+// returned end is the start of the following day. Missing bounds are zero.
+func parseDateRange(fromParam, toParam string) (from, to time.Time, err error) {
+	if fromParam != "" {
+		if from, err = time.Parse(time.DateOnly, fromParam); err != nil {
+			return from, to, errors.New("from must be formatted as YYYY-MM-DD")
+		}
+	}
+	if toParam != "" {
+		if to, err = time.Parse(time.DateOnly, toParam); err != nil {
+			return from, to, errors.New("to must be formatted as YYYY-MM-DD")
+		}
+		to = to.AddDate(0, 0, 1)
+	}
+	return from, to, nil
+}
+
+func (h *OrderHandler) PreorderSlots(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+
+	slots, res, err := h.listSlotsUC.Execute(c.Request.Context(), id, c.Query("date"))
+	if err != nil {
+		respondOrderError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToPreorderSlots(slots, res.Location()))
+}
+
+// respondOrderError writes the error with its status and, when the client can
+// act on it, a machine-readable code
+func respondOrderError(c *gin.Context, err error) {
+	body := gin.H{"error": err.Error()}
+	if code := orderErrorCode(err); code != "" {
+		body["code"] = code
+	}
+	c.JSON(orderErrorStatus(err), body)
+}
+
+func orderErrorCode(err error) string {
+	switch {
+	case errors.Is(err, delivery.ErrOutsideDeliveryArea):
+		return delivery.CodeOutsideDeliveryArea
+	case errors.Is(err, delivery.ErrNoDeliveryZones):
+		return delivery.CodeNoDeliveryZones
+	case errors.Is(err, delivery.ErrAddressRequired):
+		return delivery.CodeAddressRequired
+	case errors.Is(err, delivery.ErrAddressNotLocated):
+		return delivery.CodeAddressNotLocated
+	case errors.Is(err, delivery.ErrBelowZoneMinimumOrder):
+		return delivery.CodeBelowZoneMinimumOrder
+	case errors.Is(err, order.ErrSlotFull):
+		return order.CodeSlotFull
+	case errors.Is(err, inventory.ErrInsufficientStock):
+		return inventory.CodeInsufficientStock
+	case errors.Is(err, loyalty.ErrInsufficientPoints):
+		return loyalty.CodeInsufficientPoints
+	case errors.Is(err, wallet.ErrInsufficientFunds):
+		return wallet.CodeInsufficientFunds
+	default:
+		return ""
+	}
+}
+
+// orderErrorStatus maps domain errors raised while ordering to HTTP status codes
+func orderErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, order.ErrOrderNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound),
+		errors.Is(err, menu.ErrMenuItemNotFound),
+		errors.Is(err, address.ErrAddressNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, order.ErrNotOrderOwner):
+		return http.StatusForbidden
+	case errors.Is(err, order.ErrSlotFull),
+		errors.Is(err, order.ErrInvalidTransition),
+		errors.Is(err, order.ErrNotRefundable),
+		errors.Is(err, order.ErrAlreadyRefunded),
+		errors.Is(err, order.ErrNotPaid),
+		errors.Is(err, order.ErrPaidInCash),
+		errors.Is(err, inventory.ErrInsufficientStock),
+		errors.Is(err, loyalty.ErrInsufficientPoints),
+		errors.Is(err, wallet.ErrInsufficientFunds):
+		return http.StatusConflict
+	case errors.Is(err, payment.ErrPaymentDeclined):
+		return http.StatusPaymentRequired
+	case errors.Is(err, order.ErrInvalidScheduleDate):
+		return http.StatusBadRequest
+	case errors.Is(err, order.ErrEmptyOrder),
+		errors.Is(err, order.ErrInvalidOrderType),
+		errors.Is(err, order.ErrBelowMinimumOrder),
+		errors.Is(err, order.ErrRestaurantClosed),
+		errors.Is(err, order.ErrScheduleTooSoon),
+		errors.Is(err, order.ErrScheduleTooFar),
+		errors.Is(err, order.ErrScheduleNotAligned),
+		errors.Is(err, order.ErrScheduleClosed),
+		errors.Is(err, menu.ErrMenuItemUnavailable),
+		errors.Is(err, menu.ErrMenuItemWrongVenue),
+		errors.Is(err, delivery.ErrOutsideDeliveryArea),
+		errors.Is(err, delivery.ErrNoDeliveryZones),
+		errors.Is(err, delivery.ErrAddressRequired),
+		errors.Is(err, delivery.ErrAddressNotLocated),
+		errors.Is(err, delivery.ErrBelowZoneMinimumOrder),
+		errors.Is(err, delivery.ErrRestaurantNotLocated),
+		errors.Is(err, inventory.ErrIncompatibleUnits),
+		errors.Is(err, inventory.ErrItemWithoutUnit),
+		errors.Is(err, tax.ErrNegativeAmount),
+		errors.Is(err, tax.ErrUnknownCategory),
+		errors.Is(err, loyalty.ErrRedemptionNotAllowed),
+		errors.Is(err, loyalty.ErrRedemptionExceedsCap),
+		errors.Is(err, wallet.ErrWalletAmountTooLarge):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,274 @@
+// Package handlers contains HTTP handlers for purchase order endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	purchasingUC "github.com/james-wukong/orders-api/internal/usecase/purchasing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PurchaseOrderHandler struct {
+	auth           gin.HandlerFunc
+	createPOUC     *purchasingUC.CreatePurchaseOrderUseCase
+	updatePOUC     *purchasingUC.UpdatePurchaseOrderUseCase
+	getPOUC        *purchasingUC.GetPurchaseOrderUseCase
+	listPOsUC      *purchasingUC.ListPurchaseOrdersUseCase
+	updateStatusUC *purchasingUC.UpdatePurchaseOrderStatusUseCase
+	receivePOUC    *purchasingUC.ReceivePurchaseOrderUseCase
+	suggestUC      *purchasingUC.SuggestReordersUseCase
+	draftUC        *purchasingUC.DraftReordersUseCase
+}
+
+func NewPurchaseOrderHandler(
+	auth gin.HandlerFunc,
+	c *purchasingUC.CreatePurchaseOrderUseCase,
+	u *purchasingUC.UpdatePurchaseOrderUseCase,
+	g *purchasingUC.GetPurchaseOrderUseCase,
+	l *purchasingUC.ListPurchaseOrdersUseCase,
+	us *purchasingUC.UpdatePurchaseOrderStatusUseCase,
+	r *purchasingUC.ReceivePurchaseOrderUseCase,
+	sr *purchasingUC.SuggestReordersUseCase,
+	dr *purchasingUC.DraftReordersUseCase,
+) *PurchaseOrderHandler {
+	return &PurchaseOrderHandler{
+		auth:           auth,
+		createPOUC:     c,
+		updatePOUC:     u,
+		getPOUC:        g,
+		listPOsUC:      l,
+		updateStatusUC: us,
+		receivePOUC:    r,
+		suggestUC:      sr,
+		draftUC:        dr,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *PurchaseOrderHandler) Register(v1 *gin.RouterGroup) {
+	staff := middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String())
+	poGroup := v1.Group("/inventory/purchase-orders", h.auth, staff)
+	{
+		poGroup.GET("", h.List)
+		poGroup.POST("", h.Create)
+		poGroup.GET("/:id", h.Get)
+		poGroup.PUT("/:id", h.Update)
+		poGroup.POST("/:id/submit", h.transition(purchasing.StatusSubmitted))
+		poGroup.POST("/:id/return", h.transition(purchasing.StatusDraft))
+		poGroup.POST("/:id/approve", h.transition(purchasing.StatusApproved))
+		poGroup.POST("/:id/order", h.transition(purchasing.StatusOrdered))
+		poGroup.POST("/:id/cancel", h.transition(purchasing.StatusCancelled))
+		poGroup.POST("/:id/receive", h.Receive)
+	}
+	reorderGroup := v1.Group("/inventory/reorder-suggestions", h.auth, staff)
+	{
+		reorderGroup.GET("", h.SuggestReorders)
+		reorderGroup.POST("/drafts", h.DraftReorders)
+	}
+}
+
+func (h *PurchaseOrderHandler) Create(c *gin.Context) {
+	var req dto.CreatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	po, err := h.createPOUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToPurchaseOrderResponse(po))
+}
+
+func (h *PurchaseOrderHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter := purchasing.Filter{Status: purchasing.Status(c.Query("status"))}
+	if raw := c.Query("supplier_id"); raw != "" {
+		supplierID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+			return
+		}
+		filter.SupplierID = &supplierID
+	}
+
+	orders, err := h.listPOsUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.PurchaseOrderResponse, 0, len(orders))
+	for _, po := range orders {
+		res = append(res, dto.MapToPurchaseOrderResponse(po))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *PurchaseOrderHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid purchase order id"})
+		return
+	}
+
+	po, err := h.getPOUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToPurchaseOrderResponse(po))
+}
+
+func (h *PurchaseOrderHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid purchase order id"})
+		return
+	}
+	var req dto.UpdatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	po, err := h.updatePOUC.Execute(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToPurchaseOrderResponse(po))
+}
+
+// transition returns a handler that moves the purchase order to status
+func (h *PurchaseOrderHandler) transition(status purchasing.Status) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid purchase order id"})
+			return
+		}
+		userID, _ := middleware.CurrentUserID(c)
+
+		po, err := h.updateStatusUC.Execute(c.Request.Context(), userID, id, status)
+		if err != nil {
+			c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, dto.MapToPurchaseOrderResponse(po))
+	}
+}
+
+func (h *PurchaseOrderHandler) Receive(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid purchase order id"})
+		return
+	}
+	var req dto.ReceivePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	po, entries, err := h.receivePOUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := dto.PurchaseReceiptResponse{
+		PurchaseOrder: dto.MapToPurchaseOrderResponse(po),
+		Transactions:  make([]dto.InventoryTransactionResponse, 0, len(entries)),
+	}
+	for _, e := range entries {
+		res.Transactions = append(res.Transactions, dto.MapToInventoryTransactionResponse(e))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// SuggestReorders previews the purchase orders low stock calls for without
+// creating anything. mode is standard or forecast.
+func (h *PurchaseOrderHandler) SuggestReorders(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+
+	mode := purchasing.ReorderMode(c.Query("mode"))
+	plan, err := h.suggestUC.Execute(c.Request.Context(), restaurantID, mode, time.Now())
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReorderPlanResponse(plan, nil))
+}
+
+func (h *PurchaseOrderHandler) DraftReorders(c *gin.Context) {
+	var req dto.DraftReordersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	restaurantID, _ := uuid.Parse(req.RestaurantID)
+	userID, _ := middleware.CurrentUserID(c)
+
+	mode := purchasing.ReorderMode(req.Mode)
+	plan, drafts, err := h.draftUC.Execute(c.Request.Context(), restaurantID, mode, &userID, time.Now())
+	if err != nil {
+		c.JSON(purchaseOrderErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToReorderPlanResponse(plan, drafts))
+}
+
+// purchaseOrderErrorStatus maps purchasing domain errors to HTTP status codes
+func purchaseOrderErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, purchasing.ErrPurchaseOrderNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound),
+		errors.Is(err, supplier.ErrSupplierNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, purchasing.ErrSelfApproval):
+		return http.StatusForbidden
+	case errors.Is(err, purchasing.ErrInvalidTransition),
+		errors.Is(err, purchasing.ErrNotDraft),
+		errors.Is(err, purchasing.ErrNotOrdered),
+		errors.Is(err, purchasing.ErrAlreadyReceived),
+		errors.Is(err, purchasing.ErrReorderInProgress):
+		return http.StatusConflict
+	case errors.Is(err, purchasing.ErrInvalidReorderMode):
+		return http.StatusBadRequest
+	case errors.Is(err, purchasing.ErrLineNotFound),
+		errors.Is(err, purchasing.ErrEmptyPurchaseOrder),
+		errors.Is(err, purchasing.ErrOverReceipt),
+		errors.Is(err, purchasing.ErrDuplicateLine),
+		errors.Is(err, purchasing.ErrLineWrongVenue),
+		errors.Is(err, supplier.ErrSupplierInactive),
+		errors.Is(err, inventory.ErrItemNotFound),
+		errors.Is(err, inventory.ErrItemInactive),
+		errors.Is(err, inventory.ErrNonPositiveQuantity),
+		errors.Is(err, inventory.ErrNegativeCost):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
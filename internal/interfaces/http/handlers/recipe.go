@@ -0,0 +1,163 @@
+// Package handlers contains HTTP handlers for recipe and food-cost endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	recipeUC "github.com/james-wukong/orders-api/internal/usecase/recipe"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RecipeHandler struct {
+	auth           gin.HandlerFunc
+	saveRecipeUC   *recipeUC.SaveRecipeUseCase
+	getRecipeUC    *recipeUC.GetRecipeUseCase
+	deleteRecipeUC *recipeUC.DeleteRecipeUseCase
+	foodCostUC     *recipeUC.FoodCostReportUseCase
+}
+
+func NewRecipeHandler(
+	auth gin.HandlerFunc,
+	s *recipeUC.SaveRecipeUseCase,
+	g *recipeUC.GetRecipeUseCase,
+	d *recipeUC.DeleteRecipeUseCase,
+	fc *recipeUC.FoodCostReportUseCase,
+) *RecipeHandler {
+	return &RecipeHandler{
+		auth:           auth,
+		saveRecipeUC:   s,
+		getRecipeUC:    g,
+		deleteRecipeUC: d,
+		foodCostUC:     fc,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *RecipeHandler) Register(v1 *gin.RouterGroup) {
+	staff := middleware.RequireRoles(
+		user.RoleAdmin.String(), user.RoleKitchen.String(), user.RoleInventoryManager.String(),
+	)
+	recipeGroup := v1.Group("/menu-items/:id/recipe", h.auth, staff)
+	{
+		recipeGroup.GET("", h.Get)
+		recipeGroup.PUT("", h.Save)
+		recipeGroup.DELETE("", h.Delete)
+	}
+	v1.GET("/restaurants/:id/food-cost", h.auth, staff, h.FoodCostReport)
+}
+
+func (h *RecipeHandler) Get(c *gin.Context) {
+	menuItemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid menu item id"})
+		return
+	}
+
+	pc, err := h.getRecipeUC.Execute(c.Request.Context(), menuItemID)
+	if err != nil {
+		c.JSON(recipeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToRecipeResponse(pc))
+}
+
+func (h *RecipeHandler) Save(c *gin.Context) {
+	menuItemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid menu item id"})
+		return
+	}
+	var req dto.SaveRecipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pc, err := h.saveRecipeUC.Execute(c.Request.Context(), menuItemID, req)
+	if err != nil {
+		c.JSON(recipeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToRecipeResponse(pc))
+}
+
+func (h *RecipeHandler) Delete(c *gin.Context) {
+	menuItemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid menu item id"})
+		return
+	}
+
+	if err := h.deleteRecipeUC.Execute(c.Request.Context(), menuItemID); err != nil {
+		c.JSON(recipeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// FoodCostReport lists plate costs for the restaurant's recipes. The optional
+// threshold query parameter overrides the configured food-cost target (%).
+func (h *RecipeHandler) FoodCostReport(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	var threshold *float64
+	if raw := c.Query("threshold"); raw != "" {
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || v < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "threshold must be a non-negative percentage"})
+			return
+		}
+		threshold = &v
+	}
+
+	costs, limit, err := h.foodCostUC.Execute(c.Request.Context(), restaurantID, threshold)
+	if err != nil {
+		c.JSON(recipeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := dto.MapToFoodCostReportResponse(restaurantID.String(), limit, costs)
+	if c.Query("flagged") == "true" {
+		flagged := res.Items[:0]
+		for _, line := range res.Items {
+			if line.ExceedsTarget {
+				flagged = append(flagged, line)
+			}
+		}
+		res.Items = flagged
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// recipeErrorStatus maps recipe domain errors to HTTP status codes
+func recipeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, recipe.ErrRecipeNotFound),
+		errors.Is(err, menu.ErrMenuItemNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, recipe.ErrDuplicateIngredient),
+		errors.Is(err, recipe.ErrIngredientWrongVenue),
+		errors.Is(err, inventory.ErrItemNotFound),
+		errors.Is(err, inventory.ErrItemInactive),
+		errors.Is(err, inventory.ErrUnitNotFound),
+		errors.Is(err, inventory.ErrIncompatibleUnits),
+		errors.Is(err, inventory.ErrItemWithoutUnit):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,282 @@
+// Package handlers contains HTTP handlers for reporting endpoints.
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	reportUC "github.com/james-wukong/orders-api/internal/usecase/report"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	auth            gin.HandlerFunc
+	dailySalesUC    *reportUC.GetDailySalesUseCase
+	hourlySalesUC   *reportUC.GetHourlySalesUseCase
+	itemSalesUC     *reportUC.GetItemSalesUseCase
+	categorySalesUC *reportUC.GetCategorySalesUseCase
+	orderSummaryUC  *reportUC.GetOrderSummaryUseCase
+	cancellationsUC *reportUC.GetCancellationsUseCase
+	valueUC         *reportUC.GetInventoryValueUseCase
+	lowStockUC      *reportUC.GetLowStockUseCase
+	usageUC         *reportUC.GetInventoryUsageUseCase
+	menuUC          *reportUC.GetMenuEngineeringUseCase
+}
+
+func NewReportHandler(
+	auth gin.HandlerFunc,
+	ds *reportUC.GetDailySalesUseCase,
+	hs *reportUC.GetHourlySalesUseCase,
+	is *reportUC.GetItemSalesUseCase,
+	cs *reportUC.GetCategorySalesUseCase,
+	os *reportUC.GetOrderSummaryUseCase,
+	c *reportUC.GetCancellationsUseCase,
+	v *reportUC.GetInventoryValueUseCase,
+	ls *reportUC.GetLowStockUseCase,
+	u *reportUC.GetInventoryUsageUseCase,
+	me *reportUC.GetMenuEngineeringUseCase,
+) *ReportHandler {
+	return &ReportHandler{
+		auth:            auth,
+		dailySalesUC:    ds,
+		hourlySalesUC:   hs,
+		itemSalesUC:     is,
+		categorySalesUC: cs,
+		orderSummaryUC:  os,
+		cancellationsUC: c,
+		valueUC:         v,
+		lowStockUC:      ls,
+		usageUC:         u,
+		menuUC:          me,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Every report takes
+// format=csv to download it instead of JSON; dated reports take optional
+// from and to dates (YYYY-MM-DD, inclusive), the last 30 days by default.
+func (h *ReportHandler) Register(v1 *gin.RouterGroup) {
+	reports := v1.Group("/restaurants/:id/reports", h.auth,
+		middleware.RequireRoles(user.RoleAdmin.String(), user.RoleInventoryManager.String()))
+	reports.GET("/sales/daily", h.DailySales)
+	reports.GET("/sales/hourly", h.HourlySales)
+	reports.GET("/sales/items", h.ItemSales)
+	reports.GET("/sales/categories", h.CategorySales)
+	reports.GET("/orders", h.OrderSummary)
+	reports.GET("/cancellations", h.Cancellations)
+	reports.GET("/inventory/value", h.InventoryValue)
+	reports.GET("/inventory/low-stock", h.LowStock)
+	reports.GET("/inventory/usage", h.InventoryUsage)
+	reports.GET("/menu-engineering", h.MenuEngineering)
+}
+
+func (h *ReportHandler) DailySales(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	p, rows, err := h.dailySalesUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "sales-daily", dto.MapToDailySalesReport(p, rows))
+}
+
+func (h *ReportHandler) HourlySales(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	p, rows, err := h.hourlySalesUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "sales-hourly", dto.MapToHourlySalesReport(p, rows))
+}
+
+func (h *ReportHandler) ItemSales(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	p, rows, err := h.itemSalesUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "sales-items", dto.MapToItemSalesReport(p, rows))
+}
+
+func (h *ReportHandler) CategorySales(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	p, rows, err := h.categorySalesUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "sales-categories", dto.MapToCategorySalesReport(p, rows))
+}
+
+// OrderSummary reports the average order value and the order-type mix
+func (h *ReportHandler) OrderSummary(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	summary, err := h.orderSummaryUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "orders", dto.MapToOrderSummaryResponse(summary))
+}
+
+func (h *ReportHandler) Cancellations(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	cancellations, err := h.cancellationsUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "cancellations", dto.MapToCancellationsResponse(cancellations))
+}
+
+// InventoryValue reports stock as it is now, so it takes no dates
+func (h *ReportHandler) InventoryValue(c *gin.Context) {
+	restaurantID, _, _, ok := reportRequest(c, false)
+	if !ok {
+		return
+	}
+	rows, err := h.valueUC.Execute(c.Request.Context(), restaurantID)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "inventory-value", dto.MapToInventoryValueResponse(restaurantID.String(), rows))
+}
+
+func (h *ReportHandler) LowStock(c *gin.Context) {
+	restaurantID, _, _, ok := reportRequest(c, false)
+	if !ok {
+		return
+	}
+	rows, err := h.lowStockUC.Execute(c.Request.Context(), restaurantID)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "low-stock", dto.MapToLowStockResponse(restaurantID.String(), rows))
+}
+
+func (h *ReportHandler) InventoryUsage(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	p, rows, err := h.usageUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "inventory-usage", dto.MapToInventoryUsageResponse(p, rows))
+}
+
+// MenuEngineering classifies each menu item as a star, plowhorse, puzzle or
+// dog from its popularity and contribution margin over the period
+func (h *ReportHandler) MenuEngineering(c *gin.Context) {
+	restaurantID, from, to, ok := reportRequest(c, true)
+	if !ok {
+		return
+	}
+	me, err := h.menuUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	respondReport(c, "menu-engineering", dto.MapToMenuEngineeringResponse(me))
+}
+
+// reportRequest parses the restaurant id, the format and, when dated, the
+// from and to dates, responding 400 when one is invalid
+func reportRequest(c *gin.Context, dated bool) (restaurantID uuid.UUID, from, to time.Time, ok bool) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return restaurantID, from, to, false
+	}
+	switch c.Query("format") {
+	case "", "json", "csv":
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv"})
+		return restaurantID, from, to, false
+	}
+	if dated {
+		if from, to, err = parseDateRange(c.Query("from"), c.Query("to")); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return restaurantID, from, to, false
+		}
+	}
+	return restaurantID, from, to, true
+}
+
+// respondReport writes the report as JSON, or as a CSV download named after
+// the report and today's date when format=csv
+func respondReport(c *gin.Context, name string, res dto.CSVReport) {
+	if c.Query("format") != "csv" {
+		c.JSON(http.StatusOK, res)
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.csv", name, time.Now().Format(time.DateOnly))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	if err := w.Write(res.CSVHeader()); err != nil {
+		return
+	}
+	for _, record := range res.CSVRecords() {
+		if err := w.Write(record); err != nil {
+			// The client has gone; the status is already sent
+			return
+		}
+	}
+	w.Flush()
+}
+
+// reportErrorStatus maps report errors to HTTP status codes
+func reportErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, report.ErrInvalidPeriod),
+		errors.Is(err, report.ErrPeriodTooLong):
+		return http.StatusBadRequest
+	case errors.Is(err, recipe.ErrIngredientNotCosted),
+		errors.Is(err, inventory.ErrIncompatibleUnits):
+		// A recipe can't be costed until it is fixed
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
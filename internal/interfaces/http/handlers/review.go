@@ -0,0 +1,256 @@
+// Package handlers contains HTTP handlers for review endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	reviewUC "github.com/james-wukong/orders-api/internal/usecase/review"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReviewHandler struct {
+	auth            gin.HandlerFunc
+	createReviewUC  *reviewUC.CreateReviewUseCase
+	getReviewUC     *reviewUC.GetOrderReviewUseCase
+	listReviewsUC   *reviewUC.ListReviewsUseCase
+	replyReviewUC   *reviewUC.ReplyReviewUseCase
+	hideReviewUC    *reviewUC.HideReviewUseCase
+	restoreReviewUC *reviewUC.RestoreReviewUseCase
+}
+
+func NewReviewHandler(
+	auth gin.HandlerFunc,
+	c *reviewUC.CreateReviewUseCase,
+	g *reviewUC.GetOrderReviewUseCase,
+	l *reviewUC.ListReviewsUseCase,
+	rp *reviewUC.ReplyReviewUseCase,
+	hd *reviewUC.HideReviewUseCase,
+	rs *reviewUC.RestoreReviewUseCase,
+) *ReviewHandler {
+	return &ReviewHandler{
+		auth:            auth,
+		createReviewUC:  c,
+		getReviewUC:     g,
+		listReviewsUC:   l,
+		replyReviewUC:   rp,
+		hideReviewUC:    hd,
+		restoreReviewUC: rs,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Anyone can read a
+// restaurant's visible reviews; restaurant staff reply and admins moderate.
+func (h *ReviewHandler) Register(v1 *gin.RouterGroup) {
+	v1.POST("/orders/:id/review", h.auth, h.Create)
+	v1.GET("/orders/:id/review", h.auth, h.GetByOrder)
+	v1.GET("/restaurants/:id/reviews", h.ListByRestaurant)
+
+	admin := middleware.RequireRoles(user.RoleAdmin.String())
+	reviewGroup := v1.Group("/reviews", h.auth)
+	{
+		reviewGroup.GET("", admin, h.Moderation)
+		reviewGroup.POST("/:id/reply",
+			middleware.RequireRoles(user.RoleAdmin.String(), user.RoleInventoryManager.String()),
+			h.Reply,
+		)
+		reviewGroup.POST("/:id/hide", admin, h.Hide)
+		reviewGroup.POST("/:id/restore", admin, h.Restore)
+	}
+}
+
+// Create reviews one of the customer's delivered orders
+func (h *ReviewHandler) Create(c *gin.Context) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	var req dto.CreateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	rv, err := h.createReviewUC.Execute(c.Request.Context(), userID, orderID, req)
+	if err != nil {
+		c.JSON(reviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToReviewResponse(rv, false))
+}
+
+func (h *ReviewHandler) GetByOrder(c *gin.Context) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+	role := user.Role(middleware.CurrentUserRole(c))
+
+	rv, err := h.getReviewUC.Execute(c.Request.Context(), userID, role, orderID)
+	if err != nil {
+		c.JSON(reviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReviewResponse(rv, role.SeesAllOrders()))
+}
+
+// ListByRestaurant returns the restaurant's visible reviews, optionally
+// only those with the given rating
+func (h *ReviewHandler) ListByRestaurant(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	filter, ok := reviewFilter(c)
+	if !ok {
+		return
+	}
+	filter.Status = review.StatusVisible
+	h.list(c, restaurantID, filter, false)
+}
+
+// Moderation lists a restaurant's reviews for admins. status is visible,
+// hidden or all (default).
+func (h *ReviewHandler) Moderation(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter, ok := reviewFilter(c)
+	if !ok {
+		return
+	}
+	switch status := c.DefaultQuery("status", "all"); review.Status(status) {
+	case review.StatusVisible, review.StatusHidden:
+		filter.Status = review.Status(status)
+	case "all":
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be visible, hidden or all"})
+		return
+	}
+	h.list(c, restaurantID, filter, true)
+}
+
+func (h *ReviewHandler) list(c *gin.Context, restaurantID uuid.UUID, filter review.Filter, staff bool) {
+	summary, reviews, err := h.listReviewsUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(reviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReviewListResponse(restaurantID.String(), summary, reviews, staff))
+}
+
+func (h *ReviewHandler) Reply(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review id"})
+		return
+	}
+	var req dto.ReplyReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	rv, err := h.replyReviewUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(reviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReviewResponse(rv, true))
+}
+
+func (h *ReviewHandler) Hide(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review id"})
+		return
+	}
+	var req dto.HideReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	rv, err := h.hideReviewUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(reviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReviewResponse(rv, true))
+}
+
+func (h *ReviewHandler) Restore(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	rv, err := h.restoreReviewUC.Execute(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(reviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToReviewResponse(rv, true))
+}
+
+// reviewFilter parses rating, limit and offset, responding 400 when the
+// rating is out of range
+func reviewFilter(c *gin.Context) (review.Filter, bool) {
+	var filter review.Filter
+	if s := c.Query("rating"); s != "" {
+		rating, err := strconv.Atoi(s)
+		if err != nil || rating < review.MinRating || rating > review.MaxRating {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "rating must be between 1 and 5"})
+			return filter, false
+		}
+		filter.Rating = rating
+	}
+	filter.Limit, _ = strconv.Atoi(c.Query("limit"))
+	filter.Offset, _ = strconv.Atoi(c.Query("offset"))
+	return filter, true
+}
+
+// reviewErrorStatus maps review errors to HTTP status codes
+func reviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, review.ErrReviewNotFound),
+		errors.Is(err, order.ErrOrderNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, order.ErrNotOrderOwner):
+		return http.StatusForbidden
+	case errors.Is(err, review.ErrAlreadyReviewed),
+		errors.Is(err, review.ErrOrderNotDelivered),
+		errors.Is(err, review.ErrAlreadyHidden),
+		errors.Is(err, review.ErrNotHidden):
+		return http.StatusConflict
+	case errors.Is(err, review.ErrInvalidRating),
+		errors.Is(err, review.ErrItemNotInOrder),
+		errors.Is(err, review.ErrItemRatedTwice),
+		errors.Is(err, review.ErrEmptyReply),
+		errors.Is(err, review.ErrModerationReasonMissing):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
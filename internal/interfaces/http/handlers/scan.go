@@ -0,0 +1,324 @@
+// Package handlers contains HTTP handlers for barcode scanning endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+	scanUC "github.com/james-wukong/orders-api/internal/usecase/scan"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ScanHandler struct {
+	auth          gin.HandlerFunc
+	lookupUC      *scanUC.LookupBarcodeUseCase
+	startUC       *scanUC.StartSessionUseCase
+	getUC         *scanUC.GetSessionUseCase
+	listUC        *scanUC.ListSessionsUseCase
+	recordScanUC  *scanUC.RecordScanUseCase
+	commitUC      *scanUC.CommitSessionUseCase
+	cancelUC      *scanUC.CancelSessionUseCase
+	listUnknownUC *scanUC.ListUnknownBarcodesUseCase
+	mapUC         *scanUC.MapBarcodeUseCase
+	dismissUC     *scanUC.DismissBarcodeUseCase
+}
+
+func NewScanHandler(
+	auth gin.HandlerFunc,
+	lb *scanUC.LookupBarcodeUseCase,
+	s *scanUC.StartSessionUseCase,
+	g *scanUC.GetSessionUseCase,
+	l *scanUC.ListSessionsUseCase,
+	rs *scanUC.RecordScanUseCase,
+	c *scanUC.CommitSessionUseCase,
+	cs *scanUC.CancelSessionUseCase,
+	lu *scanUC.ListUnknownBarcodesUseCase,
+	m *scanUC.MapBarcodeUseCase,
+	d *scanUC.DismissBarcodeUseCase,
+) *ScanHandler {
+	return &ScanHandler{
+		auth:          auth,
+		lookupUC:      lb,
+		startUC:       s,
+		getUC:         g,
+		listUC:        l,
+		recordScanUC:  rs,
+		commitUC:      c,
+		cancelUC:      cs,
+		listUnknownUC: lu,
+		mapUC:         m,
+		dismissUC:     d,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Kitchen staff scan
+// deliveries and shelves; mapping unknown barcodes to items is for managers.
+func (h *ScanHandler) Register(v1 *gin.RouterGroup) {
+	managers := middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String())
+	staff := middleware.RequireRoles(
+		user.RoleKitchen.String(), user.RoleInventoryManager.String(), user.RoleAdmin.String(),
+	)
+	v1.GET("/inventory/barcodes/lookup", h.auth, staff, h.Lookup)
+	sessionGroup := v1.Group("/inventory/scan-sessions", h.auth, staff)
+	{
+		sessionGroup.GET("", h.List)
+		sessionGroup.POST("", h.Start)
+		sessionGroup.GET("/:id", h.Get)
+		sessionGroup.POST("/:id/scans", h.Scan)
+		sessionGroup.POST("/:id/commit", h.Commit)
+		sessionGroup.POST("/:id/cancel", h.Cancel)
+	}
+	unknownGroup := v1.Group("/inventory/unknown-barcodes", h.auth, staff)
+	{
+		unknownGroup.GET("", h.ListUnknown)
+		unknownGroup.POST("/:id/map", managers, h.Map)
+		unknownGroup.POST("/:id/dismiss", managers, h.Dismiss)
+	}
+}
+
+// Lookup finds the item with the barcode in the code query parameter.
+func (h *ScanHandler) Lookup(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	raw := c.Query("code")
+	if raw == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "code query parameter is required"})
+		return
+	}
+
+	item, code, err := h.lookupUC.Execute(c.Request.Context(), restaurantID, raw)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.BarcodeLookupResponse{
+		Barcode: dto.MapToBarcodeResponse(code),
+		Item:    dto.MapToInventoryItemResponse(item),
+	})
+}
+
+func (h *ScanHandler) Start(c *gin.Context) {
+	var req dto.StartScanSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	s, err := h.startUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToScanSessionResponse(s))
+}
+
+func (h *ScanHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter := scan.Filter{
+		Status: scan.Status(c.Query("status")),
+		Type:   scan.Type(c.Query("type")),
+	}
+
+	sessions, err := h.listUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.ScanSessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, dto.MapToScanSessionResponse(s))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *ScanHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid scan session id"})
+		return
+	}
+
+	s, err := h.getUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToScanSessionResponse(s))
+}
+
+// Scan records one scan. It answers 201 whether the barcode added to a
+// line or was queued as unknown; the body says which.
+func (h *ScanHandler) Scan(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid scan session id"})
+		return
+	}
+	var req dto.RecordScanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	outcome, err := h.recordScanUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToScanResponse(outcome))
+}
+
+func (h *ScanHandler) Commit(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid scan session id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	s, err := h.commitUC.Execute(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToScanSessionResponse(s))
+}
+
+func (h *ScanHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid scan session id"})
+		return
+	}
+
+	s, err := h.cancelUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToScanSessionResponse(s))
+}
+
+// ListUnknown returns the queue of unknown barcodes; status defaults to
+// pending, and all lists every status.
+func (h *ScanHandler) ListUnknown(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	status := scan.UnknownStatus(c.DefaultQuery("status", string(scan.UnknownPending)))
+	if status == "all" {
+		status = ""
+	}
+
+	unknown, err := h.listUnknownUC.Execute(c.Request.Context(), restaurantID, status)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.UnknownBarcodeResponse, 0, len(unknown))
+	for _, u := range unknown {
+		res = append(res, dto.MapToUnknownBarcodeResponse(u))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *ScanHandler) Map(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid unknown barcode id"})
+		return
+	}
+	var req dto.MapBarcodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	item, lines, err := h.mapUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToMapBarcodeResponse(item, lines))
+}
+
+func (h *ScanHandler) Dismiss(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid unknown barcode id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	u, err := h.dismissUC.Execute(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(scanErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToUnknownBarcodeResponse(u))
+}
+
+// scanErrorStatus maps scanning domain errors to HTTP status codes
+func scanErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, scan.ErrSessionNotFound),
+		errors.Is(err, scan.ErrBarcodeNotFound),
+		errors.Is(err, scan.ErrUnknownNotFound),
+		errors.Is(err, purchasing.ErrPurchaseOrderNotFound),
+		errors.Is(err, stocktake.ErrStocktakeNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, scan.ErrNotOpen),
+		errors.Is(err, scan.ErrUnknownResolved),
+		errors.Is(err, scan.ErrUnmappedScans),
+		errors.Is(err, scan.ErrItemHasBarcode),
+		errors.Is(err, inventory.ErrDuplicateBarcode),
+		errors.Is(err, purchasing.ErrNotOrdered),
+		errors.Is(err, stocktake.ErrNotOpen):
+		return http.StatusConflict
+	case errors.Is(err, scan.ErrInvalidType),
+		errors.Is(err, scan.ErrMissingReference),
+		errors.Is(err, scan.ErrNothingScanned),
+		errors.Is(err, scan.ErrItemNotExpected),
+		errors.Is(err, scan.ErrItemWrongVenue),
+		errors.Is(err, scan.ErrBelowZero),
+		errors.Is(err, gs1.ErrMalformed),
+		errors.Is(err, gs1.ErrUnknownIdentifier),
+		errors.Is(err, gs1.ErrInvalidCheckDigit),
+		errors.Is(err, purchasing.ErrOverReceipt),
+		errors.Is(err, purchasing.ErrLineNotFound),
+		errors.Is(err, stocktake.ErrItemNotInCount),
+		errors.Is(err, inventory.ErrItemNotFound),
+		errors.Is(err, inventory.ErrItemInactive),
+		errors.Is(err, inventory.ErrNonPositiveQuantity),
+		errors.Is(err, inventory.ErrNegativeCost),
+		errors.Is(err, inventory.ErrUnitNotFound),
+		errors.Is(err, inventory.ErrIncompatibleUnits):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,217 @@
+// Package handlers contains HTTP handlers for stock alert endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	inventoryUC "github.com/james-wukong/orders-api/internal/usecase/inventory"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StockAlertHandler struct {
+	auth                gin.HandlerFunc
+	listAlertsUC        *inventoryUC.ListAlertsUseCase
+	acknowledgeAlertUC  *inventoryUC.AcknowledgeAlertUseCase
+	resolveAlertUC      *inventoryUC.ResolveAlertUseCase
+	listSubscriptionsUC *inventoryUC.ListAlertSubscriptionsUseCase
+	subscribeUC         *inventoryUC.CreateAlertSubscriptionUseCase
+	unsubscribeUC       *inventoryUC.DeleteAlertSubscriptionUseCase
+}
+
+func NewStockAlertHandler(
+	auth gin.HandlerFunc,
+	l *inventoryUC.ListAlertsUseCase,
+	a *inventoryUC.AcknowledgeAlertUseCase,
+	r *inventoryUC.ResolveAlertUseCase,
+	ls *inventoryUC.ListAlertSubscriptionsUseCase,
+	s *inventoryUC.CreateAlertSubscriptionUseCase,
+	u *inventoryUC.DeleteAlertSubscriptionUseCase,
+) *StockAlertHandler {
+	return &StockAlertHandler{
+		auth:                auth,
+		listAlertsUC:        l,
+		acknowledgeAlertUC:  a,
+		resolveAlertUC:      r,
+		listSubscriptionsUC: ls,
+		subscribeUC:         s,
+		unsubscribeUC:       u,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *StockAlertHandler) Register(v1 *gin.RouterGroup) {
+	alertGroup := v1.Group("/inventory", h.auth,
+		middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String()),
+	)
+	{
+		alertGroup.GET("/alerts", h.List)
+		alertGroup.POST("/alerts/:id/acknowledge", h.Acknowledge)
+		alertGroup.POST("/alerts/:id/resolve", h.Resolve)
+		alertGroup.GET("/alert-subscriptions", h.ListSubscriptions)
+		alertGroup.POST("/alert-subscriptions", h.Subscribe)
+		alertGroup.DELETE("/alert-subscriptions/:id", h.Unsubscribe)
+	}
+}
+
+// List returns a restaurant's alerts. status is open (default),
+// unacknowledged, resolved or all.
+func (h *StockAlertHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter := inventory.AlertFilter{
+		Status: inventory.AlertStatus(c.DefaultQuery("status", string(inventory.AlertStatusOpen))),
+		Type:   inventory.AlertType(c.Query("type")),
+	}
+	switch filter.Status {
+	case inventory.AlertStatusOpen, inventory.AlertStatusUnacknowledged,
+		inventory.AlertStatusResolved, inventory.AlertStatusAll:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be open, unacknowledged, resolved or all"})
+		return
+	}
+	if filter.Type != "" && !filter.Type.Valid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert type"})
+		return
+	}
+	if s := c.Query("item_id"); s != "" {
+		itemID, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inventory item id"})
+			return
+		}
+		filter.InventoryItemID = &itemID
+	}
+	filter.Limit, _ = strconv.Atoi(c.Query("limit"))
+
+	alerts, err := h.listAlertsUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(stockAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.StockAlertResponse, 0, len(alerts))
+	for _, a := range alerts {
+		res = append(res, dto.MapToStockAlertResponse(a))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *StockAlertHandler) Acknowledge(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid stock alert id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	alert, err := h.acknowledgeAlertUC.Execute(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(stockAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToStockAlertResponse(alert))
+}
+
+func (h *StockAlertHandler) Resolve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid stock alert id"})
+		return
+	}
+	// The body is optional
+	var req dto.ResolveAlertRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	alert, err := h.resolveAlertUC.Execute(c.Request.Context(), userID, id, req.Notes)
+	if err != nil {
+		c.JSON(stockAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToStockAlertResponse(alert))
+}
+
+// ListSubscriptions returns the caller's subscriptions to a restaurant's alerts
+func (h *StockAlertHandler) ListSubscriptions(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	subs, err := h.listSubscriptionsUC.Execute(c.Request.Context(), userID, restaurantID)
+	if err != nil {
+		c.JSON(stockAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.AlertSubscriptionResponse, 0, len(subs))
+	for _, s := range subs {
+		res = append(res, dto.MapToAlertSubscriptionResponse(s))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *StockAlertHandler) Subscribe(c *gin.Context) {
+	var req dto.CreateAlertSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	sub, err := h.subscribeUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(stockAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToAlertSubscriptionResponse(sub))
+}
+
+func (h *StockAlertHandler) Unsubscribe(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert subscription id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	if err := h.unsubscribeUC.Execute(c.Request.Context(), userID, id); err != nil {
+		c.JSON(stockAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// stockAlertErrorStatus maps stock alert errors to HTTP status codes
+func stockAlertErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, inventory.ErrAlertNotFound),
+		errors.Is(err, inventory.ErrSubscriptionNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, inventory.ErrAlertResolved),
+		errors.Is(err, inventory.ErrDuplicateSubscription):
+		return http.StatusConflict
+	case errors.Is(err, inventory.ErrInvalidSubscriptionTarget):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
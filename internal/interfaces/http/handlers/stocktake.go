@@ -0,0 +1,201 @@
+// Package handlers contains HTTP handlers for stocktake endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	stocktakeUC "github.com/james-wukong/orders-api/internal/usecase/stocktake"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StocktakeHandler struct {
+	auth           gin.HandlerFunc
+	openUC         *stocktakeUC.OpenStocktakeUseCase
+	getUC          *stocktakeUC.GetStocktakeUseCase
+	listUC         *stocktakeUC.ListStocktakesUseCase
+	recordCountsUC *stocktakeUC.RecordCountsUseCase
+	updateStatusUC *stocktakeUC.UpdateStocktakeStatusUseCase
+	approveUC      *stocktakeUC.ApproveStocktakeUseCase
+}
+
+func NewStocktakeHandler(
+	auth gin.HandlerFunc,
+	o *stocktakeUC.OpenStocktakeUseCase,
+	g *stocktakeUC.GetStocktakeUseCase,
+	l *stocktakeUC.ListStocktakesUseCase,
+	rc *stocktakeUC.RecordCountsUseCase,
+	us *stocktakeUC.UpdateStocktakeStatusUseCase,
+	a *stocktakeUC.ApproveStocktakeUseCase,
+) *StocktakeHandler {
+	return &StocktakeHandler{
+		auth:           auth,
+		openUC:         o,
+		getUC:          g,
+		listUC:         l,
+		recordCountsUC: rc,
+		updateStatusUC: us,
+		approveUC:      a,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Kitchen staff may count
+// and submit; opening, cancelling and approving is for managers.
+func (h *StocktakeHandler) Register(v1 *gin.RouterGroup) {
+	managers := middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String())
+	stocktakeGroup := v1.Group("/inventory/stocktakes", h.auth,
+		middleware.RequireRoles(user.RoleKitchen.String(), user.RoleInventoryManager.String(), user.RoleAdmin.String()),
+	)
+	{
+		stocktakeGroup.GET("", h.List)
+		stocktakeGroup.POST("", managers, h.Open)
+		stocktakeGroup.GET("/:id", h.Get)
+		stocktakeGroup.PUT("/:id/counts", h.RecordCounts)
+		stocktakeGroup.POST("/:id/submit", h.transition(stocktake.StatusSubmitted))
+		stocktakeGroup.POST("/:id/reopen", managers, h.transition(stocktake.StatusOpen))
+		stocktakeGroup.POST("/:id/cancel", managers, h.transition(stocktake.StatusCancelled))
+		stocktakeGroup.POST("/:id/approve", managers, h.Approve)
+	}
+}
+
+func (h *StocktakeHandler) Open(c *gin.Context) {
+	var req dto.OpenStocktakeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	s, err := h.openUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(stocktakeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToStocktakeResponse(s))
+}
+
+func (h *StocktakeHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter := stocktake.Filter{Status: stocktake.Status(c.Query("status"))}
+
+	stocktakes, err := h.listUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(stocktakeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.StocktakeResponse, 0, len(stocktakes))
+	for _, s := range stocktakes {
+		res = append(res, dto.MapToStocktakeResponse(s))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *StocktakeHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid stocktake id"})
+		return
+	}
+
+	s, err := h.getUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(stocktakeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToStocktakeResponse(s))
+}
+
+func (h *StocktakeHandler) RecordCounts(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid stocktake id"})
+		return
+	}
+	var req dto.RecordCountsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	s, err := h.recordCountsUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(stocktakeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToStocktakeResponse(s))
+}
+
+// transition returns a handler that moves the stocktake to status
+func (h *StocktakeHandler) transition(status stocktake.Status) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid stocktake id"})
+			return
+		}
+		userID, _ := middleware.CurrentUserID(c)
+
+		s, err := h.updateStatusUC.Execute(c.Request.Context(), userID, id, status)
+		if err != nil {
+			c.JSON(stocktakeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, dto.MapToStocktakeResponse(s))
+	}
+}
+
+func (h *StocktakeHandler) Approve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid stocktake id"})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	s, err := h.approveUC.Execute(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(stocktakeErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToStocktakeResponse(s))
+}
+
+// stocktakeErrorStatus maps stocktake domain errors to HTTP status codes
+func stocktakeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, stocktake.ErrStocktakeNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, stocktake.ErrSelfApproval):
+		return http.StatusForbidden
+	case errors.Is(err, stocktake.ErrInvalidTransition),
+		errors.Is(err, stocktake.ErrNotOpen):
+		return http.StatusConflict
+	case errors.Is(err, stocktake.ErrItemNotInCount),
+		errors.Is(err, stocktake.ErrNegativeCount),
+		errors.Is(err, stocktake.ErrNothingToCount),
+		errors.Is(err, stocktake.ErrNothingCounted),
+		errors.Is(err, inventory.ErrItemNotFound),
+		errors.Is(err, inventory.ErrInsufficientStock),
+		errors.Is(err, inventory.ErrInvalidAdjustmentReason),
+		errors.Is(err, inventory.ErrItemWithoutUnit),
+		errors.Is(err, inventory.ErrUnitNotFound),
+		errors.Is(err, inventory.ErrIncompatibleUnits):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,309 @@
+// Package handlers contains HTTP handlers for supplier endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	supplierUC "github.com/james-wukong/orders-api/internal/usecase/supplier"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SupplierHandler struct {
+	auth                 gin.HandlerFunc
+	createSupplierUC     *supplierUC.CreateSupplierUseCase
+	getSupplierUC        *supplierUC.GetSupplierUseCase
+	listSuppliersUC      *supplierUC.ListSuppliersUseCase
+	updateSupplierUC     *supplierUC.UpdateSupplierUseCase
+	deactivateSupplierUC *supplierUC.DeactivateSupplierUseCase
+	setPricesUC          *supplierUC.SetPricesUseCase
+	listPricesUC         *supplierUC.ListPricesUseCase
+	deletePriceUC        *supplierUC.DeletePriceUseCase
+	itemPricesUC         *supplierUC.ListItemPricesUseCase
+	priceHistoryUC       *supplierUC.ListPriceHistoryUseCase
+	recommendUC          *supplierUC.RecommendSupplierUseCase
+}
+
+func NewSupplierHandler(
+	auth gin.HandlerFunc,
+	c *supplierUC.CreateSupplierUseCase,
+	g *supplierUC.GetSupplierUseCase,
+	l *supplierUC.ListSuppliersUseCase,
+	u *supplierUC.UpdateSupplierUseCase,
+	d *supplierUC.DeactivateSupplierUseCase,
+	sp *supplierUC.SetPricesUseCase,
+	lp *supplierUC.ListPricesUseCase,
+	dp *supplierUC.DeletePriceUseCase,
+	ip *supplierUC.ListItemPricesUseCase,
+	ph *supplierUC.ListPriceHistoryUseCase,
+	r *supplierUC.RecommendSupplierUseCase,
+) *SupplierHandler {
+	return &SupplierHandler{
+		auth:                 auth,
+		createSupplierUC:     c,
+		getSupplierUC:        g,
+		listSuppliersUC:      l,
+		updateSupplierUC:     u,
+		deactivateSupplierUC: d,
+		setPricesUC:          sp,
+		listPricesUC:         lp,
+		deletePriceUC:        dp,
+		itemPricesUC:         ip,
+		priceHistoryUC:       ph,
+		recommendUC:          r,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *SupplierHandler) Register(v1 *gin.RouterGroup) {
+	managers := middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String())
+	supplierGroup := v1.Group("/suppliers", h.auth, managers)
+	{
+		supplierGroup.GET("", h.List)
+		supplierGroup.POST("", h.Create)
+		supplierGroup.POST("/recommendations", h.Recommend)
+		supplierGroup.GET("/:id", h.Get)
+		supplierGroup.PUT("/:id", h.Update)
+		supplierGroup.DELETE("/:id", h.Deactivate)
+		supplierGroup.GET("/:id/prices", h.ListPrices)
+		supplierGroup.PUT("/:id/prices", h.SetPrices)
+		supplierGroup.DELETE("/:id/prices/:item_id", h.DeletePrice)
+	}
+	itemGroup := v1.Group("/inventory/items", h.auth, managers)
+	{
+		itemGroup.GET("/:id/supplier-prices", h.ItemPrices)
+		itemGroup.GET("/:id/price-history", h.PriceHistory)
+	}
+}
+
+func (h *SupplierHandler) Create(c *gin.Context) {
+	var req dto.CreateSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	s, err := h.createSupplierUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToSupplierResponse(s))
+}
+
+// List returns active suppliers by name; include_inactive=true adds the rest.
+func (h *SupplierHandler) List(c *gin.Context) {
+	filter := supplier.Filter{
+		Search:          c.Query("search"),
+		IncludeInactive: c.Query("include_inactive") == "true",
+	}
+
+	suppliers, err := h.listSuppliersUC.Execute(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.SupplierResponse, 0, len(suppliers))
+	for _, s := range suppliers {
+		res = append(res, dto.MapToSupplierResponse(s))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *SupplierHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+		return
+	}
+
+	s, err := h.getSupplierUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToSupplierResponse(s))
+}
+
+func (h *SupplierHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+		return
+	}
+	var req dto.UpdateSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	s, err := h.updateSupplierUC.Execute(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToSupplierResponse(s))
+}
+
+func (h *SupplierHandler) Deactivate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+		return
+	}
+
+	if err := h.deactivateSupplierUC.Execute(c.Request.Context(), id); err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SupplierHandler) ListPrices(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+		return
+	}
+
+	prices, err := h.listPricesUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToSupplierPrices(prices))
+}
+
+// SetPrices adds or changes prices and returns the whole price list.
+func (h *SupplierHandler) SetPrices(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+		return
+	}
+	var req dto.SetSupplierPricesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	prices, err := h.setPricesUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToSupplierPrices(prices))
+}
+
+func (h *SupplierHandler) DeletePrice(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier id"})
+		return
+	}
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid item id"})
+		return
+	}
+
+	if err := h.deletePriceUC.Execute(c.Request.Context(), id, itemID); err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ItemPrices compares what active suppliers charge for an item.
+func (h *SupplierHandler) ItemPrices(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid item id"})
+		return
+	}
+
+	prices, err := h.itemPricesUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToSupplierPrices(prices))
+}
+
+// PriceHistory returns the item's price history, optionally for one
+// supplier_id.
+func (h *SupplierHandler) PriceHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid item id"})
+		return
+	}
+	var supplierID *uuid.UUID
+	if s := c.Query("supplier_id"); s != "" {
+		parsed, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid supplier_id"})
+			return
+		}
+		supplierID = &parsed
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	changes, err := h.priceHistoryUC.Execute(c.Request.Context(), id, supplierID, limit)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.SupplierPriceChangeResponse, 0, len(changes))
+	for _, ch := range changes {
+		res = append(res, dto.MapToSupplierPriceChangeResponse(ch))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *SupplierHandler) Recommend(c *gin.Context) {
+	var req dto.RecommendSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rec, err := h.recommendUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(supplierErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToSupplierRecommendationResponse(rec))
+}
+
+func mapToSupplierPrices(prices []*supplier.Price) []dto.SupplierPriceResponse {
+	res := make([]dto.SupplierPriceResponse, 0, len(prices))
+	for _, p := range prices {
+		res = append(res, dto.MapToSupplierPriceResponse(p))
+	}
+	return res
+}
+
+// supplierErrorStatus maps supplier domain errors to HTTP status codes
+func supplierErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, supplier.ErrSupplierNotFound),
+		errors.Is(err, supplier.ErrPriceNotFound),
+		errors.Is(err, inventory.ErrItemNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, supplier.ErrDuplicateBasketItem),
+		errors.Is(err, inventory.ErrNegativeCost):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
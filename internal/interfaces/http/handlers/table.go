@@ -0,0 +1,375 @@
+// Package handlers contains HTTP handlers for dine-in table and tab endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	orderUC "github.com/james-wukong/orders-api/internal/usecase/order"
+	tableUC "github.com/james-wukong/orders-api/internal/usecase/table"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TableHandler struct {
+	auth          gin.HandlerFunc
+	guest         gin.HandlerFunc
+	createTableUC *tableUC.CreateTableUseCase
+	listTablesUC  *tableUC.ListTablesUseCase
+	updateTableUC *tableUC.UpdateTableUseCase
+	getQRUC       *tableUC.GetTableQRUseCase
+	rotateTokenUC *tableUC.RotateTableTokenUseCase
+	scanTableUC   *tableUC.ScanTableUseCase
+	getTabUC      *tableUC.GetTabUseCase
+	listTabsUC    *tableUC.ListTabsUseCase
+	moveTabUC     *tableUC.MoveTabUseCase
+	closeTabUC    *tableUC.CloseTabUseCase
+	placeOrderUC  *orderUC.PlaceTableOrderUseCase
+}
+
+func NewTableHandler(
+	auth gin.HandlerFunc,
+	guest gin.HandlerFunc,
+	ct *tableUC.CreateTableUseCase,
+	lt *tableUC.ListTablesUseCase,
+	ut *tableUC.UpdateTableUseCase,
+	qr *tableUC.GetTableQRUseCase,
+	rt *tableUC.RotateTableTokenUseCase,
+	st *tableUC.ScanTableUseCase,
+	gt *tableUC.GetTabUseCase,
+	ls *tableUC.ListTabsUseCase,
+	mt *tableUC.MoveTabUseCase,
+	cl *tableUC.CloseTabUseCase,
+	po *orderUC.PlaceTableOrderUseCase,
+) *TableHandler {
+	return &TableHandler{
+		auth:          auth,
+		guest:         guest,
+		createTableUC: ct,
+		listTablesUC:  lt,
+		updateTableUC: ut,
+		getQRUC:       qr,
+		rotateTokenUC: rt,
+		scanTableUC:   st,
+		getTabUC:      gt,
+		listTabsUC:    ls,
+		moveTabUC:     mt,
+		closeTabUC:    cl,
+		placeOrderUC:  po,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Guests scan a table's
+// QR code with POST /tables/scan, then view and order on the tab with the
+// returned token in the X-Table-Session header. Their orders reach the
+// kitchen through the usual order status workflow.
+func (h *TableHandler) Register(v1 *gin.RouterGroup) {
+	staffRoles := middleware.RequireRoles(user.RoleAdmin.String(), user.RoleKitchen.String())
+
+	restaurantGroup := v1.Group("/restaurants/:id", h.auth)
+	{
+		restaurantGroup.POST("/tables", middleware.RequireRoles(user.RoleAdmin.String()), h.Create)
+		restaurantGroup.GET("/tables", staffRoles, h.List)
+		restaurantGroup.GET("/tabs", staffRoles, h.ListTabs)
+	}
+	tableGroup := v1.Group("/tables")
+	{
+		tableGroup.POST("/scan", h.Scan)
+		admin := tableGroup.Group("", h.auth, middleware.RequireRoles(user.RoleAdmin.String()))
+		admin.PATCH("/:id", h.Update)
+		admin.GET("/:id/qr", h.QR)
+		admin.POST("/:id/rotate-token", h.RotateToken)
+	}
+	tabGroup := v1.Group("/tabs", h.auth, staffRoles)
+	{
+		tabGroup.GET("/:id", h.GetTab)
+		tabGroup.POST("/:id/move", h.MoveTab)
+		tabGroup.POST("/:id/close", h.CloseTab)
+	}
+	guestGroup := v1.Group("/tab", h.guest)
+	{
+		guestGroup.GET("", h.GuestTab)
+		guestGroup.POST("/orders", h.PlaceOrder)
+	}
+}
+
+func (h *TableHandler) Create(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	var req dto.CreateTableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	t, err := h.createTableUC.Execute(c.Request.Context(), restaurantID, req)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToTableResponse(t))
+}
+
+func (h *TableHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+
+	tables, err := h.listTablesUC.Execute(c.Request.Context(), restaurantID)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.TableResponse, 0, len(tables))
+	for _, t := range tables {
+		res = append(res, dto.MapToTableResponse(t))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *TableHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid table id"})
+		return
+	}
+	var req dto.UpdateTableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	t, err := h.updateTableUC.Execute(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTableResponse(t))
+}
+
+// QR returns the token and URL to print on the table's QR code
+func (h *TableHandler) QR(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid table id"})
+		return
+	}
+
+	qr, err := h.getQRUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToTableQR(qr))
+}
+
+// RotateToken retires the table's printed QR code and returns the new one
+func (h *TableHandler) RotateToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid table id"})
+		return
+	}
+
+	qr, err := h.rotateTokenUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToTableQR(qr))
+}
+
+// Scan opens or joins the tab of the scanned table. No account is needed.
+func (h *TableHandler) Scan(c *gin.Context) {
+	var req dto.ScanTableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tab, token, err := h.scanTableUC.Execute(c.Request.Context(), req.Token, req.Guests)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ScanTableResponse{
+		SessionToken: token,
+		Tab:          dto.MapToTabResponse(tab),
+	})
+}
+
+func (h *TableHandler) GuestTab(c *gin.Context) {
+	sessionID, _ := middleware.CurrentTableSessionID(c)
+
+	tab, err := h.getTabUC.Execute(c.Request.Context(), sessionID)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTabResponse(tab))
+}
+
+// PlaceOrder adds a guest's order to the tab; it is paid when the tab is
+// closed
+func (h *TableHandler) PlaceOrder(c *gin.Context) {
+	sessionID, _ := middleware.CurrentTableSessionID(c)
+	var req dto.TableOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	o, err := h.placeOrderUC.Execute(c.Request.Context(), sessionID, req)
+	if err != nil {
+		body := gin.H{"error": err.Error()}
+		if code := orderErrorCode(err); code != "" {
+			body["code"] = code
+		}
+		c.JSON(tableErrorStatus(err), body)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToOrderResponse(o))
+}
+
+// ListTabs lists the restaurant's tabs, newest first. status may be open
+// or closed.
+func (h *TableHandler) ListTabs(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	status := table.SessionStatus(c.Query("status"))
+	switch status {
+	case "", table.SessionOpen, table.SessionClosed:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be open or closed"})
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	sessions, err := h.listTabsUC.Execute(c.Request.Context(), restaurantID, status, limit, offset)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.TableSessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, dto.MapToTableSessionResponse(s))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *TableHandler) GetTab(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tab id"})
+		return
+	}
+
+	tab, err := h.getTabUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTabResponse(tab))
+}
+
+// MoveTab moves the guests and their tab to another, free table
+func (h *TableHandler) MoveTab(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tab id"})
+		return
+	}
+	var req dto.MoveTabRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	tableID, err := uuid.Parse(req.TableID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid table id"})
+		return
+	}
+
+	tab, err := h.moveTabUC.Execute(c.Request.Context(), id, tableID)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTabResponse(tab))
+}
+
+// CloseTab settles the tab and frees the table
+func (h *TableHandler) CloseTab(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tab id"})
+		return
+	}
+	var req dto.CloseTabRequest
+	// The body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	tab, err := h.closeTabUC.Execute(c.Request.Context(), id, order.PaymentMethod(req.PaymentMethod), userID)
+	if err != nil {
+		c.JSON(tableErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTabResponse(tab))
+}
+
+func mapToTableQR(qr *tableUC.QR) dto.TableQRResponse {
+	return dto.TableQRResponse{
+		TableID: qr.Table.ID.String(),
+		Number:  qr.Table.Number,
+		Token:   qr.Token,
+		URL:     qr.URL,
+	}
+}
+
+// tableErrorStatus maps table errors to HTTP status codes, and errors
+// raised while ordering the way checkout does
+func tableErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, table.ErrTableNotFound),
+		errors.Is(err, table.ErrSessionNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, table.ErrInvalidToken):
+		return http.StatusUnauthorized
+	case errors.Is(err, table.ErrTableNumberTaken),
+		errors.Is(err, table.ErrTableInactive),
+		errors.Is(err, table.ErrTableOccupied),
+		errors.Is(err, table.ErrSessionClosed),
+		errors.Is(err, table.ErrOrdersInProgress):
+		return http.StatusConflict
+	case errors.Is(err, table.ErrInvalidTable),
+		errors.Is(err, table.ErrOverCapacity),
+		errors.Is(err, table.ErrPaymentMethodRequired):
+		return http.StatusUnprocessableEntity
+	default:
+		return orderErrorStatus(err)
+	}
+}
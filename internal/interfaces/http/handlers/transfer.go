@@ -0,0 +1,228 @@
+// Package handlers contains HTTP handlers for stock transfer endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	transferUC "github.com/james-wukong/orders-api/internal/usecase/transfer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TransferHandler struct {
+	auth              gin.HandlerFunc
+	requestTransferUC *transferUC.RequestTransferUseCase
+	getTransferUC     *transferUC.GetTransferUseCase
+	listTransfersUC   *transferUC.ListTransfersUseCase
+	shipTransferUC    *transferUC.ShipTransferUseCase
+	receiveTransferUC *transferUC.ReceiveTransferUseCase
+	cancelTransferUC  *transferUC.CancelTransferUseCase
+}
+
+func NewTransferHandler(
+	auth gin.HandlerFunc,
+	r *transferUC.RequestTransferUseCase,
+	g *transferUC.GetTransferUseCase,
+	l *transferUC.ListTransfersUseCase,
+	s *transferUC.ShipTransferUseCase,
+	rc *transferUC.ReceiveTransferUseCase,
+	c *transferUC.CancelTransferUseCase,
+) *TransferHandler {
+	return &TransferHandler{
+		auth:              auth,
+		requestTransferUC: r,
+		getTransferUC:     g,
+		listTransfersUC:   l,
+		shipTransferUC:    s,
+		receiveTransferUC: rc,
+		cancelTransferUC:  c,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *TransferHandler) Register(v1 *gin.RouterGroup) {
+	transferGroup := v1.Group("/inventory/transfers", h.auth,
+		middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String()),
+	)
+	{
+		transferGroup.GET("", h.List)
+		transferGroup.POST("", h.Request)
+		transferGroup.GET("/:id", h.Get)
+		transferGroup.POST("/:id/ship", h.Ship)
+		transferGroup.POST("/:id/receive", h.Receive)
+		transferGroup.POST("/:id/cancel", h.Cancel)
+	}
+}
+
+func (h *TransferHandler) Request(c *gin.Context) {
+	var req dto.RequestTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	t, err := h.requestTransferUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(transferErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToTransferResponse(t))
+}
+
+// List returns the restaurant's transfers; direction is outgoing, incoming
+// or, by default, both.
+func (h *TransferHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	filter := transfer.Filter{
+		Status:    transfer.Status(c.Query("status")),
+		Direction: transfer.Direction(c.Query("direction")),
+	}
+	switch filter.Direction {
+	case "", transfer.DirectionOutgoing, transfer.DirectionIncoming:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "direction must be outgoing or incoming"})
+		return
+	}
+
+	transfers, err := h.listTransfersUC.Execute(c.Request.Context(), restaurantID, filter)
+	if err != nil {
+		c.JSON(transferErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.TransferResponse, 0, len(transfers))
+	for _, t := range transfers {
+		res = append(res, dto.MapToTransferResponse(t))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *TransferHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transfer id"})
+		return
+	}
+
+	t, err := h.getTransferUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(transferErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTransferResponse(t))
+}
+
+func (h *TransferHandler) Ship(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transfer id"})
+		return
+	}
+	var req dto.ShipTransferRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	t, entries, err := h.shipTransferUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(transferErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToTransferMovement(t, entries))
+}
+
+func (h *TransferHandler) Receive(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transfer id"})
+		return
+	}
+	var req dto.ReceiveTransferRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	t, entries, err := h.receiveTransferUC.Execute(c.Request.Context(), userID, id, req)
+	if err != nil {
+		c.JSON(transferErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, mapToTransferMovement(t, entries))
+}
+
+func (h *TransferHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transfer id"})
+		return
+	}
+
+	t, err := h.cancelTransferUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(transferErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToTransferResponse(t))
+}
+
+func mapToTransferMovement(t *transfer.Transfer, entries []*inventory.Transaction) dto.TransferMovementResponse {
+	res := dto.TransferMovementResponse{
+		Transfer:     dto.MapToTransferResponse(t),
+		Transactions: make([]dto.InventoryTransactionResponse, 0, len(entries)),
+	}
+	for _, e := range entries {
+		res.Transactions = append(res.Transactions, dto.MapToInventoryTransactionResponse(e))
+	}
+	return res
+}
+
+// transferErrorStatus maps transfer domain errors to HTTP status codes
+func transferErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, transfer.ErrTransferNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound),
+		errors.Is(err, inventory.ErrItemNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, transfer.ErrInvalidTransition),
+		errors.Is(err, transfer.ErrNotRequested),
+		errors.Is(err, transfer.ErrNotShipped):
+		return http.StatusConflict
+	case errors.Is(err, transfer.ErrLineNotFound),
+		errors.Is(err, transfer.ErrSameRestaurant),
+		errors.Is(err, transfer.ErrEmptyTransfer),
+		errors.Is(err, transfer.ErrDuplicateLine),
+		errors.Is(err, transfer.ErrLineWrongVenue),
+		errors.Is(err, transfer.ErrItemWithoutSKU),
+		errors.Is(err, transfer.ErrNoMatchingItem),
+		errors.Is(err, transfer.ErrOverShipment),
+		errors.Is(err, transfer.ErrOverReceipt),
+		errors.Is(err, inventory.ErrItemInactive),
+		errors.Is(err, inventory.ErrNonPositiveQuantity),
+		errors.Is(err, inventory.ErrInsufficientStock),
+		errors.Is(err, inventory.ErrInvalidAdjustmentReason),
+		errors.Is(err, inventory.ErrIncompatibleUnits):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,102 @@
+// Package handlers contains HTTP handlers for inventory valuation endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	inventoryUC "github.com/james-wukong/orders-api/internal/usecase/inventory"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ValuationHandler struct {
+	auth        gin.HandlerFunc
+	valueUC     *inventoryUC.ValueInventoryUseCase
+	setMethodUC *inventoryUC.SetValuationMethodUseCase
+}
+
+func NewValuationHandler(
+	auth gin.HandlerFunc,
+	v *inventoryUC.ValueInventoryUseCase,
+	s *inventoryUC.SetValuationMethodUseCase,
+) *ValuationHandler {
+	return &ValuationHandler{
+		auth:        auth,
+		valueUC:     v,
+		setMethodUC: s,
+	}
+}
+
+// Register satisfies the RouterRegister interface
+func (h *ValuationHandler) Register(v1 *gin.RouterGroup) {
+	managers := middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String())
+	v1.GET("/restaurants/:id/inventory-valuation", h.auth, managers, h.Report)
+	v1.PUT("/restaurants/:id/valuation-method", h.auth, middleware.RequireRoles(user.RoleAdmin.String()), h.SetMethod)
+}
+
+// Report values the restaurant's inventory between the optional from and to
+// dates (YYYY-MM-DD, inclusive), the current month so far by default.
+// method overrides the restaurant's valuation method.
+func (h *ValuationHandler) Report(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	from, to, err := parseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	method := inventory.ValuationMethod(c.Query("method"))
+
+	report, err := h.valueUC.Execute(c.Request.Context(), restaurantID, method, from, to)
+	if err != nil {
+		c.JSON(valuationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToValuationResponse(report))
+}
+
+func (h *ValuationHandler) SetMethod(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	var req dto.SetValuationMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.setMethodUC.Execute(c.Request.Context(), restaurantID, inventory.ValuationMethod(req.Method))
+	if err != nil {
+		c.JSON(valuationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ValuationMethodResponse{
+		RestaurantID: res.ID.String(),
+		Method:       res.ValuationMethod,
+	})
+}
+
+// valuationErrorStatus maps valuation errors to HTTP status codes
+func valuationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, inventory.ErrInvalidValuationMethod),
+		errors.Is(err, inventory.ErrInvalidPeriod):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,190 @@
+// Package handlers contains HTTP handlers for wallet and gift card endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	walletUC "github.com/james-wukong/orders-api/internal/usecase/wallet"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WalletHandler struct {
+	auth            gin.HandlerFunc
+	getWalletUC     *walletUC.GetWalletUseCase
+	listPostingsUC  *walletUC.ListWalletPostingsUseCase
+	topUpUC         *walletUC.TopUpWalletUseCase
+	purchaseCardUC  *walletUC.PurchaseGiftCardUseCase
+	redeemCardUC    *walletUC.RedeemGiftCardUseCase
+	listGiftCardsUC *walletUC.ListGiftCardsUseCase
+}
+
+func NewWalletHandler(
+	auth gin.HandlerFunc,
+	gw *walletUC.GetWalletUseCase,
+	lp *walletUC.ListWalletPostingsUseCase,
+	tu *walletUC.TopUpWalletUseCase,
+	pc *walletUC.PurchaseGiftCardUseCase,
+	rc *walletUC.RedeemGiftCardUseCase,
+	lc *walletUC.ListGiftCardsUseCase,
+) *WalletHandler {
+	return &WalletHandler{
+		auth:            auth,
+		getWalletUC:     gw,
+		listPostingsUC:  lp,
+		topUpUC:         tu,
+		purchaseCardUC:  pc,
+		redeemCardUC:    rc,
+		listGiftCardsUC: lc,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Wallet funds are spent
+// at checkout with wallet_amount or payment_method wallet.
+func (h *WalletHandler) Register(v1 *gin.RouterGroup) {
+	walletGroup := v1.Group("/wallet", h.auth)
+	{
+		walletGroup.GET("", h.Wallet)
+		walletGroup.GET("/transactions", h.Postings)
+		walletGroup.POST("/top-ups", h.TopUp)
+	}
+	giftCardGroup := v1.Group("/gift-cards", h.auth)
+	{
+		giftCardGroup.GET("", h.ListGiftCards)
+		giftCardGroup.POST("", h.PurchaseGiftCard)
+		giftCardGroup.POST("/redeem", h.RedeemGiftCard)
+	}
+}
+
+func (h *WalletHandler) Wallet(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	acc, err := h.getWalletUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(walletErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToWalletResponse(acc))
+}
+
+// Postings returns the customer's wallet statement, newest first
+func (h *WalletHandler) Postings(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	postings, err := h.listPostingsUC.Execute(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		c.JSON(walletErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.WalletPostingResponse, 0, len(postings))
+	for _, p := range postings {
+		res = append(res, dto.MapToWalletPostingResponse(p))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *WalletHandler) TopUp(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+	var req dto.TopUpWalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	t, err := h.topUpUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(walletErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToTopUpResponse(t))
+}
+
+func (h *WalletHandler) ListGiftCards(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	cards, err := h.listGiftCardsUC.Execute(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		c.JSON(walletErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.GiftCardResponse, 0, len(cards))
+	for _, g := range cards {
+		res = append(res, dto.MapToGiftCardResponse(g, ""))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// PurchaseGiftCard returns the card's code; it is not shown again
+func (h *WalletHandler) PurchaseGiftCard(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+	var req dto.PurchaseGiftCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	card, code, err := h.purchaseCardUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		respondWalletError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToGiftCardResponse(card, code))
+}
+
+func (h *WalletHandler) RedeemGiftCard(c *gin.Context) {
+	userID, _ := middleware.CurrentUserID(c)
+	var req dto.RedeemGiftCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	card, balance, err := h.redeemCardUC.Execute(c.Request.Context(), userID, req.Code)
+	if err != nil {
+		c.JSON(walletErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.RedeemGiftCardResponse{
+		GiftCardID: card.ID.String(),
+		Amount:     card.Amount,
+		Balance:    balance,
+	})
+}
+
+// respondWalletError adds the machine-readable code to errors that have one
+func respondWalletError(c *gin.Context, err error) {
+	body := gin.H{"error": err.Error()}
+	if errors.Is(err, wallet.ErrInsufficientFunds) {
+		body["code"] = wallet.CodeInsufficientFunds
+	}
+	c.JSON(walletErrorStatus(err), body)
+}
+
+// walletErrorStatus maps wallet and payment errors to HTTP status codes
+func walletErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, wallet.ErrGiftCardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, wallet.ErrGiftCardRedeemed),
+		errors.Is(err, wallet.ErrInsufficientFunds):
+		return http.StatusConflict
+	case errors.Is(err, payment.ErrPaymentDeclined):
+		return http.StatusPaymentRequired
+	case errors.Is(err, wallet.ErrInvalidAmount),
+		errors.Is(err, wallet.ErrInvalidGiftCardAmount),
+		errors.Is(err, payment.ErrInvalidAmount):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,139 @@
+// Package handlers contains HTTP handlers for waste endpoints.
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/middleware"
+	inventoryUC "github.com/james-wukong/orders-api/internal/usecase/inventory"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WasteHandler struct {
+	auth          gin.HandlerFunc
+	logWasteUC    *inventoryUC.LogWasteUseCase
+	listWasteUC   *inventoryUC.ListWasteUseCase
+	wasteReportUC *inventoryUC.WasteReportUseCase
+}
+
+func NewWasteHandler(
+	auth gin.HandlerFunc,
+	lw *inventoryUC.LogWasteUseCase,
+	l *inventoryUC.ListWasteUseCase,
+	r *inventoryUC.WasteReportUseCase,
+) *WasteHandler {
+	return &WasteHandler{
+		auth:          auth,
+		logWasteUC:    lw,
+		listWasteUC:   l,
+		wasteReportUC: r,
+	}
+}
+
+// Register satisfies the RouterRegister interface. Cooks log waste; the
+// report is for managers.
+func (h *WasteHandler) Register(v1 *gin.RouterGroup) {
+	managers := middleware.RequireRoles(user.RoleInventoryManager.String(), user.RoleAdmin.String())
+	wasteGroup := v1.Group("/inventory/waste", h.auth,
+		middleware.RequireRoles(user.RoleKitchen.String(), user.RoleInventoryManager.String(), user.RoleAdmin.String()),
+	)
+	{
+		wasteGroup.POST("", h.Log)
+		wasteGroup.GET("", h.List)
+	}
+	v1.GET("/restaurants/:id/waste-report", h.auth, managers, h.Report)
+}
+
+func (h *WasteHandler) Log(c *gin.Context) {
+	var req dto.LogWasteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := middleware.CurrentUserID(c)
+
+	entry, entries, err := h.logWasteUC.Execute(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(wasteErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapToWasteEntryResponse(entry, entries))
+}
+
+// List returns waste logged between the optional from and to dates
+// (YYYY-MM-DD, inclusive), the last 7 days by default.
+func (h *WasteHandler) List(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_id query parameter is required"})
+		return
+	}
+	from, to, err := parseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entries, err := h.listWasteUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(wasteErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	res := make([]dto.WasteEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, dto.MapToWasteEntryResponse(e, nil))
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// Report breaks down waste cost between the optional from and to dates
+// (YYYY-MM-DD, inclusive), the last 30 days by default.
+func (h *WasteHandler) Report(c *gin.Context) {
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant id"})
+		return
+	}
+	from, to, err := parseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.wasteReportUC.Execute(c.Request.Context(), restaurantID, from, to)
+	if err != nil {
+		c.JSON(wasteErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapToWasteReportResponse(restaurantID.String(), report))
+}
+
+// wasteErrorStatus maps waste errors to HTTP status codes
+func wasteErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, inventory.ErrItemNotFound),
+		errors.Is(err, recipe.ErrRecipeNotFound),
+		errors.Is(err, restaurant.ErrRestaurantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, inventory.ErrInvalidWasteTarget):
+		return http.StatusBadRequest
+	case errors.Is(err, inventory.ErrInvalidWasteReason),
+		errors.Is(err, inventory.ErrUnitNotFound),
+		errors.Is(err, inventory.ErrIncompatibleUnits),
+		errors.Is(err, inventory.ErrItemWithoutUnit),
+		errors.Is(err, inventory.ErrNonPositiveQuantity),
+		errors.Is(err, inventory.ErrInsufficientStock),
+		errors.Is(err, inventory.ErrItemInactive):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,66 +1,83 @@
 package middleware
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/pkg/auth"
 )
 
-func JWTAuthMiddleware() gin.HandlerFunc {
+// Context keys set by JWTAuthMiddleware
+const (
+	CtxUserID    = "user_id"
+	CtxUserEmail = "user_email"
+	CtxUserRole  = "user_role"
+)
+
+func JWTAuthMiddleware(secret string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenString := c.GetHeader("Authorization")
 		if tokenString == "" {
-			// utils.ReturnErrorResponse(c, http.StatusUnauthorized, "Token Error", "Token Required")
-			c.Abort()
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token required"})
 			return
 		}
 
 		// Check Bearer scheme, tokenString := parts[1]
 		parts := strings.Split(tokenString, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			// utils.ReturnErrorResponse(c, http.StatusUnauthorized, "Token Error", "Invalid authorization format")
-			c.Abort()
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization format"})
 			return
 		}
-		// actualToken := parts[1]
 
 		// Parse and validate token
-		// claims, err := pkg.ParseToken(actualToken, &pkg.JWTAccessClaims{})
-		// if err != nil {
-		// 	utils.ReturnErrorResponse(c, http.StatusUnauthorized, "Token Error", "Invalid token claims")
-		// 	c.Abort()
-		// 	return
-		// }
-
-		// validate token
-		// if err = pkg.ValidateClaim(claims); err != nil {
-		// 	utils.ReturnErrorResponse(c, http.StatusUnauthorized, "Token Error", "Invalid token claims")
-		// 	c.Abort()
-		// 	return
-		// }
+		claims, err := auth.ParseToken(parts[1], secret)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		userID, err := uuid.Parse(claims.UserID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token claims"})
+			return
+		}
 
 		// Set user information in context
-		// if ac, ok := claims.(*pkg.JWTAccessClaims); ok {
-		// c.Set(constant.CtxUserID, ac.UserID)
-		// c.Set(constant.CtxUserEmail, ac.Email)
-		// c.Set(constant.CtxUsername, ac.Username)
-		// c.Set(constant.CtxJWTAccessToken, actualToken)
-		// decline if can't find they key in redis (expired or logout)
-		// var clientInfo string
-		// clientInfoTmp, exists := c.Get(constant.CtxDeviceInfo)
-		// clientInfo, ok := clientInfoTmp.(string)
-		// if !exists || !ok {
-		// 	clientInfo = utils.RedisJWTTokenKey(constant.UnknownIpStr, constant.UnknownStr)
-		// }
-		// query := redisCache.NewRedisQuerier(q)
-		// if _, err := query.GetUserToken(c.Request.Context(), utils.RedisJWTTokenKey(clientInfo, ac.UserID)); err != nil {
-		// 	q.Log.Warn().Msgf("can't find user: %v, with client info: %v", ac.UserID, clientInfo)
-		// 	utils.ReturnErrorResponse(c, http.StatusUnauthorized, "Token Error", "Token not found")
-		// 	c.Abort()
-		// 	return
-		// }
-		// }
+		c.Set(CtxUserID, userID)
+		c.Set(CtxUserEmail, claims.Email)
+		c.Set(CtxUserRole, claims.Role)
 
 		c.Next()
 	}
 }
+
+// RequireRoles aborts the request unless the authenticated user has one of the given roles.
+// It must be registered after JWTAuthMiddleware.
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := CurrentUserRole(c)
+		for _, r := range roles {
+			if r == role {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+	}
+}
+
+// CurrentUserID returns the authenticated user's ID, if any.
+func CurrentUserID(c *gin.Context) (uuid.UUID, bool) {
+	v, exists := c.Get(CtxUserID)
+	if !exists {
+		return uuid.Nil, false
+	}
+	id, ok := v.(uuid.UUID)
+	return id, ok
+}
+
+// CurrentUserRole returns the authenticated user's role, or an empty string.
+func CurrentUserRole(c *gin.Context) string {
+	return c.GetString(CtxUserRole)
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+)
+
+// CtxTableSessionID is set by TableSessionMiddleware
+const CtxTableSessionID = "table_session_id"
+
+// TableSessionMiddleware admits guests ordering at a table, who have no
+// account, by the session token they got when scanning its QR code.
+func TableSessionMiddleware(signer *table.Signer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader("X-Table-Session")
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "table session token required"})
+			return
+		}
+		sessionID, err := signer.ParseSessionToken(token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set(CtxTableSessionID, sessionID)
+		c.Next()
+	}
+}
+
+// CurrentTableSessionID returns the guest's table session ID, if any.
+func CurrentTableSessionID(c *gin.Context) (uuid.UUID, bool) {
+	v, exists := c.Get(CtxTableSessionID)
+	if !exists {
+		return uuid.Nil, false
+	}
+	id, ok := v.(uuid.UUID)
+	return id, ok
+}
@@ -0,0 +1,100 @@
+// Package auth provides helpers to issue and verify the HS256 JSON Web Tokens
+// used to authenticate API requests.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token expired")
+	ErrNoExpiry     = errors.New("token has no expiry")
+)
+
+// Claims is the payload carried by an access token. ExpiresAt is required.
+type Claims struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email,omitempty"`
+	Role      string `json:"role"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+var encoding = base64.RawURLEncoding
+
+// GenerateToken signs the claims with the given secret using HS256.
+func GenerateToken(claims Claims, secret string) (string, error) {
+	if claims.ExpiresAt == 0 {
+		return "", ErrNoExpiry
+	}
+	h, err := json.Marshal(header{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	p, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := encoding.EncodeToString(h) + "." + encoding.EncodeToString(p)
+	return unsigned + "." + sign(unsigned, secret), nil
+}
+
+// ParseToken verifies the signature and expiry of a token and returns its claims.
+// Tokens without an expiry are rejected.
+func ParseToken(token, secret string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil || h.Alg != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	expected := sign(parts[0]+"."+parts[1], secret)
+	if !hmac.Equal([]byte(expected), []byte(parts[2])) {
+		return nil, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.ExpiresAt == 0 {
+		return nil, ErrNoExpiry
+	}
+	if time.Now().Unix() > claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	return &claims, nil
+}
+
+func sign(unsigned, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return encoding.EncodeToString(mac.Sum(nil))
+}
+
+func decodeSegment(seg string, v any) error {
+	raw, err := encoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
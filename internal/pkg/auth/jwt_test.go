@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseToken(t *testing.T) {
+	const secret = "secret"
+	future := time.Now().Add(time.Hour).Unix()
+	past := time.Now().Add(-time.Hour).Unix()
+
+	// GenerateToken refuses claims without exp, so sign them by hand
+	signWithoutExpiry := func(c Claims) string {
+		raw := `{"alg":"HS256","typ":"JWT"}`
+		p := `{"user_id":"` + c.UserID + `","role":"` + c.Role + `"}`
+		unsigned := encoding.EncodeToString([]byte(raw)) + "." + encoding.EncodeToString([]byte(p))
+		return unsigned + "." + sign(unsigned, secret)
+	}
+	generate := func(c Claims, key string) string {
+		token, err := GenerateToken(c, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"valid", generate(Claims{UserID: "u1", Role: "admin", ExpiresAt: future}, secret), nil},
+		{"expired", generate(Claims{UserID: "u1", ExpiresAt: past}, secret), ErrTokenExpired},
+		{"no expiry", signWithoutExpiry(Claims{UserID: "u1", Role: "admin"}), ErrNoExpiry},
+		{"wrong secret", generate(Claims{UserID: "u1", ExpiresAt: future}, "other"), ErrInvalidToken},
+		{"malformed", "a.b", ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := ParseToken(tt.token, secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && claims.UserID != "u1" {
+				t.Errorf("UserID = %q, want u1", claims.UserID)
+			}
+		})
+	}
+}
+
+func TestGenerateTokenRequiresExpiry(t *testing.T) {
+	if _, err := GenerateToken(Claims{UserID: "u1"}, "secret"); !errors.Is(err, ErrNoExpiry) {
+		t.Fatalf("err = %v, want %v", err, ErrNoExpiry)
+	}
+}
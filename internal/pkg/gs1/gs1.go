@@ -0,0 +1,333 @@
+// Package gs1 reads what handheld scanners send for product barcodes: plain
+// EAN/UPC codes and GS1-128 element strings carrying a GTIN with its
+// batch/lot, expiry date and quantity.
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed         = errors.New("malformed GS1 barcode")
+	ErrUnknownIdentifier = errors.New("unsupported GS1 application identifier")
+	ErrInvalidCheckDigit = errors.New("GTIN check digit does not match")
+)
+
+// groupSeparator is what scanners send for FNC1 between variable-length
+// fields
+const groupSeparator = '\x1d'
+
+// Code is what a scan says about the product. Only Raw is always set; a
+// plain code that isn't a valid EAN/UPC is kept as is.
+type Code struct {
+	Raw string
+	// GTIN is the 14-digit trade item number, zero-padded from EAN-8,
+	// UPC-A and EAN-13
+	GTIN       string
+	SSCC       string
+	Batch      string
+	Serial     string
+	Expiry     *time.Time
+	BestBefore *time.Time
+	// Count is the number of units in the scanned pack (AI 30 or 37)
+	Count *float64
+	// NetWeight is the pack's net weight in kg (AI 310n)
+	NetWeight *float64
+}
+
+// Key is what identifies the product: the GTIN, or the raw code when it
+// has none.
+func (c Code) Key() string {
+	if c.GTIN != "" {
+		return c.GTIN
+	}
+	return c.Raw
+}
+
+// Candidates lists the forms the product's barcode may have been stored in:
+// the raw code and the GTIN as GTIN-14, EAN-13, UPC-A and EAN-8.
+func (c Code) Candidates() []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	add(c.Raw)
+	if c.GTIN == "" {
+		return out
+	}
+	add(c.GTIN)
+	for _, n := range []int{13, 12, 8} {
+		if strings.HasPrefix(c.GTIN, strings.Repeat("0", 14-n)) {
+			add(c.GTIN[14-n:])
+		}
+	}
+	return out
+}
+
+// Parse reads a scan. GS1-128 is recognised by its symbology identifier
+// ("]C1"), FNC1 separators, the bracketed human-readable form or, failing
+// those, a long numeric string starting with a GTIN or SSCC that parses.
+func Parse(raw string) (Code, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return Code{}, ErrMalformed
+	}
+
+	switch {
+	case strings.HasPrefix(raw, "("):
+		return parseBracketed(raw)
+	case strings.HasPrefix(raw, "]C1"):
+		return parseElements(raw, strings.TrimPrefix(raw, "]C1"))
+	case strings.HasPrefix(raw, "]"):
+		// Other symbologies (]E0 for EAN-13 and so on) carry a plain code
+		if len(raw) > 3 {
+			raw = raw[3:]
+		}
+	case strings.ContainsRune(raw, groupSeparator):
+		return parseElements(raw, strings.TrimPrefix(raw, string(groupSeparator)))
+	}
+
+	if len(raw) > 14 && (strings.HasPrefix(raw, "01") || strings.HasPrefix(raw, "02") || strings.HasPrefix(raw, "00")) {
+		if code, err := parseElements(raw, raw); err == nil {
+			return code, nil
+		}
+	}
+	code := Code{Raw: raw}
+	if gtin, ok := normalizeGTIN(raw); ok {
+		code.GTIN = gtin
+	}
+	return code, nil
+}
+
+// identifier describes an application identifier: how many digits it has
+// and its data length, or the maximum when the field is variable.
+type identifier struct {
+	digits   int
+	length   int
+	variable bool
+}
+
+// identifiers is keyed by the first two digits of an AI. Only the prefix
+// decides an AI's length, so unlisted AIs under a known prefix still parse.
+var identifiers = map[string]identifier{
+	"00": {2, 18, false},
+	"01": {2, 14, false},
+	"02": {2, 14, false},
+	"10": {2, 20, true},
+	"11": {2, 6, false},
+	"12": {2, 6, false},
+	"13": {2, 6, false},
+	"15": {2, 6, false},
+	"16": {2, 6, false},
+	"17": {2, 6, false},
+	"20": {2, 2, false},
+	"21": {2, 20, true},
+	"22": {2, 20, true},
+	"24": {3, 30, true},
+	"25": {3, 30, true},
+	"30": {2, 8, true},
+	"31": {4, 6, false},
+	"32": {4, 6, false},
+	"33": {4, 6, false},
+	"34": {4, 6, false},
+	"35": {4, 6, false},
+	"36": {4, 6, false},
+	"37": {2, 8, true},
+	"41": {3, 13, false},
+}
+
+func parseElements(raw, s string) (Code, error) {
+	code := Code{Raw: raw}
+	for len(s) > 0 {
+		if len(s) < 2 {
+			return Code{}, ErrMalformed
+		}
+		id, ok := identifiers[s[:2]]
+		if !ok {
+			return Code{}, fmt.Errorf("%w: %s", ErrUnknownIdentifier, s[:2])
+		}
+		if len(s) < id.digits {
+			return Code{}, ErrMalformed
+		}
+		ai, rest := s[:id.digits], s[id.digits:]
+
+		var value string
+		if id.variable {
+			end := strings.IndexRune(rest, groupSeparator)
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 || end > id.length {
+				return Code{}, fmt.Errorf("%w: field %s", ErrMalformed, ai)
+			}
+			value, rest = rest[:end], rest[end:]
+		} else {
+			if len(rest) < id.length {
+				return Code{}, fmt.Errorf("%w: field %s", ErrMalformed, ai)
+			}
+			value, rest = rest[:id.length], rest[id.length:]
+		}
+		// Some scanners send FNC1 after fixed-length fields too
+		s = strings.TrimPrefix(rest, string(groupSeparator))
+
+		if err := code.set(ai, value); err != nil {
+			return Code{}, err
+		}
+	}
+	if code.GTIN == "" && code.SSCC == "" {
+		return Code{}, fmt.Errorf("%w: no GTIN", ErrMalformed)
+	}
+	return code, nil
+}
+
+func parseBracketed(raw string) (Code, error) {
+	code := Code{Raw: raw}
+	s := raw
+	for len(s) > 0 {
+		if s[0] != '(' {
+			return Code{}, ErrMalformed
+		}
+		closing := strings.IndexByte(s, ')')
+		if closing < 0 {
+			return Code{}, ErrMalformed
+		}
+		ai := s[1:closing]
+		s = s[closing+1:]
+		end := strings.IndexByte(s, '(')
+		if end < 0 {
+			end = len(s)
+		}
+		value := s[:end]
+		s = s[end:]
+
+		if len(ai) < 2 {
+			return Code{}, ErrMalformed
+		}
+		id, ok := identifiers[ai[:2]]
+		if !ok || len(ai) != id.digits {
+			return Code{}, fmt.Errorf("%w: %s", ErrUnknownIdentifier, ai)
+		}
+		if value == "" || len(value) > id.length || (!id.variable && len(value) != id.length) {
+			return Code{}, fmt.Errorf("%w: field %s", ErrMalformed, ai)
+		}
+		if err := code.set(ai, value); err != nil {
+			return Code{}, err
+		}
+	}
+	if code.GTIN == "" && code.SSCC == "" {
+		return Code{}, fmt.Errorf("%w: no GTIN", ErrMalformed)
+	}
+	return code, nil
+}
+
+// set records the fields a kitchen cares about; the rest are skipped
+func (c *Code) set(ai, value string) error {
+	var err error
+	switch {
+	case ai == "00":
+		c.SSCC = value
+	case ai == "01", ai == "02":
+		if !checkDigit(value) {
+			return ErrInvalidCheckDigit
+		}
+		c.GTIN = value
+	case ai == "10":
+		c.Batch = value
+	case ai == "21":
+		c.Serial = value
+	case ai == "15":
+		c.BestBefore, err = parseDate(value)
+	case ai == "17":
+		c.Expiry, err = parseDate(value)
+	case ai == "30", ai == "37":
+		var n int
+		if n, err = strconv.Atoi(value); err == nil {
+			count := float64(n)
+			c.Count = &count
+		}
+	case strings.HasPrefix(ai, "310"):
+		var n int
+		if n, err = strconv.Atoi(value); err == nil {
+			weight := float64(n) / math.Pow10(int(ai[3]-'0'))
+			c.NetWeight = &weight
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w: field %s", ErrMalformed, ai)
+	}
+	return nil
+}
+
+// parseDate reads YYMMDD. Day 00 means the last day of the month, and the
+// century is the one that puts the year within 50 years of today.
+func parseDate(value string) (*time.Time, error) {
+	yy, err1 := strconv.Atoi(value[0:2])
+	mm, err2 := strconv.Atoi(value[2:4])
+	dd, err3 := strconv.Atoi(value[4:6])
+	if err1 != nil || err2 != nil || err3 != nil || mm < 1 || mm > 12 || dd > 31 {
+		return nil, ErrMalformed
+	}
+	current := time.Now().Year()
+	year := current - current%100 + yy
+	switch diff := yy - current%100; {
+	case diff >= 51:
+		year -= 100
+	case diff <= -50:
+		year += 100
+	}
+	var d time.Time
+	if dd == 0 {
+		d = time.Date(year, time.Month(mm)+1, 0, 0, 0, 0, 0, time.UTC)
+	} else {
+		d = time.Date(year, time.Month(mm), dd, 0, 0, 0, 0, time.UTC)
+		if d.Day() != dd {
+			return nil, ErrMalformed
+		}
+	}
+	return &d, nil
+}
+
+// normalizeGTIN pads an EAN-8, UPC-A, EAN-13 or GTIN-14 to 14 digits if its
+// check digit is right
+func normalizeGTIN(s string) (string, bool) {
+	switch len(s) {
+	case 8, 12, 13, 14:
+	default:
+		return "", false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+	gtin := strings.Repeat("0", 14-len(s)) + s
+	return gtin, checkDigit(gtin)
+}
+
+// checkDigit validates the mod-10 check digit of a GTIN-14
+func checkDigit(gtin string) bool {
+	if len(gtin) != 14 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 13; i++ {
+		d := int(gtin[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return int(gtin[13]-'0') == (10-sum%10)%10
+}
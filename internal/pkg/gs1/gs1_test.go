@@ -0,0 +1,162 @@
+package gs1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func TestParse(t *testing.T) {
+	const gtin = "04006381333931"
+
+	tests := []struct {
+		name string
+		raw  string
+		// want.Raw defaults to raw
+		want    Code
+		wantErr error
+	}{
+		{
+			name: "symbology identifier",
+			raw:  "]C101" + gtin + "17270131" + "10LOT42",
+			want: Code{GTIN: gtin, Expiry: date(2027, time.January, 31), Batch: "LOT42"},
+		},
+		{
+			name: "FNC1 after a fixed-length field",
+			raw:  "01" + gtin + "\x1d" + "10LOT42" + "\x1d" + "3103001250",
+			want: Code{GTIN: gtin, Batch: "LOT42", NetWeight: float(1.25)},
+		},
+		{
+			name: "leading FNC1 and variable-length count",
+			raw:  "\x1d" + "01" + gtin + "3712" + "\x1d" + "21SN-9",
+			want: Code{GTIN: gtin, Count: float(12), Serial: "SN-9"},
+		},
+		{
+			name: "bracketed with day 00 meaning end of month",
+			raw:  "(01)" + gtin + "(15)270200(10)LOT42",
+			want: Code{GTIN: gtin, BestBefore: date(2027, time.February, 28), Batch: "LOT42"},
+		},
+		{
+			name: "unmarked element string",
+			raw:  "01" + gtin + "10LOT42",
+			want: Code{GTIN: gtin, Batch: "LOT42"},
+		},
+		{
+			name: "SSCC only",
+			raw:  "(00)000123456789012345",
+			want: Code{SSCC: "000123456789012345"},
+		},
+		{
+			name: "EAN-13",
+			raw:  "4006381333931",
+			want: Code{GTIN: gtin},
+		},
+		{
+			name: "EAN-13 with symbology identifier",
+			raw:  "]E04006381333931",
+			want: Code{Raw: "4006381333931", GTIN: gtin},
+		},
+		{
+			name: "UPC-A is padded",
+			raw:  "036000291452",
+			want: Code{GTIN: "00036000291452"},
+		},
+		{
+			name: "EAN-8 is padded",
+			raw:  "96385074",
+			want: Code{GTIN: "00000096385074"},
+		},
+		{
+			name: "plain code with a wrong check digit has no GTIN",
+			raw:  "4006381333932",
+			want: Code{},
+		},
+		{
+			name: "internal code is kept as is",
+			raw:  " SKU-778 ",
+			want: Code{Raw: "SKU-778"},
+		},
+		{name: "empty", raw: "  ", wantErr: ErrMalformed},
+		{name: "wrong GTIN check digit", raw: "(01)04006381333932", wantErr: ErrInvalidCheckDigit},
+		{name: "short GTIN", raw: "(01)0400638133393", wantErr: ErrMalformed},
+		{name: "unknown identifier", raw: "]C199ABC", wantErr: ErrUnknownIdentifier},
+		{name: "one-digit bracketed identifier", raw: "(01)" + gtin + "(9)X", wantErr: ErrMalformed},
+		{name: "bracketed identifier of the wrong length", raw: "(011)" + gtin, wantErr: ErrUnknownIdentifier},
+		{name: "unclosed bracket", raw: "(01" + gtin, wantErr: ErrMalformed},
+		{name: "empty bracketed value", raw: "(01)" + gtin + "(10)", wantErr: ErrMalformed},
+		{name: "invalid month", raw: "]C101" + gtin + "17271301", wantErr: ErrMalformed},
+		{name: "invalid day", raw: "]C101" + gtin + "17270230", wantErr: ErrMalformed},
+		{name: "truncated fixed-length field", raw: "]C101" + gtin + "172701", wantErr: ErrMalformed},
+		{name: "variable field too long", raw: "]C101" + gtin + "10" + "ABCDEFGHIJKLMNOPQRSTU", wantErr: ErrMalformed},
+		{name: "no GTIN", raw: "]C110LOT42", wantErr: ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) err = %v, want %v", tt.raw, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			want := tt.want
+			if want.Raw == "" {
+				want.Raw = tt.raw
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.raw, got, want)
+			}
+		})
+	}
+}
+
+func TestCodeCandidates(t *testing.T) {
+	tests := []struct {
+		name string
+		code Code
+		want []string
+	}{
+		{
+			name: "EAN-13",
+			code: Code{Raw: "4006381333931", GTIN: "04006381333931"},
+			want: []string{"4006381333931", "04006381333931"},
+		},
+		{
+			name: "UPC-A",
+			code: Code{Raw: "036000291452", GTIN: "00036000291452"},
+			want: []string{"036000291452", "00036000291452", "0036000291452"},
+		},
+		{
+			name: "EAN-8",
+			code: Code{Raw: "96385074", GTIN: "00000096385074"},
+			want: []string{"96385074", "00000096385074", "0000096385074", "000096385074"},
+		},
+		{
+			name: "element string",
+			code: Code{Raw: "(01)04006381333931(10)LOT42", GTIN: "04006381333931", Batch: "LOT42"},
+			want: []string{"(01)04006381333931(10)LOT42", "04006381333931", "4006381333931"},
+		},
+		{
+			name: "no GTIN",
+			code: Code{Raw: "SKU-778"},
+			want: []string{"SKU-778"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.code.Candidates(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Candidates() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package money provides helpers for working with currency amounts stored as
+// DECIMAL(10, 2) columns.
+package money
+
+import "math"
+
+// Round rounds an amount to cents, half away from zero.
+func Round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// Sum adds the amounts and rounds the result to cents.
+func Sum(amounts ...float64) float64 {
+	var total float64
+	for _, a := range amounts {
+		total += a
+	}
+	return Round(total)
+}
@@ -0,0 +1,61 @@
+// Package address contains the use cases for a user's delivery address book.
+package address
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/address"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type CreateAddressUseCase struct {
+	repo     address.Repository
+	geocoder address.Geocoder
+}
+
+func NewCreateAddressUseCase(repo address.Repository, geocoder address.Geocoder) *CreateAddressUseCase {
+	return &CreateAddressUseCase{
+		repo:     repo,
+		geocoder: geocoder,
+	}
+}
+
+func (uc *CreateAddressUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.CreateAddressRequest) (*address.Address, error) {
+	// 1. Initialize Entity using the Factory
+	a := address.NewAddress(userID, input.StreetAddress, input.City, input.PostalCode)
+	a.Label = input.Label
+	a.Apartment = input.Apartment
+	a.State = input.State
+	a.Phone = input.Phone
+	a.IsDefault = input.IsDefault
+	a.DeliveryInstructions = input.DeliveryInstructions
+	if input.Country != "" {
+		a.Country = input.Country
+	}
+
+	// 2. Geocode. Delivery fees and zone checks need coordinates, so an
+	// address that can't be located is rejected up front.
+	coords, err := uc.geocoder.Geocode(ctx, a.Query())
+	if err != nil {
+		return nil, err
+	}
+	a.SetCoordinates(coords)
+
+	// 3. The first address always becomes the default
+	count, err := uc.repo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		a.IsDefault = true
+	}
+
+	// 4. Save to Repository
+	if err := uc.repo.Create(ctx, a); err != nil {
+		return nil, fmt.Errorf("failed to save address: %w", err)
+	}
+
+	return a, nil
+}
@@ -0,0 +1,24 @@
+package address
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/address"
+)
+
+type DeleteAddressUseCase struct {
+	repo address.Repository
+}
+
+func NewDeleteAddressUseCase(repo address.Repository) *DeleteAddressUseCase {
+	return &DeleteAddressUseCase{repo: repo}
+}
+
+func (uc *DeleteAddressUseCase) Execute(ctx context.Context, userID, id uuid.UUID) error {
+	a, err := NewGetAddressUseCase(uc.repo).Execute(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, a)
+}
@@ -0,0 +1,29 @@
+package address
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/address"
+)
+
+type GetAddressUseCase struct {
+	repo address.Repository
+}
+
+func NewGetAddressUseCase(repo address.Repository) *GetAddressUseCase {
+	return &GetAddressUseCase{repo: repo}
+}
+
+// Execute returns the address if it belongs to the user. Other users'
+// addresses are reported as not found so their IDs aren't disclosed.
+func (uc *GetAddressUseCase) Execute(ctx context.Context, userID, id uuid.UUID) (*address.Address, error) {
+	a, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil || a.UserID != userID {
+		return nil, address.ErrAddressNotFound
+	}
+	return a, nil
+}
@@ -0,0 +1,20 @@
+package address
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/address"
+)
+
+type ListAddressesUseCase struct {
+	repo address.Repository
+}
+
+func NewListAddressesUseCase(repo address.Repository) *ListAddressesUseCase {
+	return &ListAddressesUseCase{repo: repo}
+}
+
+func (uc *ListAddressesUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]*address.Address, error) {
+	return uc.repo.ListByUser(ctx, userID)
+}
@@ -0,0 +1,68 @@
+package address
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/address"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type UpdateAddressUseCase struct {
+	repo     address.Repository
+	geocoder address.Geocoder
+}
+
+func NewUpdateAddressUseCase(repo address.Repository, geocoder address.Geocoder) *UpdateAddressUseCase {
+	return &UpdateAddressUseCase{
+		repo:     repo,
+		geocoder: geocoder,
+	}
+}
+
+func (uc *UpdateAddressUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.UpdateAddressRequest,
+) (*address.Address, error) {
+	a, err := NewGetAddressUseCase(uc.repo).Execute(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Apply the fields that were sent, remembering whether the location moved
+	before := a.Query()
+	assign := func(dst *string, src *string) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	assign(&a.Label, input.Label)
+	assign(&a.StreetAddress, input.StreetAddress)
+	assign(&a.Apartment, input.Apartment)
+	assign(&a.City, input.City)
+	assign(&a.State, input.State)
+	assign(&a.PostalCode, input.PostalCode)
+	assign(&a.Country, input.Country)
+	assign(&a.Phone, input.Phone)
+	assign(&a.DeliveryInstructions, input.DeliveryInstructions)
+
+	// 2. Re-geocode only when the location changed or was never resolved
+	if a.Query() != before || !a.HasCoordinates() {
+		coords, err := uc.geocoder.Geocode(ctx, a.Query())
+		if err != nil {
+			return nil, err
+		}
+		a.SetCoordinates(coords)
+	}
+
+	// 3. The default can be moved to this address but not simply switched
+	// off; pick another address as default instead.
+	if input.IsDefault != nil && *input.IsDefault {
+		a.IsDefault = true
+	}
+
+	if err := uc.repo.Update(ctx, a); err != nil {
+		return nil, fmt.Errorf("failed to update address: %w", err)
+	}
+	return a, nil
+}
@@ -0,0 +1,65 @@
+// Package delivery contains the use cases for managing restaurant delivery zones.
+package delivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type CreateZoneUseCase struct {
+	repo        delivery.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreateZoneUseCase(repo delivery.Repository, restaurants restaurant.Repository) *CreateZoneUseCase {
+	return &CreateZoneUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateZoneUseCase) Execute(ctx context.Context, restaurantID uuid.UUID, input dto.CreateDeliveryZoneRequest) (*delivery.Zone, error) {
+	// 1. The restaurant must exist, and radius rings need its coordinates
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+
+	// 2. Initialize Entity using the Factory
+	var zone *delivery.Zone
+	switch delivery.ZoneType(input.ZoneType) {
+	case delivery.ZoneRadius:
+		if res.Latitude == 0 && res.Longitude == 0 {
+			return nil, delivery.ErrRestaurantNotLocated
+		}
+		zone, err = delivery.NewRadiusZone(res.ID, input.Name, input.MinRadiusKm, input.MaxRadiusKm)
+	case delivery.ZonePolygon:
+		zone, err = delivery.NewPolygonZone(res.ID, input.Name, input.Polygon)
+	default:
+		err = delivery.ErrInvalidZoneType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Map Basic Fields
+	zone.DeliveryFee = input.DeliveryFee
+	zone.MinimumOrder = input.MinimumOrder
+	zone.ExtraETAMinutes = input.ExtraETAMinutes
+	zone.Priority = input.Priority
+
+	// 4. Save to Repository
+	if err := uc.repo.Create(ctx, zone); err != nil {
+		return nil, fmt.Errorf("failed to save delivery zone: %w", err)
+	}
+
+	return zone, nil
+}
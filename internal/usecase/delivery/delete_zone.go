@@ -0,0 +1,27 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+)
+
+type DeleteZoneUseCase struct {
+	repo delivery.Repository
+}
+
+func NewDeleteZoneUseCase(repo delivery.Repository) *DeleteZoneUseCase {
+	return &DeleteZoneUseCase{repo: repo}
+}
+
+func (uc *DeleteZoneUseCase) Execute(ctx context.Context, restaurantID, zoneID uuid.UUID) error {
+	zone, err := uc.repo.GetByID(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+	if zone == nil || zone.RestaurantID != restaurantID {
+		return delivery.ErrZoneNotFound
+	}
+	return uc.repo.Delete(ctx, zoneID)
+}
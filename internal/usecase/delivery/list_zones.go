@@ -0,0 +1,20 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+)
+
+type ListZonesUseCase struct {
+	repo delivery.Repository
+}
+
+func NewListZonesUseCase(repo delivery.Repository) *ListZonesUseCase {
+	return &ListZonesUseCase{repo: repo}
+}
+
+func (uc *ListZonesUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) ([]*delivery.Zone, error) {
+	return uc.repo.ListByRestaurant(ctx, restaurantID)
+}
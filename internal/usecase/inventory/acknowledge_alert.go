@@ -0,0 +1,61 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// AcknowledgeAlertUseCase marks an open alert as seen by the user.
+type AcknowledgeAlertUseCase struct {
+	alerts     inventory.AlertRepository
+	transactor tx.Transactor
+}
+
+func NewAcknowledgeAlertUseCase(alerts inventory.AlertRepository, transactor tx.Transactor) *AcknowledgeAlertUseCase {
+	return &AcknowledgeAlertUseCase{
+		alerts:     alerts,
+		transactor: transactor,
+	}
+}
+
+func (uc *AcknowledgeAlertUseCase) Execute(ctx context.Context, userID, id uuid.UUID) (*inventory.Alert, error) {
+	return changeAlert(ctx, uc.alerts, uc.transactor, id, func(a *inventory.Alert) error {
+		return a.Acknowledge(userID, time.Now())
+	})
+}
+
+// changeAlert locks an alert, applies change and saves it
+func changeAlert(
+	ctx context.Context,
+	alerts inventory.AlertRepository,
+	transactor tx.Transactor,
+	id uuid.UUID,
+	change func(a *inventory.Alert) error,
+) (*inventory.Alert, error) {
+	var alert *inventory.Alert
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if alert, err = alerts.LockByID(ctx, id); err != nil {
+			return err
+		}
+		if alert == nil {
+			return inventory.ErrAlertNotFound
+		}
+		if err := change(alert); err != nil {
+			return err
+		}
+		if err := alerts.Update(ctx, alert); err != nil {
+			return fmt.Errorf("failed to update stock alert: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
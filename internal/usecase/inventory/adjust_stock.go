@@ -0,0 +1,86 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// AdjustStockUseCase corrects stock by a signed quantity and records both an
+// inventory adjustment and its ledger entry.
+type AdjustStockUseCase struct {
+	repo       inventory.Repository
+	units      inventory.UnitRepository
+	transactor tx.Transactor
+}
+
+func NewAdjustStockUseCase(
+	repo inventory.Repository,
+	units inventory.UnitRepository,
+	transactor tx.Transactor,
+) *AdjustStockUseCase {
+	return &AdjustStockUseCase{
+		repo:       repo,
+		units:      units,
+		transactor: transactor,
+	}
+}
+
+func (uc *AdjustStockUseCase) Execute(
+	ctx context.Context, userID, itemID uuid.UUID, input dto.AdjustStockRequest,
+) (*inventory.Item, *inventory.Transaction, error) {
+	var (
+		item  *inventory.Item
+		entry *inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if item, err = lockItem(ctx, uc.repo, itemID); err != nil {
+			return err
+		}
+		delta, err := toItemUnit(ctx, uc.units, item, input.Quantity, input.Unit)
+		if err != nil {
+			return err
+		}
+		adj, movement, err := item.Adjust(delta, inventory.AdjustmentReason(input.Reason))
+		if err != nil {
+			return err
+		}
+		if delta < 0 {
+			// Write-downs come out of the first-expiring batches; write-ups
+			// are untracked stock
+			batches, err := uc.repo.LockBatches(ctx, item.ID)
+			if err != nil {
+				return err
+			}
+			if err := saveBatches(ctx, uc.repo, inventory.TakeFEFO(batches, -delta)); err != nil {
+				return err
+			}
+		}
+		adj.AdjustedBy = &userID
+		adj.ReasonDetails = input.Details
+		if err := uc.repo.CreateAdjustment(ctx, adj); err != nil {
+			return fmt.Errorf("failed to save adjustment: %w", err)
+		}
+
+		entry = movement
+		entry.PerformedBy = &userID
+		entry.Reason = input.Reason
+		entry.ReferenceType = "adjustment"
+		entry.ReferenceID = &adj.ID
+		entry.Notes = joinNotes(input.Details, describeInput(input.Quantity, input.Unit))
+
+		if err := uc.repo.Update(ctx, item); err != nil {
+			return fmt.Errorf("failed to update stock: %w", err)
+		}
+		return uc.repo.CreateTransaction(ctx, entry)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, entry, nil
+}
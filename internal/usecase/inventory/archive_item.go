@@ -0,0 +1,34 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ArchiveItemUseCase deactivates an item instead of deleting it: its stock
+// ledger and recipes keep referring to it.
+type ArchiveItemUseCase struct {
+	repo inventory.Repository
+}
+
+func NewArchiveItemUseCase(repo inventory.Repository) *ArchiveItemUseCase {
+	return &ArchiveItemUseCase{repo: repo}
+}
+
+func (uc *ArchiveItemUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	item, err := NewGetItemUseCase(uc.repo).Execute(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !item.IsActive {
+		return nil
+	}
+	item.IsActive = false
+	if err := uc.repo.Update(ctx, item); err != nil {
+		return fmt.Errorf("failed to archive inventory item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// CheckExpiryUseCase raises expiring_soon and expired stock alerts for a
+// restaurant's batches. Each batch gets each alert once; expired alerts
+// suggest writing off what is left as waste. Batches another run is
+// checking are skipped, so replicas can run it concurrently.
+type CheckExpiryUseCase struct {
+	repo       inventory.Repository
+	transactor tx.Transactor
+}
+
+func NewCheckExpiryUseCase(repo inventory.Repository, transactor tx.Transactor) *CheckExpiryUseCase {
+	return &CheckExpiryUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *CheckExpiryUseCase) Execute(ctx context.Context, restaurantID uuid.UUID, now time.Time) ([]*inventory.Alert, error) {
+	var alerts []*inventory.Alert
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		batches, err := uc.repo.LockExpiringBatches(ctx, restaurantID, now)
+		if err != nil {
+			return err
+		}
+		for _, b := range batches {
+			alert := b.RaiseExpiryAlert(now)
+			if alert == nil {
+				continue
+			}
+			if err := uc.repo.UpdateBatch(ctx, b); err != nil {
+				return fmt.Errorf("failed to update batch: %w", err)
+			}
+			if err := uc.repo.CreateAlert(ctx, alert); err != nil {
+				return err
+			}
+			alerts = append(alerts, alert)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
@@ -0,0 +1,77 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// ConsumeStockUseCase records manual usage, e.g. staff meals or prep. Stock
+// comes out of the first-expiring batches first, one ledger entry per batch.
+type ConsumeStockUseCase struct {
+	repo       inventory.Repository
+	units      inventory.UnitRepository
+	transactor tx.Transactor
+}
+
+func NewConsumeStockUseCase(
+	repo inventory.Repository,
+	units inventory.UnitRepository,
+	transactor tx.Transactor,
+) *ConsumeStockUseCase {
+	return &ConsumeStockUseCase{
+		repo:       repo,
+		units:      units,
+		transactor: transactor,
+	}
+}
+
+func (uc *ConsumeStockUseCase) Execute(
+	ctx context.Context, userID, itemID uuid.UUID, input dto.ConsumeStockRequest,
+) (*inventory.Item, []*inventory.Transaction, error) {
+	var (
+		item    *inventory.Item
+		entries []*inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if item, err = lockItem(ctx, uc.repo, itemID); err != nil {
+			return err
+		}
+		qty, err := toItemUnit(ctx, uc.units, item, input.Quantity, input.Unit)
+		if err != nil {
+			return err
+		}
+		batches, err := uc.repo.LockBatches(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+		var draws []inventory.BatchDraw
+		if entries, draws, err = item.ConsumeFEFO(qty, batches); err != nil {
+			return err
+		}
+		if err := saveBatches(ctx, uc.repo, draws); err != nil {
+			return err
+		}
+		if err := uc.repo.Update(ctx, item); err != nil {
+			return fmt.Errorf("failed to update stock: %w", err)
+		}
+		for _, entry := range entries {
+			entry.PerformedBy = &userID
+			entry.Reason = input.Reason
+			entry.Notes = describeInput(input.Quantity, input.Unit)
+			if err := uc.repo.CreateTransaction(ctx, entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, entries, nil
+}
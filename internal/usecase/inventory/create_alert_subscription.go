@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// CreateAlertSubscriptionUseCase subscribes an email address or webhook URL
+// to a restaurant's new stock alerts on behalf of the user.
+type CreateAlertSubscriptionUseCase struct {
+	alerts      inventory.AlertRepository
+	restaurants restaurant.Repository
+}
+
+func NewCreateAlertSubscriptionUseCase(
+	alerts inventory.AlertRepository,
+	restaurants restaurant.Repository,
+) *CreateAlertSubscriptionUseCase {
+	return &CreateAlertSubscriptionUseCase{
+		alerts:      alerts,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateAlertSubscriptionUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.CreateAlertSubscriptionRequest,
+) (*inventory.AlertSubscription, error) {
+	restaurantID, err := uuid.Parse(input.RestaurantID)
+	if err != nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+
+	channel := inventory.SubscriptionChannel(input.Channel)
+	if !validTarget(channel, input.Target) {
+		return nil, inventory.ErrInvalidSubscriptionTarget
+	}
+	sub := inventory.NewAlertSubscription(restaurantID, userID, channel, input.Target)
+	if err := uc.alerts.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// validTarget checks the target is an address the channel can deliver to
+func validTarget(channel inventory.SubscriptionChannel, target string) bool {
+	switch channel {
+	case inventory.ChannelEmail:
+		addr, err := mail.ParseAddress(target)
+		return err == nil && addr.Address == target
+	case inventory.ChannelWebhook:
+		u, err := url.Parse(target)
+		return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+// Package inventory contains the use cases for managing inventory items and
+// moving stock.
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+type CreateItemUseCase struct {
+	repo        inventory.Repository
+	units       inventory.UnitRepository
+	restaurants restaurant.Repository
+}
+
+func NewCreateItemUseCase(
+	repo inventory.Repository,
+	units inventory.UnitRepository,
+	restaurants restaurant.Repository,
+) *CreateItemUseCase {
+	return &CreateItemUseCase{
+		repo:        repo,
+		units:       units,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateItemUseCase) Execute(ctx context.Context, input dto.CreateInventoryItemRequest) (*inventory.Item, error) {
+	// 1. Validate references
+	restaurantID, err := uuid.Parse(input.RestaurantID)
+	if err != nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	unit, err := findUnit(ctx, uc.units, input.Unit)
+	if err != nil {
+		return nil, err
+	}
+	if input.SKU != "" {
+		if err := checkSKU(ctx, uc.repo, res.ID, input.SKU, uuid.Nil); err != nil {
+			return nil, err
+		}
+	}
+	if input.Barcode != "" {
+		if err := checkBarcode(ctx, uc.repo, res.ID, input.Barcode, uuid.Nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Initialize Entity using the Factory
+	item := inventory.NewItem(res.ID, input.Name, unit)
+	if input.SKU != "" {
+		item.SKU = &input.SKU
+	}
+	item.Description = input.Description
+	item.CategoryID = parseOptionalID(input.CategoryID)
+	item.SupplierID = parseOptionalID(input.SupplierID)
+	item.MinimumStock = inventory.RoundQty(input.MinimumStock)
+	item.MaximumStock = input.MaximumStock
+	item.ReorderPoint = input.ReorderPoint
+	item.ReorderQuantity = input.ReorderQuantity
+	item.UnitCost = input.UnitCost
+	item.AverageCost = input.UnitCost
+	item.StorageLocation = input.StorageLocation
+	item.ShelfLifeDays = input.ShelfLifeDays
+	if input.ExpiryAlertDays != nil {
+		item.ExpiryAlertDays = *input.ExpiryAlertDays
+	}
+	item.IsPerishable = input.IsPerishable
+	item.RequiresRefrigeration = input.RequiresRefrigeration
+	item.Barcode = input.Barcode
+	item.ImageURL = input.ImageURL
+	item.Notes = input.Notes
+
+	// 3. Save to Repository
+	if err := uc.repo.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to save inventory item: %w", err)
+	}
+	return item, nil
+}
+
+// findUnit resolves a unit code sent by the client
+func findUnit(ctx context.Context, units inventory.UnitRepository, code string) (*inventory.Unit, error) {
+	unit, err := units.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if unit == nil {
+		return nil, fmt.Errorf("%w: %s", inventory.ErrUnitNotFound, code)
+	}
+	return unit, nil
+}
+
+// checkSKU rejects a SKU already used by another of the restaurant's items
+// than self
+func checkSKU(ctx context.Context, repo inventory.Repository, restaurantID uuid.UUID, sku string, self uuid.UUID) error {
+	existing, err := repo.GetBySKU(ctx, restaurantID, sku)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != self {
+		return inventory.ErrDuplicateSKU
+	}
+	return nil
+}
+
+// checkBarcode fails if another of the restaurant's items has the barcode in
+// any of its forms, so a scan never matches two items
+func checkBarcode(ctx context.Context, repo inventory.Repository, restaurantID uuid.UUID, barcode string, self uuid.UUID) error {
+	code, err := gs1.Parse(barcode)
+	if err != nil {
+		return err
+	}
+	candidates := code.Candidates()
+	existing, err := repo.GetByBarcode(ctx, restaurantID, candidates)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != self && slices.Contains(candidates, existing.Barcode) {
+		return inventory.ErrDuplicateBarcode
+	}
+	return nil
+}
+
+// parseOptionalID parses an id validated by the binding tags; empty means none
+func parseOptionalID(s string) *uuid.UUID {
+	if s == "" {
+		return nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
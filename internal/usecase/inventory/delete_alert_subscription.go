@@ -0,0 +1,29 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// DeleteAlertSubscriptionUseCase unsubscribes. Users can only remove their
+// own subscriptions; other users' look like they don't exist.
+type DeleteAlertSubscriptionUseCase struct {
+	alerts inventory.AlertRepository
+}
+
+func NewDeleteAlertSubscriptionUseCase(alerts inventory.AlertRepository) *DeleteAlertSubscriptionUseCase {
+	return &DeleteAlertSubscriptionUseCase{alerts: alerts}
+}
+
+func (uc *DeleteAlertSubscriptionUseCase) Execute(ctx context.Context, userID, id uuid.UUID) error {
+	sub, err := uc.alerts.GetSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub == nil || sub.UserID != userID {
+		return inventory.ErrSubscriptionNotFound
+	}
+	return uc.alerts.DeleteSubscription(ctx, id)
+}
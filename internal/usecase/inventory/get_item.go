@@ -0,0 +1,27 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+type GetItemUseCase struct {
+	repo inventory.Repository
+}
+
+func NewGetItemUseCase(repo inventory.Repository) *GetItemUseCase {
+	return &GetItemUseCase{repo: repo}
+}
+
+func (uc *GetItemUseCase) Execute(ctx context.Context, id uuid.UUID) (*inventory.Item, error) {
+	item, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, inventory.ErrItemNotFound
+	}
+	return item, nil
+}
@@ -0,0 +1,24 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ListAlertSubscriptionsUseCase returns the user's subscriptions to a
+// restaurant's stock alerts.
+type ListAlertSubscriptionsUseCase struct {
+	alerts inventory.AlertRepository
+}
+
+func NewListAlertSubscriptionsUseCase(alerts inventory.AlertRepository) *ListAlertSubscriptionsUseCase {
+	return &ListAlertSubscriptionsUseCase{alerts: alerts}
+}
+
+func (uc *ListAlertSubscriptionsUseCase) Execute(
+	ctx context.Context, userID, restaurantID uuid.UUID,
+) ([]*inventory.AlertSubscription, error) {
+	return uc.alerts.ListSubscriptions(ctx, restaurantID, userID)
+}
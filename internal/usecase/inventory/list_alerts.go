@@ -0,0 +1,26 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ListAlertsUseCase returns a restaurant's stock alerts, newest first.
+type ListAlertsUseCase struct {
+	alerts inventory.AlertRepository
+}
+
+func NewListAlertsUseCase(alerts inventory.AlertRepository) *ListAlertsUseCase {
+	return &ListAlertsUseCase{alerts: alerts}
+}
+
+func (uc *ListAlertsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter inventory.AlertFilter,
+) ([]*inventory.Alert, error) {
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 100
+	}
+	return uc.alerts.List(ctx, restaurantID, filter)
+}
@@ -0,0 +1,23 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ListExpiringUseCase returns the batches of a restaurant that have expired
+// or are within their item's expiry alert days, first-expiring first.
+type ListExpiringUseCase struct {
+	repo inventory.Repository
+}
+
+func NewListExpiringUseCase(repo inventory.Repository) *ListExpiringUseCase {
+	return &ListExpiringUseCase{repo: repo}
+}
+
+func (uc *ListExpiringUseCase) Execute(ctx context.Context, restaurantID uuid.UUID, on time.Time) ([]*inventory.Batch, error) {
+	return uc.repo.ListExpiringBatches(ctx, restaurantID, on)
+}
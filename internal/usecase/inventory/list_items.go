@@ -0,0 +1,22 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+type ListItemsUseCase struct {
+	repo inventory.Repository
+}
+
+func NewListItemsUseCase(repo inventory.Repository) *ListItemsUseCase {
+	return &ListItemsUseCase{repo: repo}
+}
+
+func (uc *ListItemsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter inventory.ItemFilter,
+) ([]*inventory.Item, error) {
+	return uc.repo.ListByRestaurant(ctx, restaurantID, filter)
+}
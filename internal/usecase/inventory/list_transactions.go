@@ -0,0 +1,27 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ListTransactionsUseCase returns the latest stock ledger entries of an item.
+type ListTransactionsUseCase struct {
+	repo inventory.Repository
+}
+
+func NewListTransactionsUseCase(repo inventory.Repository) *ListTransactionsUseCase {
+	return &ListTransactionsUseCase{repo: repo}
+}
+
+func (uc *ListTransactionsUseCase) Execute(ctx context.Context, itemID uuid.UUID, limit int) ([]*inventory.Transaction, error) {
+	if _, err := NewGetItemUseCase(uc.repo).Execute(ctx, itemID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return uc.repo.ListTransactions(ctx, itemID, limit)
+}
@@ -0,0 +1,19 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+type ListUnitsUseCase struct {
+	units inventory.UnitRepository
+}
+
+func NewListUnitsUseCase(units inventory.UnitRepository) *ListUnitsUseCase {
+	return &ListUnitsUseCase{units: units}
+}
+
+func (uc *ListUnitsUseCase) Execute(ctx context.Context) ([]*inventory.Unit, error) {
+	return uc.units.List(ctx)
+}
@@ -0,0 +1,31 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// ListWasteUseCase returns the waste logged at a restaurant, newest first.
+type ListWasteUseCase struct {
+	waste inventory.WasteRepository
+}
+
+func NewListWasteUseCase(waste inventory.WasteRepository) *ListWasteUseCase {
+	return &ListWasteUseCase{waste: waste}
+}
+
+// Execute covers [from, to); a zero range defaults to the last 7 days.
+func (uc *ListWasteUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) ([]*inventory.WasteEntry, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -7)
+	}
+	return uc.waste.List(ctx, restaurantID, from, to)
+}
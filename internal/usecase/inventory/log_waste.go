@@ -0,0 +1,162 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// LogWasteUseCase writes off stock that was thrown away: an inventory item
+// directly, or portions of a menu item through its recipe, e.g. a dropped
+// plate. Stock comes out of the first-expiring batches first.
+type LogWasteUseCase struct {
+	repo       inventory.Repository
+	waste      inventory.WasteRepository
+	units      inventory.UnitRepository
+	recipes    recipe.Repository
+	deductor   *inventory.Deductor
+	transactor tx.Transactor
+}
+
+func NewLogWasteUseCase(
+	repo inventory.Repository,
+	waste inventory.WasteRepository,
+	units inventory.UnitRepository,
+	recipes recipe.Repository,
+	deductor *inventory.Deductor,
+	transactor tx.Transactor,
+) *LogWasteUseCase {
+	return &LogWasteUseCase{
+		repo:       repo,
+		waste:      waste,
+		units:      units,
+		recipes:    recipes,
+		deductor:   deductor,
+		transactor: transactor,
+	}
+}
+
+func (uc *LogWasteUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.LogWasteRequest,
+) (*inventory.WasteEntry, []*inventory.Transaction, error) {
+	if (input.InventoryItemID == "") == (input.MenuItemID == "") {
+		return nil, nil, inventory.ErrInvalidWasteTarget
+	}
+
+	var (
+		entry   *inventory.WasteEntry
+		entries []*inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if input.MenuItemID != "" {
+			entry, entries, err = uc.wastePortions(ctx, userID, input)
+		} else {
+			entry, entries, err = uc.wasteItem(ctx, userID, input)
+		}
+		if err != nil {
+			return err
+		}
+		entry.Notes = input.Notes
+		if err := uc.waste.Create(ctx, entry); err != nil {
+			return fmt.Errorf("failed to save waste entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry, entries, nil
+}
+
+// wasteItem writes the quantity off one inventory item. Waste never drives
+// stock negative.
+func (uc *LogWasteUseCase) wasteItem(
+	ctx context.Context, userID uuid.UUID, input dto.LogWasteRequest,
+) (*inventory.WasteEntry, []*inventory.Transaction, error) {
+	item, err := lockItem(ctx, uc.repo, uuid.MustParse(input.InventoryItemID)) // validated by binding
+	if err != nil {
+		return nil, nil, err
+	}
+	qty, err := toItemUnit(ctx, uc.units, item, input.Quantity, input.Unit)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry, err := inventory.NewWasteEntry(item.RestaurantID, qty, inventory.AdjustmentReason(input.Reason), userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.InventoryItemID = &item.ID
+	entry.UnitOfMeasureID = item.UnitOfMeasureID
+	entry.Item = item
+	entry.Unit = item.Unit
+
+	batches, err := uc.repo.LockBatches(ctx, item.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, draws, err := item.WasteFEFO(qty, entry.Reason, batches)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := saveBatches(ctx, uc.repo, draws); err != nil {
+		return nil, nil, err
+	}
+	if err := uc.repo.Update(ctx, item); err != nil {
+		return nil, nil, fmt.Errorf("failed to update stock: %w", err)
+	}
+	entry.Post(entries)
+	for _, e := range entries {
+		e.Notes = joinNotes(input.Notes, describeInput(input.Quantity, input.Unit))
+		if err := uc.repo.CreateTransaction(ctx, e); err != nil {
+			return nil, nil, err
+		}
+	}
+	return entry, entries, nil
+}
+
+// wastePortions writes off the recipe ingredients of the wasted portions.
+// Shortages follow the order deduction policy.
+func (uc *LogWasteUseCase) wastePortions(
+	ctx context.Context, userID uuid.UUID, input dto.LogWasteRequest,
+) (*inventory.WasteEntry, []*inventory.Transaction, error) {
+	menuItemID := uuid.MustParse(input.MenuItemID) // validated by binding
+	rec, err := uc.recipes.GetByMenuItem(ctx, menuItemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rec == nil || rec.MenuItem == nil {
+		return nil, nil, recipe.ErrRecipeNotFound
+	}
+	entry, err := inventory.NewWasteEntry(
+		rec.MenuItem.RestaurantID, input.Quantity, inventory.AdjustmentReason(input.Reason), userID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.MenuItemID = &menuItemID
+
+	// Scale the per-portion components rather than the portion count, so
+	// half a plate can be logged too
+	components := rec.Components()
+	for i := range components {
+		components[i].Quantity *= entry.Quantity
+	}
+	label := fmt.Sprintf("%g x %s", entry.Quantity, rec.MenuItem.Name)
+	result, err := uc.deductor.Deduct(ctx,
+		entry.Reference(label),
+		[]inventory.Portion{{MenuItemID: menuItemID, Quantity: 1}},
+		map[uuid.UUID][]inventory.Component{menuItemID: components},
+		&userID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.Post(result.Transactions)
+	return entry, result.Transactions, nil
+}
@@ -0,0 +1,130 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/notification"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// NotifyAlertsUseCase sends new stock alerts to the subscribers of each
+// alert's restaurant. Alerts are raised both here and by database triggers,
+// so it picks up whatever nobody has been sent yet. A page of alerts is
+// claimed for a while in a short transaction and delivered after it
+// commits, so slow senders don't hold row locks and concurrent runs never
+// pick the same alert. An alert is marked sent once its subscribers have
+// been tried; if they can't be looked up, or the run dies first, the claim
+// lapses and a later run tries again, up to maxNotifyAttempts runs. One
+// broken address can't hold up or repeat the others; delivery failures are
+// returned.
+const (
+	notifyPage        = 100
+	notifyClaim       = 10 * time.Minute
+	maxNotifyAttempts = 5
+)
+
+type NotifyAlertsUseCase struct {
+	alerts     inventory.AlertRepository
+	senders    map[inventory.SubscriptionChannel]notification.Sender
+	transactor tx.Transactor
+}
+
+func NewNotifyAlertsUseCase(
+	alerts inventory.AlertRepository,
+	senders map[inventory.SubscriptionChannel]notification.Sender,
+	transactor tx.Transactor,
+) *NotifyAlertsUseCase {
+	return &NotifyAlertsUseCase{
+		alerts:     alerts,
+		senders:    senders,
+		transactor: transactor,
+	}
+}
+
+// Execute sends up to one page of alerts and returns how many it sent.
+func (uc *NotifyAlertsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	alerts, err := uc.claim(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		sent     int
+		failures []error
+	)
+	subscribers := make(map[uuid.UUID][]*inventory.AlertSubscription)
+	for _, a := range alerts {
+		restaurantID := a.Item.RestaurantID
+		subs, ok := subscribers[restaurantID]
+		if !ok {
+			if subs, err = uc.alerts.ListActiveSubscriptions(ctx, restaurantID); err != nil {
+				failures = append(failures, fmt.Errorf("failed to list alert subscriptions: %w", err))
+				if err := uc.alerts.ReleaseClaim(ctx, a.ID); err != nil {
+					failures = append(failures, fmt.Errorf("failed to release stock alert: %w", err))
+				}
+				continue
+			}
+			subscribers[restaurantID] = subs
+		}
+		for _, sub := range subs {
+			if err := uc.send(ctx, sub, a); err != nil {
+				failures = append(failures, err)
+			}
+		}
+		if err := uc.alerts.MarkNotified(ctx, a.ID, now); err != nil {
+			failures = append(failures, fmt.Errorf("failed to update stock alert: %w", err))
+			continue
+		}
+		sent++
+	}
+	return sent, errors.Join(failures...)
+}
+
+// claim takes up to one page of unsent alerts for this run until
+// notifyClaim has passed and returns them. Rows another run has locked are
+// skipped rather than waited on.
+func (uc *NotifyAlertsUseCase) claim(ctx context.Context, now time.Time) ([]*inventory.Alert, error) {
+	var alerts []*inventory.Alert
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if alerts, err = uc.alerts.LockUnnotified(ctx, now, maxNotifyAttempts, notifyPage); err != nil {
+			return err
+		}
+		until := now.Add(notifyClaim)
+		for _, a := range alerts {
+			a.NotifyAttempts++
+			a.NotifyClaimedUntil = &until
+			if err := uc.alerts.Update(ctx, a); err != nil {
+				return fmt.Errorf("failed to update stock alert: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+func (uc *NotifyAlertsUseCase) send(ctx context.Context, sub *inventory.AlertSubscription, a *inventory.Alert) error {
+	sender, ok := uc.senders[sub.Channel]
+	if !ok {
+		return fmt.Errorf("no sender for %s alerts", sub.Channel)
+	}
+	return sender.Send(ctx, sub.Target, notification.Message{
+		UserID:  sub.UserID,
+		Subject: a.Subject(),
+		Body:    a.Body(),
+		Data: map[string]string{
+			"alert_id":          a.ID.String(),
+			"alert_type":        string(a.AlertType),
+			"inventory_item_id": a.InventoryItemID.String(),
+			"restaurant_id":     a.Item.RestaurantID.String(),
+		},
+	})
+}
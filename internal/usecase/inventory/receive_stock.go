@@ -0,0 +1,76 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// ReceiveStockUseCase books incoming stock as a new batch and updates the
+// average cost.
+type ReceiveStockUseCase struct {
+	repo       inventory.Repository
+	units      inventory.UnitRepository
+	transactor tx.Transactor
+}
+
+func NewReceiveStockUseCase(
+	repo inventory.Repository,
+	units inventory.UnitRepository,
+	transactor tx.Transactor,
+) *ReceiveStockUseCase {
+	return &ReceiveStockUseCase{
+		repo:       repo,
+		units:      units,
+		transactor: transactor,
+	}
+}
+
+func (uc *ReceiveStockUseCase) Execute(
+	ctx context.Context, userID, itemID uuid.UUID, input dto.ReceiveStockRequest,
+) (*inventory.Item, *inventory.Transaction, error) {
+	var (
+		item  *inventory.Item
+		entry *inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if item, err = lockItem(ctx, uc.repo, itemID); err != nil {
+			return err
+		}
+		qty, err := toItemUnit(ctx, uc.units, item, input.Quantity, input.Unit)
+		if err != nil {
+			return err
+		}
+		if qty <= 0 {
+			return inventory.ErrNonPositiveQuantity
+		}
+
+		// The cost was quoted per entered unit; re-express it per item unit
+		unitCost := input.UnitCost * input.Quantity / qty
+		now := time.Now()
+		if entry, err = item.Receive(qty, unitCost, now); err != nil {
+			return err
+		}
+		entry.PerformedBy = &userID
+		entry.Notes = joinNotes(input.Notes, describeInput(input.Quantity, input.Unit))
+		batch := item.NewBatch(entry, input.BatchNumber, parseDate(input.ExpiryDate), now)
+
+		if err := uc.repo.Update(ctx, item); err != nil {
+			return fmt.Errorf("failed to update stock: %w", err)
+		}
+		if err := uc.repo.CreateBatch(ctx, batch); err != nil {
+			return err
+		}
+		return uc.repo.CreateTransaction(ctx, entry)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, entry, nil
+}
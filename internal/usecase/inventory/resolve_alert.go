@@ -0,0 +1,29 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// ResolveAlertUseCase closes an alert, recording who resolved it and when.
+type ResolveAlertUseCase struct {
+	alerts     inventory.AlertRepository
+	transactor tx.Transactor
+}
+
+func NewResolveAlertUseCase(alerts inventory.AlertRepository, transactor tx.Transactor) *ResolveAlertUseCase {
+	return &ResolveAlertUseCase{
+		alerts:     alerts,
+		transactor: transactor,
+	}
+}
+
+func (uc *ResolveAlertUseCase) Execute(ctx context.Context, userID, id uuid.UUID, notes string) (*inventory.Alert, error) {
+	return changeAlert(ctx, uc.alerts, uc.transactor, id, func(a *inventory.Alert) error {
+		return a.Resolve(userID, time.Now(), notes)
+	})
+}
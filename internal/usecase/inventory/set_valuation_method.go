@@ -0,0 +1,41 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// SetValuationMethodUseCase chooses how a restaurant values its inventory.
+// Valuations replay the whole ledger, so a switch applies to past periods
+// too.
+type SetValuationMethodUseCase struct {
+	restaurants restaurant.Repository
+}
+
+func NewSetValuationMethodUseCase(restaurants restaurant.Repository) *SetValuationMethodUseCase {
+	return &SetValuationMethodUseCase{restaurants: restaurants}
+}
+
+func (uc *SetValuationMethodUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, method inventory.ValuationMethod,
+) (*restaurant.Restaurant, error) {
+	if !method.Valid() {
+		return nil, inventory.ErrInvalidValuationMethod
+	}
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	res.ValuationMethod = string(method)
+	if err := uc.restaurants.Update(ctx, res); err != nil {
+		return nil, fmt.Errorf("failed to update restaurant: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,93 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+)
+
+// lockItem loads an active item and locks it for the rest of the transaction
+func lockItem(ctx context.Context, repo inventory.Repository, id uuid.UUID) (*inventory.Item, error) {
+	item, err := repo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, inventory.ErrItemNotFound
+	}
+	if !item.IsActive {
+		return nil, inventory.ErrItemInactive
+	}
+	return item, nil
+}
+
+// saveBatches stores the batches a movement took stock from
+func saveBatches(ctx context.Context, repo inventory.Repository, draws []inventory.BatchDraw) error {
+	for _, d := range draws {
+		if err := repo.UpdateBatch(ctx, d.Batch); err != nil {
+			return fmt.Errorf("failed to update batch: %w", err)
+		}
+	}
+	return nil
+}
+
+// toItemUnit converts qty, given in the unit named by code, into the item's
+// own unit. An empty code means the quantity is already in the item's unit.
+func toItemUnit(
+	ctx context.Context, units inventory.UnitRepository, item *inventory.Item, qty float64, code string,
+) (float64, error) {
+	if code == "" {
+		return inventory.RoundQty(qty), nil
+	}
+	if item.Unit == nil {
+		return 0, inventory.ErrItemWithoutUnit
+	}
+	from, err := findUnit(ctx, units, code)
+	if err != nil {
+		return 0, err
+	}
+	converted, err := inventory.Convert(qty, from, item.Unit)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s to %s", err, from.Abbreviation, item.Unit.Abbreviation)
+	}
+	return converted, nil
+}
+
+// describeInput keeps the quantity as entered when it was converted, so the
+// ledger shows what the user actually typed
+func describeInput(qty float64, code string) string {
+	if code == "" {
+		return ""
+	}
+	return fmt.Sprintf("entered as %g %s", qty, code)
+}
+
+// parseDate parses a date validated by the binding tags; empty means none
+func parseDate(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// joinNotes joins non-empty notes with "; "
+func joinNotes(notes ...string) string {
+	out := ""
+	for _, n := range notes {
+		if n == "" {
+			continue
+		}
+		if out != "" {
+			out += "; "
+		}
+		out += n
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type UpdateItemUseCase struct {
+	repo inventory.Repository
+}
+
+func NewUpdateItemUseCase(repo inventory.Repository) *UpdateItemUseCase {
+	return &UpdateItemUseCase{repo: repo}
+}
+
+func (uc *UpdateItemUseCase) Execute(
+	ctx context.Context, id uuid.UUID, input dto.UpdateInventoryItemRequest,
+) (*inventory.Item, error) {
+	item, err := NewGetItemUseCase(uc.repo).Execute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.SKU != nil {
+		if *input.SKU == "" {
+			item.SKU = nil
+		} else {
+			if err := checkSKU(ctx, uc.repo, item.RestaurantID, *input.SKU, item.ID); err != nil {
+				return nil, err
+			}
+			item.SKU = input.SKU
+		}
+	}
+	if input.Barcode != nil && *input.Barcode != "" {
+		if err := checkBarcode(ctx, uc.repo, item.RestaurantID, *input.Barcode, item.ID); err != nil {
+			return nil, err
+		}
+	}
+	assign := func(dst *string, src *string) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	assign(&item.Name, input.Name)
+	assign(&item.Description, input.Description)
+	assign(&item.StorageLocation, input.StorageLocation)
+	assign(&item.Barcode, input.Barcode)
+	assign(&item.ImageURL, input.ImageURL)
+	assign(&item.Notes, input.Notes)
+	if input.CategoryID != nil {
+		item.CategoryID = parseOptionalID(*input.CategoryID)
+	}
+	if input.SupplierID != nil {
+		item.SupplierID = parseOptionalID(*input.SupplierID)
+	}
+	if input.MinimumStock != nil {
+		item.MinimumStock = inventory.RoundQty(*input.MinimumStock)
+	}
+	if input.MaximumStock != nil {
+		item.MaximumStock = input.MaximumStock
+	}
+	if input.ReorderPoint != nil {
+		item.ReorderPoint = input.ReorderPoint
+	}
+	if input.ReorderQuantity != nil {
+		item.ReorderQuantity = input.ReorderQuantity
+	}
+	if input.ShelfLifeDays != nil {
+		item.ShelfLifeDays = input.ShelfLifeDays
+	}
+	if input.ExpiryAlertDays != nil {
+		item.ExpiryAlertDays = *input.ExpiryAlertDays
+	}
+	if input.IsPerishable != nil {
+		item.IsPerishable = *input.IsPerishable
+	}
+	if input.RequiresRefrigeration != nil {
+		item.RequiresRefrigeration = *input.RequiresRefrigeration
+	}
+	if input.IsActive != nil {
+		item.IsActive = *input.IsActive
+	}
+
+	if err := uc.repo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update inventory item: %w", err)
+	}
+	return item, nil
+}
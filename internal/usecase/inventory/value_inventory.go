@@ -0,0 +1,66 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// ValueInventoryUseCase closes a period for a restaurant: it replays the
+// stock ledger with the restaurant's valuation method to value opening and
+// closing stock and every movement in between.
+type ValueInventoryUseCase struct {
+	repo        inventory.Repository
+	restaurants restaurant.Repository
+}
+
+func NewValueInventoryUseCase(repo inventory.Repository, restaurants restaurant.Repository) *ValueInventoryUseCase {
+	return &ValueInventoryUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute covers [from, to); a zero range defaults to the current month so
+// far, in the restaurant's time zone. An empty method uses the
+// restaurant's.
+func (uc *ValueInventoryUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, method inventory.ValuationMethod, from, to time.Time,
+) (*inventory.Valuation, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	if method == "" {
+		method = inventory.ValuationMethod(res.ValuationMethod)
+	}
+	if !method.Valid() {
+		return nil, inventory.ErrInvalidValuationMethod
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		local := to.In(res.Location())
+		from = time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, local.Location())
+	}
+	if !from.Before(to) {
+		return nil, inventory.ErrInvalidPeriod
+	}
+
+	items, err := uc.repo.ListByRestaurant(ctx, restaurantID, inventory.ItemFilter{IncludeInactive: true})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := uc.repo.ListLedger(ctx, restaurantID, to)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.Value(restaurantID, method, from, to, items, entries), nil
+}
@@ -0,0 +1,49 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// WasteReportUseCase reports what waste cost a restaurant, by reason, item
+// and day, from the waste entries of the stock ledger.
+type WasteReportUseCase struct {
+	waste       inventory.WasteRepository
+	restaurants restaurant.Repository
+}
+
+func NewWasteReportUseCase(waste inventory.WasteRepository, restaurants restaurant.Repository) *WasteReportUseCase {
+	return &WasteReportUseCase{
+		waste:       waste,
+		restaurants: restaurants,
+	}
+}
+
+// Execute covers [from, to); a zero range defaults to the last 30 days.
+func (uc *WasteReportUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (*inventory.WasteReport, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -30)
+	}
+
+	lines, err := uc.waste.Lines(ctx, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.SummarizeWaste(from, to, lines), nil
+}
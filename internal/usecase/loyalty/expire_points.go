@@ -0,0 +1,63 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// expireBatchSize is how many accounts are looked up at a time
+const expireBatchSize = 100
+
+// ExpirePointsUseCase debits the points that expired unspent, one account
+// per transaction. It is safe to run from several replicas at once: the
+// account lock makes the second run find nothing left to expire.
+type ExpirePointsUseCase struct {
+	repo       loyalty.Repository
+	ledger     *loyalty.Ledger
+	transactor tx.Transactor
+}
+
+func NewExpirePointsUseCase(
+	repo loyalty.Repository, ledger *loyalty.Ledger, transactor tx.Transactor,
+) *ExpirePointsUseCase {
+	return &ExpirePointsUseCase{
+		repo:       repo,
+		ledger:     ledger,
+		transactor: transactor,
+	}
+}
+
+// Execute returns how many accounts had points expire.
+func (uc *ExpirePointsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	for {
+		userIDs, err := uc.repo.ListExpired(ctx, now, expireBatchSize)
+		if err != nil {
+			return expired, err
+		}
+		batch := 0
+		for _, userID := range userIDs {
+			var e *loyalty.Entry
+			err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				var err error
+				e, err = uc.ledger.Expire(ctx, userID, now)
+				return err
+			})
+			if err != nil {
+				return expired, err
+			}
+			if e != nil {
+				batch++
+			}
+		}
+		expired += batch
+		// Expired accounts drop out of the list, so the next batch starts
+		// from the top again; a batch another replica got to first ends it
+		if len(userIDs) < expireBatchSize || batch == 0 {
+			return expired, nil
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Package loyalty contains the use cases for customers' points balances
+// and ledgers, restaurants' loyalty rules and expiring unspent points.
+package loyalty
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+)
+
+// GetAccountUseCase returns the customer's points balance with the program
+// settings that say what it is worth; customers who never had points have
+// an empty account.
+type GetAccountUseCase struct {
+	repo    loyalty.Repository
+	program loyalty.Program
+}
+
+func NewGetAccountUseCase(repo loyalty.Repository, program loyalty.Program) *GetAccountUseCase {
+	return &GetAccountUseCase{
+		repo:    repo,
+		program: program,
+	}
+}
+
+func (uc *GetAccountUseCase) Execute(ctx context.Context, userID uuid.UUID) (*loyalty.Account, loyalty.Program, error) {
+	acc, err := uc.repo.GetAccount(ctx, userID)
+	if err != nil {
+		return nil, uc.program, err
+	}
+	if acc == nil {
+		acc = &loyalty.Account{UserID: userID}
+	}
+	return acc, uc.program, nil
+}
@@ -0,0 +1,27 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+)
+
+type GetRuleUseCase struct {
+	repo loyalty.Repository
+}
+
+func NewGetRuleUseCase(repo loyalty.Repository) *GetRuleUseCase {
+	return &GetRuleUseCase{repo: repo}
+}
+
+func (uc *GetRuleUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) (*loyalty.Rule, error) {
+	rule, err := uc.repo.GetRule(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, loyalty.ErrRuleNotFound
+	}
+	return rule, nil
+}
@@ -0,0 +1,30 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+)
+
+// ListEntriesUseCase pages through the customer's points ledger, newest
+// first.
+type ListEntriesUseCase struct {
+	repo loyalty.Repository
+}
+
+func NewListEntriesUseCase(repo loyalty.Repository) *ListEntriesUseCase {
+	return &ListEntriesUseCase{repo: repo}
+}
+
+func (uc *ListEntriesUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]*loyalty.Entry, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.repo.ListEntries(ctx, userID, limit, offset)
+}
@@ -0,0 +1,58 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// SaveRuleUseCase creates or replaces a restaurant's loyalty rule. Orders
+// already delivered keep the points they earned.
+type SaveRuleUseCase struct {
+	repo        loyalty.Repository
+	restaurants restaurant.Repository
+}
+
+func NewSaveRuleUseCase(repo loyalty.Repository, restaurants restaurant.Repository) *SaveRuleUseCase {
+	return &SaveRuleUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *SaveRuleUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, input dto.SaveLoyaltyRuleRequest,
+) (*loyalty.Rule, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+
+	rule, err := uc.repo.GetRule(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		rule = &loyalty.Rule{RestaurantID: restaurantID, IsActive: true}
+	}
+	rule.PointsPerUnit = *input.PointsPerUnit
+	rule.MinimumSubtotal = input.MinimumSubtotal
+	rule.MaxRedeemPercent = input.MaxRedeemPercent
+	if input.IsActive != nil {
+		rule.IsActive = *input.IsActive
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.SaveRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
@@ -0,0 +1,38 @@
+package menu
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+)
+
+// ListAvailabilityChangesUseCase returns when and why a menu item was
+// switched off or back on by stock, newest first.
+type ListAvailabilityChangesUseCase struct {
+	repo menu.Repository
+}
+
+func NewListAvailabilityChangesUseCase(repo menu.Repository) *ListAvailabilityChangesUseCase {
+	return &ListAvailabilityChangesUseCase{repo: repo}
+}
+
+func (uc *ListAvailabilityChangesUseCase) Execute(
+	ctx context.Context, menuItemID uuid.UUID, limit int,
+) (*menu.MenuItem, []*menu.AvailabilityChange, error) {
+	mi, err := uc.repo.GetByID(ctx, menuItemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mi == nil {
+		return nil, nil, menu.ErrMenuItemNotFound
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	changes, err := uc.repo.ListAvailabilityChanges(ctx, menuItemID, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mi, changes, nil
+}
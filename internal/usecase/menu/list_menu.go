@@ -0,0 +1,36 @@
+// Package menu contains the use cases for reading a restaurant's menu and
+// the availability of its items.
+package menu
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// ListMenuUseCase returns a restaurant's menu in display order. Items that
+// ran out of an ingredient are included, switched off with the reason.
+type ListMenuUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewListMenuUseCase(repo menu.Repository, restaurants restaurant.Repository) *ListMenuUseCase {
+	return &ListMenuUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *ListMenuUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) ([]*menu.MenuItem, error) {
+	r, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	return uc.repo.ListByRestaurant(ctx, restaurantID)
+}
@@ -0,0 +1,117 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+)
+
+// CancelOrderUseCase cancels an order and gives back its pre-order slot,
+// the loyalty points spent on it, what was paid from the wallet or card, and
+// the stock deducted when it was confirmed. Admins and the kitchen may
+// cancel any order before it is ready; everyone else only their own orders
+// while they are still pending.
+type CancelOrderUseCase struct {
+	repo       order.Repository
+	slots      order.SlotRepository
+	transactor tx.Transactor
+	loyalty    *loyalty.Ledger
+	payments   *Payments
+	stock      *StockDeduction
+}
+
+func NewCancelOrderUseCase(
+	repo order.Repository,
+	slots order.SlotRepository,
+	transactor tx.Transactor,
+	ledger *loyalty.Ledger,
+	payments *Payments,
+	stock *StockDeduction,
+) *CancelOrderUseCase {
+	return &CancelOrderUseCase{
+		repo:       repo,
+		slots:      slots,
+		transactor: transactor,
+		loyalty:    ledger,
+		payments:   payments,
+		stock:      stock,
+	}
+}
+
+func (uc *CancelOrderUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, role user.Role, orderID uuid.UUID, reason string,
+) (*order.Order, error) {
+	var o *order.Order
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		// Locked so a cancel can't overwrite a concurrent confirmation, and
+		// two cancels can't both refund
+		o, err = uc.repo.LockByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			return order.ErrOrderNotFound
+		}
+		// Admins may cancel any order and the kitchen any it hasn't finished
+		// (the transition rules stop cancels once an order is ready). Every
+		// other role is treated like a customer.
+		if role != user.RoleAdmin && role != user.RoleKitchen {
+			if !o.PlacedBy(userID) {
+				return order.ErrNotOrderOwner
+			}
+			if o.Status != order.StatusPending {
+				return order.ErrInvalidTransition
+			}
+		}
+
+		// Stock is deducted when an order is confirmed
+		deducted := o.Status != order.StatusPending
+		now := time.Now()
+		if err := o.TransitionTo(order.StatusCancelled, now); err != nil {
+			return err
+		}
+		o.CancellationReason = reason
+		// Part paid from the wallet comes back too, but only a paid order
+		// is marked refunded
+		refund := collected(o)
+		if refund && o.PaymentStatus == order.PaymentPaid {
+			if err := o.Refund("order cancelled", now); err != nil {
+				return err
+			}
+		}
+		if err := uc.repo.Update(ctx, o); err != nil {
+			return fmt.Errorf("failed to cancel order: %w", err)
+		}
+		if deducted {
+			if _, err := uc.stock.Restore(ctx, o, &userID); err != nil {
+				return err
+			}
+		}
+		if o.PointsRedeemed > 0 {
+			if _, err := uc.loyalty.Reverse(ctx, o, "order cancelled", now); err != nil {
+				return err
+			}
+		}
+		if refund {
+			if err := uc.payments.Refund(ctx, o, false); err != nil {
+				return err
+			}
+		}
+
+		if o.ScheduledFor != nil {
+			return uc.slots.Release(ctx, o.RestaurantID, *o.ScheduledFor)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+)
+
+// ETAParams tunes the ETA estimate.
+type ETAParams struct {
+	// MinutesPerQueuedOrder is the delay each order already in the kitchen adds
+	MinutesPerQueuedOrder float64
+	// TravelSpeedKmh is the average courier speed
+	TravelSpeedKmh float64
+	// HandoffMinutes covers collection at the restaurant and drop-off at the door
+	HandoffMinutes int
+}
+
+// Estimator keeps order ETAs current from prep time, kitchen load and travel.
+type Estimator struct {
+	orders order.Repository
+	params ETAParams
+}
+
+func NewEstimator(orders order.Repository, params ETAParams) *Estimator {
+	if params.TravelSpeedKmh <= 0 {
+		params.TravelSpeedKmh = 20
+	}
+	return &Estimator{
+		orders: orders,
+		params: params,
+	}
+}
+
+// TravelMinutes estimates the courier time for distanceKm, plus any delivery
+// zone surcharge.
+func (e *Estimator) TravelMinutes(distanceKm float64, extraMinutes int) int {
+	ride := math.Ceil(distanceKm / e.params.TravelSpeedKmh * 60)
+	return int(ride) + e.params.HandoffMinutes + extraMinutes
+}
+
+// Refresh recomputes o.EstimatedDeliveryTime for the order's current status.
+// Call it after every status transition.
+func (e *Estimator) Refresh(ctx context.Context, o *order.Order, now time.Time) error {
+	load := 0
+	if o.Status == order.StatusPending || o.Status == order.StatusConfirmed {
+		n, err := e.orders.CountInKitchen(ctx, o.RestaurantID, o.ID)
+		if err != nil {
+			return err
+		}
+		load = n
+	}
+	perOrder := time.Duration(e.params.MinutesPerQueuedOrder * float64(time.Minute))
+	o.EstimatedDeliveryTime = o.EstimateETA(load, perOrder, now)
+	return nil
+}
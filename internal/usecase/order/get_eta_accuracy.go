@@ -0,0 +1,32 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+)
+
+// GetETAAccuracyUseCase reports how close promised ETAs were to actual
+// delivery times for a restaurant.
+type GetETAAccuracyUseCase struct {
+	repo order.Repository
+}
+
+func NewGetETAAccuracyUseCase(repo order.Repository) *GetETAAccuracyUseCase {
+	return &GetETAAccuracyUseCase{repo: repo}
+}
+
+// Execute covers [from, to); a zero range defaults to the last 30 days.
+func (uc *GetETAAccuracyUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (*order.ETAAccuracy, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -30)
+	}
+	return uc.repo.ETAAccuracy(ctx, restaurantID, from, to)
+}
@@ -0,0 +1,34 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+)
+
+// GetOrderUseCase loads an order visible to the requesting user.
+type GetOrderUseCase struct {
+	repo order.Repository
+}
+
+func NewGetOrderUseCase(repo order.Repository) *GetOrderUseCase {
+	return &GetOrderUseCase{repo: repo}
+}
+
+func (uc *GetOrderUseCase) Execute(ctx context.Context, userID uuid.UUID, role user.Role, orderID uuid.UUID) (*order.Order, error) {
+	o, err := uc.repo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, order.ErrOrderNotFound
+	}
+	// Staff working on orders see all of them; everyone else only their own,
+	// and a token without a known role sees none
+	if !role.Valid() || (!role.SeesAllOrders() && !o.PlacedBy(userID)) {
+		return nil, order.ErrNotOrderOwner
+	}
+	return o, nil
+}
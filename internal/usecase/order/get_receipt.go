@@ -0,0 +1,42 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+)
+
+// GetReceiptUseCase returns a placed order together with the restaurant
+// details printed on the receipt.
+type GetReceiptUseCase struct {
+	getOrder    *GetOrderUseCase
+	restaurants restaurant.Repository
+}
+
+func NewGetReceiptUseCase(repo order.Repository, restaurants restaurant.Repository) *GetReceiptUseCase {
+	return &GetReceiptUseCase{
+		getOrder:    NewGetOrderUseCase(repo),
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetReceiptUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, role user.Role, orderID uuid.UUID,
+) (*order.Order, *restaurant.Restaurant, error) {
+	o, err := uc.getOrder.Execute(ctx, userID, role, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := uc.restaurants.GetByID(ctx, o.RestaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res == nil {
+		return nil, nil, restaurant.ErrRestaurantNotFound
+	}
+	return o, res, nil
+}
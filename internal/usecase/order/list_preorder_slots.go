@@ -0,0 +1,72 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// ListPreorderSlotsUseCase lists the bookable pre-order slots of a restaurant
+// for one local calendar day.
+type ListPreorderSlotsUseCase struct {
+	restaurants restaurant.Repository
+	slots       order.SlotRepository
+}
+
+func NewListPreorderSlotsUseCase(restaurants restaurant.Repository, slots order.SlotRepository) *ListPreorderSlotsUseCase {
+	return &ListPreorderSlotsUseCase{
+		restaurants: restaurants,
+		slots:       slots,
+	}
+}
+
+// Execute returns the future slots of date (YYYY-MM-DD in the restaurant's
+// time zone) that fall within opening hours. Today is used when date is empty.
+func (uc *ListPreorderSlotsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, date string,
+) ([]order.Slot, *restaurant.Restaurant, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res == nil {
+		return nil, nil, restaurant.ErrRestaurantNotFound
+	}
+
+	loc := res.Location()
+	now := time.Now()
+	day := now.In(loc)
+	if date != "" {
+		day, err = time.ParseInLocation(time.DateOnly, date, loc)
+		if err != nil {
+			return nil, nil, order.ErrInvalidScheduleDate
+		}
+	}
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, 1)
+	limit := now.AddDate(0, 0, res.PreorderMaxDays)
+
+	reserved, err := uc.slots.ListReserved(ctx, res.ID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	step := time.Duration(slotMinutes(res)) * time.Minute
+	slots := make([]order.Slot, 0)
+	for start := from; start.Before(to); start = start.Add(step) {
+		if !start.After(now) || start.After(limit) || !res.IsOpenAt(start) {
+			continue
+		}
+		slots = append(slots, order.Slot{
+			RestaurantID: res.ID,
+			Start:        start.UTC(),
+			End:          start.Add(step).UTC(),
+			Capacity:     res.PreorderSlotCapacity,
+			Reserved:     reserved[start.UTC()],
+		})
+	}
+	return slots, res, nil
+}
@@ -0,0 +1,115 @@
+package order
+
+import (
+	"context"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Payments collects an order's total from the customer's wallet and card
+// and gives it back on refund, recording both in the wallet ledger.
+// Orders paid any other way, such as cash on delivery, never touch it.
+type Payments struct {
+	ledger   *wallet.Ledger
+	provider payment.Provider
+}
+
+func NewPayments(ledger *wallet.Ledger, provider payment.Provider) *Payments {
+	return &Payments{
+		ledger:   ledger,
+		provider: provider,
+	}
+}
+
+// Collect must run inside the transaction that saves the order, after it
+// is saved. It takes o.WalletAmount from the wallet and, given a card
+// token, charges the rest to the card; without a token the rest stays
+// pending. The card is charged last so an earlier failure never charges
+// it. The charge is returned even with an error, so the caller can Void
+// it once the transaction has rolled back.
+func (p *Payments) Collect(ctx context.Context, o *order.Order, token string) (*payment.Charge, error) {
+	remaining := money.Sum(o.Total, -o.WalletAmount)
+
+	var charge *payment.Charge
+	if remaining > 0 && token != "" {
+		var err error
+		charge, err = p.provider.Charge(ctx, payment.ChargeRequest{
+			Amount:         remaining,
+			Token:          token,
+			Description:    "Order " + o.OrderNumber,
+			IdempotencyKey: o.ID.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		o.PaymentTransactionID = charge.ID
+		remaining = 0
+	}
+	if o.WalletAmount <= 0 && charge == nil {
+		return nil, nil
+	}
+
+	cardAmount, chargeID := 0.0, ""
+	if charge != nil {
+		cardAmount, chargeID = charge.Amount, charge.ID
+	}
+	if _, err := p.ledger.PayOrder(ctx, o, cardAmount, chargeID); err != nil {
+		return charge, err
+	}
+	if remaining <= 0 {
+		o.PaymentStatus = order.PaymentPaid
+	}
+	return charge, nil
+}
+
+// Void refunds a charge whose order was never saved.
+func (p *Payments) Void(ctx context.Context, charge *payment.Charge) error {
+	_, err := p.provider.Refund(ctx, payment.RefundRequest{
+		ChargeID:       charge.ID,
+		Amount:         charge.Amount,
+		IdempotencyKey: "void-" + charge.ID,
+	})
+	return err
+}
+
+// Refund gives back what Collect took for the order, once. It must run
+// inside the transaction that refunds the order. The wallet part goes back
+// to the wallet; the card part goes back to the card, or to the wallet
+// when toWallet is set. The card refund is keyed by the order, so when the
+// transaction rolls back after the provider refunded, the retry gets the
+// same refund back instead of a second one.
+func (p *Payments) Refund(ctx context.Context, o *order.Order, toWallet bool) error {
+	paid, err := p.ledger.OrderPayment(ctx, o.ID)
+	if err != nil {
+		return err
+	}
+	if !paid.Paid() || paid.Refunded {
+		return nil
+	}
+	// Guests ordering at a table have no wallet
+	if o.UserID == nil {
+		toWallet = false
+	}
+
+	refundID := ""
+	if paid.Card > 0 && !toWallet {
+		refundID, err = p.provider.Refund(ctx, payment.RefundRequest{
+			ChargeID:       o.PaymentTransactionID,
+			Amount:         paid.Card,
+			IdempotencyKey: "refund-" + o.ID.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	_, err = p.ledger.RefundOrder(ctx, o, paid, toWallet, refundID)
+	return err
+}
+
+// collected reports whether Collect took any money for the order
+func collected(o *order.Order) bool {
+	return o.WalletAmount > 0 || o.PaymentTransactionID != ""
+}
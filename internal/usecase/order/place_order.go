@@ -0,0 +1,86 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// PlaceOrderUseCase prices a checkout request and persists it as a pending
+// order, spending the loyalty points it redeems and collecting the wallet
+// and card payment.
+type PlaceOrderUseCase struct {
+	repo       order.Repository
+	slots      order.SlotRepository
+	transactor tx.Transactor
+	pricer     *Pricer
+	loyalty    *loyalty.Ledger
+	payments   *Payments
+}
+
+func NewPlaceOrderUseCase(
+	repo order.Repository,
+	slots order.SlotRepository,
+	transactor tx.Transactor,
+	pricer *Pricer,
+	ledger *loyalty.Ledger,
+	payments *Payments,
+) *PlaceOrderUseCase {
+	return &PlaceOrderUseCase{
+		repo:       repo,
+		slots:      slots,
+		transactor: transactor,
+		pricer:     pricer,
+		loyalty:    ledger,
+		payments:   payments,
+	}
+}
+
+func (uc *PlaceOrderUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.CheckoutRequest) (*order.Order, error) {
+	// 1. Price the order exactly as a quote would
+	o, res, err := uc.pricer.price(ctx, &userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Reserve the pre-order slot, save, spend the points and collect the
+	// payment in one transaction, so a failed step never leaves capacity,
+	// points or wallet funds taken
+	var charge *payment.Charge
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if o.ScheduledFor != nil {
+			err := uc.slots.Reserve(ctx, res.ID, *o.ScheduledFor, res.PreorderSlotCapacity)
+			if err != nil {
+				return err
+			}
+		}
+		if err := uc.repo.Create(ctx, o); err != nil {
+			return fmt.Errorf("failed to save order: %w", err)
+		}
+		if err := uc.loyalty.Redeem(ctx, o); err != nil {
+			return err
+		}
+		charge, err = uc.payments.Collect(ctx, o, input.PaymentToken)
+		if err != nil || !collected(o) {
+			return err
+		}
+		return uc.repo.Update(ctx, o)
+	})
+	if err != nil {
+		// The card was charged but the order rolled back
+		if charge != nil {
+			if voidErr := uc.payments.Void(ctx, charge); voidErr != nil {
+				return nil, fmt.Errorf("%w (and refunding card charge %s failed: %v)", err, charge.ID, voidErr)
+			}
+		}
+		return nil, err
+	}
+
+	return o, nil
+}
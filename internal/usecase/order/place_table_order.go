@@ -0,0 +1,85 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// PlaceTableOrderUseCase adds a guest's dine-in order to a table's tab. The
+// order goes to the kitchen like any other, with the table number on the
+// ticket, and is paid when staff close the tab.
+type PlaceTableOrderUseCase struct {
+	repo       order.Repository
+	sessions   table.SessionRepository
+	transactor tx.Transactor
+	pricer     *Pricer
+}
+
+func NewPlaceTableOrderUseCase(
+	repo order.Repository,
+	sessions table.SessionRepository,
+	transactor tx.Transactor,
+	pricer *Pricer,
+) *PlaceTableOrderUseCase {
+	return &PlaceTableOrderUseCase{
+		repo:       repo,
+		sessions:   sessions,
+		transactor: transactor,
+		pricer:     pricer,
+	}
+}
+
+func (uc *PlaceTableOrderUseCase) Execute(
+	ctx context.Context, sessionID uuid.UUID, input dto.TableOrderRequest,
+) (*order.Order, error) {
+	s, err := uc.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, table.ErrSessionNotFound
+	}
+	if !s.IsOpen() {
+		return nil, table.ErrSessionClosed
+	}
+
+	o, _, err := uc.pricer.price(ctx, nil, dto.CheckoutRequest{
+		RestaurantID:        s.RestaurantID.String(),
+		OrderType:           string(order.TypeDineIn),
+		Items:               input.Items,
+		SpecialInstructions: input.SpecialInstructions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	o.TableSessionID = &s.ID
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Locked so the tab can't be closed or moved while the order is added
+		s, err := uc.sessions.LockByID(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			return table.ErrSessionNotFound
+		}
+		if !s.IsOpen() {
+			return table.ErrSessionClosed
+		}
+		o.TableNumber = s.Table.Number
+		if err := uc.repo.Create(ctx, o); err != nil {
+			return fmt.Errorf("failed to save order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,426 @@
+// Package order contains the use cases for quoting, placing and reading orders.
+package order
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/address"
+	"github.com/james-wukong/orders-api/internal/domain/delivery"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/tax"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// Pricer turns a checkout request into a fully priced, unsaved order.
+// Quote and checkout share it so the customer is charged exactly what was quoted.
+type Pricer struct {
+	restaurants restaurant.Repository
+	menuItems   menu.Repository
+	addresses   address.Repository
+	zones       delivery.Repository
+	taxCalc     tax.Calculator
+	eta         *Estimator
+	loyalty     *loyalty.Ledger
+	wallet      *wallet.Ledger
+}
+
+func NewPricer(
+	restaurants restaurant.Repository,
+	menuItems menu.Repository,
+	addresses address.Repository,
+	zones delivery.Repository,
+	taxCalc tax.Calculator,
+	eta *Estimator,
+	ledger *loyalty.Ledger,
+	wallets *wallet.Ledger,
+) *Pricer {
+	return &Pricer{
+		restaurants: restaurants,
+		menuItems:   menuItems,
+		addresses:   addresses,
+		zones:       zones,
+		taxCalc:     taxCalc,
+		eta:         eta,
+		loyalty:     ledger,
+		wallet:      wallets,
+	}
+}
+
+// price prices the request for the user, or for a guest at a table when
+// userID is nil.
+func (p *Pricer) price(ctx context.Context, userID *uuid.UUID, input dto.CheckoutRequest) (*order.Order, *restaurant.Restaurant, error) {
+	if len(input.Items) == 0 {
+		return nil, nil, order.ErrEmptyOrder
+	}
+
+	// 1. Load and validate the restaurant
+	restaurantID, err := uuid.Parse(input.RestaurantID)
+	if err != nil {
+		return nil, nil, restaurant.ErrRestaurantNotFound
+	}
+	res, err := p.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res == nil {
+		return nil, nil, restaurant.ErrRestaurantNotFound
+	}
+	if !res.IsOpen {
+		return nil, nil, order.ErrRestaurantClosed
+	}
+
+	orderType := order.TypeDelivery
+	if input.OrderType != "" {
+		orderType = order.Type(input.OrderType)
+	}
+	switch orderType {
+	case order.TypeDelivery, order.TypePickup, order.TypeDineIn:
+	default:
+		return nil, nil, order.ErrInvalidOrderType
+	}
+	if userID == nil && orderType != order.TypeDineIn {
+		return nil, nil, order.ErrInvalidOrderType
+	}
+
+	// 2. Load the menu items in one query
+	ids := make([]uuid.UUID, 0, len(input.Items))
+	for _, it := range input.Items {
+		id, err := uuid.Parse(it.MenuItemID)
+		if err != nil {
+			return nil, nil, menu.ErrMenuItemNotFound
+		}
+		ids = append(ids, id)
+	}
+	found, err := p.menuItems.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uuid.UUID]*menu.MenuItem, len(found))
+	for _, m := range found {
+		byID[m.ID] = m
+	}
+
+	// 3. Build order lines and taxable lines
+	o := order.NewOrder(userID, res.ID, orderType)
+	o.DeliveryPhone = input.DeliveryPhone
+	o.DeliveryInstructions = input.DeliveryInstructions
+	o.SpecialInstructions = input.SpecialInstructions
+	if input.PaymentMethod != "" {
+		pm := order.PaymentMethod(input.PaymentMethod)
+		o.PaymentMethod = &pm
+	}
+	if input.Tip != nil {
+		o.Tip = money.Round(*input.Tip)
+	}
+
+	taxLines := make([]tax.Line, 0, len(input.Items)+1)
+	prepMinutes := 0
+	for i, it := range input.Items {
+		m, ok := byID[ids[i]]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %s", menu.ErrMenuItemNotFound, it.MenuItemID)
+		}
+		if m.RestaurantID != res.ID {
+			return nil, nil, fmt.Errorf("%w: %s", menu.ErrMenuItemWrongVenue, m.Name)
+		}
+		if !m.IsAvailable {
+			if m.UnavailableReason != "" {
+				return nil, nil, fmt.Errorf("%w: %s (%s)", menu.ErrMenuItemUnavailable, m.Name, m.UnavailableReason)
+			}
+			return nil, nil, fmt.Errorf("%w: %s", menu.ErrMenuItemUnavailable, m.Name)
+		}
+
+		if m.PreparationTime != nil && *m.PreparationTime > prepMinutes {
+			prepMinutes = *m.PreparationTime
+		}
+
+		unitPrice := m.EffectivePrice()
+		lineTotal := money.Round(unitPrice * float64(it.Quantity))
+		o.Items = append(o.Items, &order.Item{
+			ID:                  uuid.New(),
+			OrderID:             o.ID,
+			MenuItemID:          m.ID,
+			ItemName:            m.Name,
+			ItemDescription:     m.Description,
+			ItemImageURL:        m.ImageURL,
+			Quantity:            it.Quantity,
+			UnitPrice:           unitPrice,
+			Subtotal:            lineTotal,
+			SpecialInstructions: it.SpecialInstructions,
+		})
+		o.Subtotal += lineTotal
+
+		category := m.TaxCategory
+		if category == "" {
+			category = tax.CategoryFood
+		}
+		taxLines = append(taxLines, tax.Line{
+			Category:  category,
+			Amount:    lineTotal,
+			Inclusive: res.PricesIncludeTax,
+		})
+	}
+	o.Subtotal = money.Round(o.Subtotal)
+
+	// Items without a preparation time fall back to the restaurant estimate
+	if prepMinutes == 0 {
+		prepMinutes = res.EstimatedDeliveryTime
+	}
+	o.PrepMinutes = prepMinutes
+
+	// 4. Loyalty points pay for part of the subtotal. Tax is charged on what
+	// is left of each item.
+	if input.RedeemPoints > 0 {
+		if userID == nil {
+			return nil, nil, loyalty.ErrInsufficientPoints
+		}
+		discount, err := p.loyalty.Discount(ctx, *userID, res.ID, input.RedeemPoints, o.Subtotal)
+		if err != nil {
+			return nil, nil, err
+		}
+		o.PointsRedeemed = input.RedeemPoints
+		o.Discount = discount
+		discountLines(taxLines, discount)
+	}
+
+	// 5. Delivery fee and minimum order. Delivery fees are never tax-inclusive.
+	if orderType == order.TypeDelivery {
+		if err := p.applyDelivery(ctx, o, res, input.DeliveryAddressID); err != nil {
+			return nil, nil, err
+		}
+		if o.DeliveryFee > 0 {
+			taxLines = append(taxLines, tax.Line{Category: tax.CategoryDelivery, Amount: o.DeliveryFee})
+		}
+	} else if userID != nil && o.Subtotal < res.MinimumOrder {
+		// Table orders add to a shared tab, so no single one has a minimum
+		return nil, nil, order.ErrBelowMinimumOrder
+	}
+
+	// 6. ETA. Pre-orders are promised for their slot.
+	now := time.Now()
+	if input.ScheduledFor != nil {
+		if err := applySchedule(o, res, *input.ScheduledFor, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := p.eta.Refresh(ctx, o, now); err != nil {
+		return nil, nil, fmt.Errorf("failed to estimate delivery time: %w", err)
+	}
+	o.PromisedDeliveryTime = o.EstimatedDeliveryTime
+
+	// 7. Tax
+	taxRes, err := p.taxCalc.Calculate(ctx, tax.Request{
+		Jurisdiction: tax.Jurisdiction{
+			Country:    res.Country,
+			State:      res.State,
+			PostalCode: res.PostalCode,
+		},
+		Lines: taxLines,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to calculate tax: %w", err)
+	}
+	o.Tax = taxRes.Total
+	o.TaxBreakdown = taxRes.Breakdown
+
+	// 8. Total. Tax already contained in inclusive prices is not added again.
+	o.Total = money.Sum(o.Subtotal, o.DeliveryFee, taxRes.Additional, o.Tip, -o.Discount)
+
+	// 9. Wallet funds
+	if err := p.applyWallet(ctx, o, input.WalletAmount); err != nil {
+		return nil, nil, err
+	}
+
+	return o, res, nil
+}
+
+// discountLines takes discount off the lines in proportion to their
+// amounts, the last line taking what rounding leaves
+func discountLines(lines []tax.Line, discount float64) {
+	var total float64
+	for _, l := range lines {
+		total += l.Amount
+	}
+	if total <= 0 {
+		return
+	}
+	left := discount
+	for i := range lines {
+		share := left
+		if i < len(lines)-1 {
+			share = money.Round(discount * lines[i].Amount / total)
+		}
+		share = math.Min(share, lines[i].Amount)
+		lines[i].Amount = money.Sum(lines[i].Amount, -share)
+		left = money.Sum(left, -share)
+	}
+}
+
+// applyWallet sets how much of the order the wallet pays: all of it when
+// the payment method is wallet, otherwise the amount asked for. The balance
+// is checked again, under lock, when the order is placed.
+func (p *Pricer) applyWallet(ctx context.Context, o *order.Order, requested *float64) error {
+	amount := 0.0
+	switch {
+	case o.PaymentMethod != nil && *o.PaymentMethod == order.PaymentWallet:
+		amount = o.Total
+	case requested != nil:
+		amount = money.Round(*requested)
+		if amount > o.Total {
+			return wallet.ErrWalletAmountTooLarge
+		}
+	}
+	if amount <= 0 {
+		return nil
+	}
+	// Guests ordering at a table have no wallet
+	if o.UserID == nil {
+		return wallet.ErrInsufficientFunds
+	}
+
+	balance, err := p.wallet.Balance(ctx, *o.UserID)
+	if err != nil {
+		return err
+	}
+	if balance < amount {
+		return wallet.ErrInsufficientFunds
+	}
+	o.WalletAmount = amount
+	return nil
+}
+
+// applyDelivery resolves the delivery zone for the order's address and sets
+// the fee, zone, distance, travel time and address snapshot. Restaurants
+// without any zone don't deliver.
+func (p *Pricer) applyDelivery(ctx context.Context, o *order.Order, res *restaurant.Restaurant, addressID string) error {
+	if addressID == "" {
+		return delivery.ErrAddressRequired
+	}
+	id, err := uuid.Parse(addressID)
+	if err != nil {
+		return address.ErrAddressNotFound
+	}
+	addr, err := p.addresses.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if addr == nil || !o.PlacedBy(addr.UserID) {
+		return address.ErrAddressNotFound
+	}
+	if !addr.HasCoordinates() {
+		return delivery.ErrAddressNotLocated
+	}
+
+	o.DeliveryAddressID = &addr.ID
+	o.DeliveryAddress = &order.AddressSnapshot{
+		Label:         addr.Label,
+		StreetAddress: addr.StreetAddress,
+		Apartment:     addr.Apartment,
+		City:          addr.City,
+		State:         addr.State,
+		PostalCode:    addr.PostalCode,
+		Country:       addr.Country,
+		Latitude:      *addr.Latitude,
+		Longitude:     *addr.Longitude,
+	}
+	if o.DeliveryPhone == "" {
+		o.DeliveryPhone = addr.Phone
+	}
+	if o.DeliveryInstructions == "" {
+		o.DeliveryInstructions = addr.DeliveryInstructions
+	}
+
+	zones, err := p.zones.ListByRestaurant(ctx, res.ID)
+	if err != nil {
+		return err
+	}
+	located := res.Latitude != 0 || res.Longitude != 0
+	origin := delivery.Point{Lat: res.Latitude, Lng: res.Longitude}
+	dest := delivery.Point{Lat: *addr.Latitude, Lng: *addr.Longitude}
+
+	// Without zones there is no delivery area to check the address against,
+	// so the restaurant doesn't deliver until it defines one
+	if len(zones) == 0 {
+		return delivery.ErrNoDeliveryZones
+	}
+	if !located {
+		return delivery.ErrRestaurantNotLocated
+	}
+	quote, err := delivery.Resolve(zones, origin, dest)
+	if err != nil {
+		return err
+	}
+
+	// A zone-level minimum overrides the restaurant-wide one
+	minimum := res.MinimumOrder
+	if quote.Zone.MinimumOrder > 0 {
+		minimum = quote.Zone.MinimumOrder
+	}
+	if o.Subtotal < minimum {
+		return delivery.ErrBelowZoneMinimumOrder
+	}
+
+	o.DeliveryFee = quote.Zone.DeliveryFee
+	o.DeliveryZoneID = &quote.Zone.ID
+	extraMinutes := quote.Zone.ExtraETAMinutes
+
+	// Without restaurant coordinates only the handoff time is known
+	distance := 0.0
+	if located {
+		distance = math.Round(delivery.DistanceKm(origin.Lat, origin.Lng, dest.Lat, dest.Lng)*1000) / 1000
+		o.DeliveryDistanceKm = &distance
+	}
+	o.TravelMinutes = p.eta.TravelMinutes(distance, extraMinutes)
+	return nil
+}
+
+// applySchedule validates a requested pre-order time against the restaurant's
+// slots and opening hours, and sets when the order is released to the kitchen:
+// early enough to prepare and deliver it by the scheduled time.
+func applySchedule(o *order.Order, res *restaurant.Restaurant, at time.Time, now time.Time) error {
+	at = at.UTC()
+	if !slotAligned(res, at) {
+		return order.ErrScheduleNotAligned
+	}
+	lead := time.Duration(o.PrepMinutes+o.TravelMinutes) * time.Minute
+	if at.Before(now.Add(lead)) {
+		return order.ErrScheduleTooSoon
+	}
+	if at.After(now.AddDate(0, 0, res.PreorderMaxDays)) {
+		return order.ErrScheduleTooFar
+	}
+	if !res.IsOpenAt(at) {
+		return order.ErrScheduleClosed
+	}
+
+	releaseAt := at.Add(-lead)
+	o.ScheduledFor = &at
+	o.ReleaseAt = &releaseAt
+	return nil
+}
+
+// slotAligned reports whether t is the start of a pre-order slot. Slots are
+// counted from midnight in the restaurant's time zone.
+func slotAligned(res *restaurant.Restaurant, t time.Time) bool {
+	local := t.In(res.Location())
+	if local.Second() != 0 || local.Nanosecond() != 0 {
+		return false
+	}
+	return (local.Hour()*60+local.Minute())%slotMinutes(res) == 0
+}
+
+func slotMinutes(res *restaurant.Restaurant) int {
+	if res.PreorderSlotMinutes <= 0 {
+		return 15
+	}
+	return res.PreorderSlotMinutes
+}
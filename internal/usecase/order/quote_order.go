@@ -0,0 +1,23 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// QuoteOrderUseCase prices a checkout request without persisting it.
+type QuoteOrderUseCase struct {
+	pricer *Pricer
+}
+
+func NewQuoteOrderUseCase(pricer *Pricer) *QuoteOrderUseCase {
+	return &QuoteOrderUseCase{pricer: pricer}
+}
+
+func (uc *QuoteOrderUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.CheckoutRequest) (*order.Order, error) {
+	o, _, err := uc.pricer.price(ctx, &userID, input)
+	return o, err
+}
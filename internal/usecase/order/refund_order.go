@@ -0,0 +1,78 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// RefundOrderUseCase refunds a delivered or cancelled order that was paid.
+// What was paid from the wallet or by card through the payment provider is
+// given back, the loyalty points the order earned are taken back and the
+// ones spent on it given back, in the same transaction. Unpaid orders and
+// orders paid in cash are refused, leaving their points alone: there is
+// nothing to give back, or staff hand the cash back at the restaurant.
+type RefundOrderUseCase struct {
+	repo       order.Repository
+	transactor tx.Transactor
+	loyalty    *loyalty.Ledger
+	payments   *Payments
+}
+
+func NewRefundOrderUseCase(
+	repo order.Repository,
+	transactor tx.Transactor,
+	ledger *loyalty.Ledger,
+	payments *Payments,
+) *RefundOrderUseCase {
+	return &RefundOrderUseCase{
+		repo:       repo,
+		transactor: transactor,
+		loyalty:    ledger,
+		payments:   payments,
+	}
+}
+
+// Execute gives the card part of the payment back to the card, or to the
+// customer's wallet when toWallet is set.
+func (uc *RefundOrderUseCase) Execute(
+	ctx context.Context, orderID uuid.UUID, reason string, toWallet bool,
+) (*order.Order, error) {
+	var o *order.Order
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		// Locked so a refund can't race the delivery that earns the points
+		o, err = uc.repo.LockByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			return order.ErrOrderNotFound
+		}
+
+		now := time.Now()
+		if err := o.Refund(reason, now); err != nil {
+			return err
+		}
+		if err := uc.repo.Update(ctx, o); err != nil {
+			return fmt.Errorf("failed to refund order: %w", err)
+		}
+		note := "order refunded"
+		if reason != "" {
+			note += ": " + reason
+		}
+		if _, err := uc.loyalty.Reverse(ctx, o, note, now); err != nil {
+			return err
+		}
+		return uc.payments.Refund(ctx, o, toWallet)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,119 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/notification"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// ReleaseScheduledOrdersUseCase confirms pre-orders whose release time has
+// passed, which puts them in the kitchen queue, and notifies the customers.
+// Several replicas may run it at once: every order is locked with
+// SKIP LOCKED, so each one is released exactly once.
+//
+// Releasing deducts the order's ingredients from inventory. An order that is
+// rejected for lack of stock stays pending with its release time cleared, so
+// staff can confirm or cancel it by hand: an out-of-stock alert goes to the
+// restaurant's alert subscribers for every missing ingredient, and the
+// customer is told the order is delayed.
+type ReleaseScheduledOrdersUseCase struct {
+	repo       order.Repository
+	transactor tx.Transactor
+	notifier   notification.Notifier
+	eta        *Estimator
+	stock      *StockDeduction
+}
+
+func NewReleaseScheduledOrdersUseCase(
+	repo order.Repository,
+	transactor tx.Transactor,
+	notifier notification.Notifier,
+	eta *Estimator,
+	stock *StockDeduction,
+) *ReleaseScheduledOrdersUseCase {
+	return &ReleaseScheduledOrdersUseCase{
+		repo:       repo,
+		transactor: transactor,
+		notifier:   notifier,
+		eta:        eta,
+		stock:      stock,
+	}
+}
+
+// Execute releases every due order and returns how many were released.
+func (uc *ReleaseScheduledOrdersUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	released := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return released, err
+		}
+
+		// One short transaction per order keeps row locks brief
+		var o *order.Order
+		held := false
+		err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			o, err = uc.repo.LockNextDueForRelease(ctx, now.UTC())
+			if err != nil || o == nil {
+				return err
+			}
+			// A rejected deduction has written nothing, so holding the order
+			// back can commit in the same transaction
+			res, err := uc.stock.Deduct(ctx, o, nil)
+			if err != nil {
+				if !errors.Is(err, inventory.ErrInsufficientStock) {
+					return err
+				}
+				held = true
+				o.ReleaseAt = nil
+				if err := uc.stock.AlertShortages(ctx, o, res.Shortages); err != nil {
+					return err
+				}
+				return uc.repo.Update(ctx, o)
+			}
+			if err := o.TransitionTo(order.StatusConfirmed, now); err != nil {
+				return err
+			}
+			if err := uc.eta.Refresh(ctx, o, now); err != nil {
+				return err
+			}
+			return uc.repo.Update(ctx, o)
+		})
+		if err != nil {
+			return released, fmt.Errorf("failed to release scheduled order: %w", err)
+		}
+		if o == nil {
+			return released, nil
+		}
+		if !held {
+			released++
+		}
+		if o.UserID == nil {
+			continue
+		}
+
+		// The order is committed; a failed notification must not undo it
+		msg := notification.Message{
+			UserID:  *o.UserID,
+			Subject: "Your order is being prepared",
+			Body:    fmt.Sprintf("Order %s has been sent to the kitchen.", o.OrderNumber),
+			Data: map[string]string{
+				"order_id":     o.ID.String(),
+				"order_number": o.OrderNumber,
+				"status":       string(o.Status),
+			},
+		}
+		if held {
+			msg.Subject = "Your order is delayed"
+			msg.Body = fmt.Sprintf("Order %s is short of ingredients. The restaurant will confirm or cancel it shortly.",
+				o.OrderNumber)
+		}
+		_ = uc.notifier.Notify(ctx, msg)
+	}
+}
@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+)
+
+// StockDeduction takes the recipe ingredients of an order out of inventory
+// when the order is confirmed, and puts them back if it is cancelled later.
+// Menu items without a recipe use no stock.
+type StockDeduction struct {
+	recipes  recipe.Repository
+	deductor *inventory.Deductor
+}
+
+func NewStockDeduction(recipes recipe.Repository, deductor *inventory.Deductor) *StockDeduction {
+	return &StockDeduction{
+		recipes:  recipes,
+		deductor: deductor,
+	}
+}
+
+// Deduct must run inside the transaction that confirms the order, so a
+// rejected deduction also rolls the confirmation back. performedBy is nil
+// when the system confirms the order.
+func (s *StockDeduction) Deduct(ctx context.Context, o *order.Order, performedBy *uuid.UUID) (*inventory.DeductionResult, error) {
+	portions := make([]inventory.Portion, 0, len(o.Items))
+	menuItemIDs := make([]uuid.UUID, 0, len(o.Items))
+	for _, item := range o.Items {
+		portions = append(portions, inventory.Portion{MenuItemID: item.MenuItemID, Quantity: item.Quantity})
+		menuItemIDs = append(menuItemIDs, item.MenuItemID)
+	}
+
+	recipes, err := s.recipes.ListByMenuItems(ctx, menuItemIDs)
+	if err != nil {
+		return nil, err
+	}
+	components := make(map[uuid.UUID][]inventory.Component, len(recipes))
+	for _, r := range recipes {
+		components[r.MenuItemID] = r.Components()
+	}
+
+	return s.deductor.Deduct(ctx, stockReference(o), portions, components, performedBy)
+}
+
+// Restore puts back the stock Deduct took for the order. It must run inside
+// the transaction that cancels the order; an order whose stock was never
+// deducted is left alone.
+func (s *StockDeduction) Restore(ctx context.Context, o *order.Order, performedBy *uuid.UUID) ([]*inventory.Transaction, error) {
+	return s.deductor.Restore(ctx, stockReference(o), performedBy)
+}
+
+// AlertShortages raises out-of-stock alerts for what the order was short
+// of, so the people subscribed to the restaurant's alerts hear about it.
+func (s *StockDeduction) AlertShortages(ctx context.Context, o *order.Order, shortages []inventory.Shortage) error {
+	return s.deductor.AlertShortages(ctx, stockReference(o), shortages)
+}
+
+func stockReference(o *order.Order) inventory.Reference {
+	return inventory.Reference{Type: "order", ID: o.ID, Label: "order " + o.OrderNumber}
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/loyalty"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// UpdateOrderStatusUseCase moves an order through the kitchen and delivery
+// workflow and re-estimates its ETA. Cancellation goes through
+// CancelOrderUseCase so pre-order slots are released. Confirming an order
+// deducts its ingredients from inventory in the same transaction, and
+// delivering it credits the customer's loyalty points.
+type UpdateOrderStatusUseCase struct {
+	repo       order.Repository
+	transactor tx.Transactor
+	eta        *Estimator
+	stock      *StockDeduction
+	loyalty    *loyalty.Ledger
+}
+
+func NewUpdateOrderStatusUseCase(
+	repo order.Repository,
+	transactor tx.Transactor,
+	eta *Estimator,
+	stock *StockDeduction,
+	ledger *loyalty.Ledger,
+) *UpdateOrderStatusUseCase {
+	return &UpdateOrderStatusUseCase{
+		repo:       repo,
+		transactor: transactor,
+		eta:        eta,
+		stock:      stock,
+		loyalty:    ledger,
+	}
+}
+
+func (uc *UpdateOrderStatusUseCase) Execute(
+	ctx context.Context,
+	orderID uuid.UUID,
+	status order.Status,
+	performedBy uuid.UUID,
+) (*order.Order, error) {
+	if status == order.StatusCancelled {
+		return nil, order.ErrInvalidTransition
+	}
+
+	var o *order.Order
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		// Locked so two confirmations can't both deduct stock
+		o, err = uc.repo.LockByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			return order.ErrOrderNotFound
+		}
+
+		now := time.Now()
+		if err := o.TransitionTo(status, now); err != nil {
+			return err
+		}
+		switch status {
+		case order.StatusConfirmed:
+			if _, err := uc.stock.Deduct(ctx, o, &performedBy); err != nil {
+				return err
+			}
+		case order.StatusDelivered:
+			if _, err := uc.loyalty.Earn(ctx, o, now); err != nil {
+				return err
+			}
+		}
+		if err := uc.eta.Refresh(ctx, o, now); err != nil {
+			return fmt.Errorf("failed to estimate delivery time: %w", err)
+		}
+		return uc.repo.Update(ctx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,147 @@
+// Package purchasing contains the use cases for raising, approving and
+// receiving purchase orders.
+package purchasing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type CreatePurchaseOrderUseCase struct {
+	repo        purchasing.Repository
+	items       inventory.Repository
+	suppliers   supplier.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreatePurchaseOrderUseCase(
+	repo purchasing.Repository,
+	items inventory.Repository,
+	suppliers supplier.Repository,
+	restaurants restaurant.Repository,
+) *CreatePurchaseOrderUseCase {
+	return &CreatePurchaseOrderUseCase{
+		repo:        repo,
+		items:       items,
+		suppliers:   suppliers,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreatePurchaseOrderUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.CreatePurchaseOrderRequest,
+) (*purchasing.PurchaseOrder, error) {
+	// 1. Validate references
+	restaurantID, err := uuid.Parse(input.RestaurantID)
+	if err != nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	sup, err := findSupplier(ctx, uc.suppliers, input.SupplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Initialize Entity using the Factory
+	po := purchasing.NewPurchaseOrder(res.ID, sup.ID, &userID, time.Now())
+	po.ExpectedDeliveryDate = parseDate(input.ExpectedDeliveryDate)
+	po.Notes = input.Notes
+	po.DeliveryInstructions = input.DeliveryInstructions
+
+	lines, err := buildLines(ctx, uc.items, res.ID, input.Lines)
+	if err != nil {
+		return nil, err
+	}
+	if err := po.SetLines(lines, input.ShippingCost, input.Discount); err != nil {
+		return nil, err
+	}
+
+	// 3. Persist
+	if err := uc.repo.Create(ctx, po); err != nil {
+		return nil, fmt.Errorf("failed to create purchase order: %w", err)
+	}
+	return po, nil
+}
+
+// findSupplier loads an active supplier by its validated id
+func findSupplier(ctx context.Context, suppliers supplier.Repository, id string) (*supplier.Supplier, error) {
+	supplierID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, supplier.ErrSupplierNotFound
+	}
+	sup, err := suppliers.GetByID(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+	if sup == nil {
+		return nil, supplier.ErrSupplierNotFound
+	}
+	if !sup.IsActive {
+		return nil, supplier.ErrSupplierInactive
+	}
+	return sup, nil
+}
+
+// buildLines prices the requested lines. Every item must be an active item
+// of the restaurant and appear once.
+func buildLines(
+	ctx context.Context, items inventory.Repository, restaurantID uuid.UUID, input []dto.PurchaseOrderLineRequest,
+) ([]*purchasing.Line, error) {
+	lines := make([]*purchasing.Line, 0, len(input))
+	seen := make(map[uuid.UUID]bool, len(input))
+	for _, in := range input {
+		itemID, err := uuid.Parse(in.InventoryItemID)
+		if err != nil {
+			return nil, inventory.ErrItemNotFound
+		}
+		if seen[itemID] {
+			return nil, fmt.Errorf("%w: %s", purchasing.ErrDuplicateLine, itemID)
+		}
+		seen[itemID] = true
+
+		item, err := items.GetByID(ctx, itemID)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			return nil, fmt.Errorf("%w: %s", inventory.ErrItemNotFound, itemID)
+		}
+		if item.RestaurantID != restaurantID {
+			return nil, fmt.Errorf("%w: %s", purchasing.ErrLineWrongVenue, item.Name)
+		}
+		if !item.IsActive {
+			return nil, fmt.Errorf("%w: %s", inventory.ErrItemInactive, item.Name)
+		}
+
+		line := purchasing.NewLine(item, in.Quantity, in.UnitPrice, in.Tax)
+		line.Notes = in.Notes
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// parseDate parses a date validated by the binding tags; empty means none
+func parseDate(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
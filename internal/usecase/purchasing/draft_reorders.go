@@ -0,0 +1,73 @@
+package purchasing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// DraftReordersUseCase turns reorder suggestions into draft purchase orders
+// for staff to review and submit. Proposals below the supplier's minimum
+// order amount are left as suggestions. Items already on an open purchase
+// order count as on their way, so running it again drafts nothing new.
+type DraftReordersUseCase struct {
+	suggest    *SuggestReordersUseCase
+	repo       purchasing.Repository
+	transactor tx.Transactor
+}
+
+func NewDraftReordersUseCase(
+	suggest *SuggestReordersUseCase, repo purchasing.Repository, transactor tx.Transactor,
+) *DraftReordersUseCase {
+	return &DraftReordersUseCase{
+		suggest:    suggest,
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+// Execute returns the plan it worked from and the drafts it created.
+// createdBy is nil when the background job drafts them.
+func (uc *DraftReordersUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, mode purchasing.ReorderMode, createdBy *uuid.UUID, now time.Time,
+) (*purchasing.ReorderPlan, []*purchasing.PurchaseOrder, error) {
+	var (
+		plan   *purchasing.ReorderPlan
+		drafts []*purchasing.PurchaseOrder
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, err := uc.repo.TryLockReorders(ctx, restaurantID)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			return purchasing.ErrReorderInProgress
+		}
+
+		if plan, err = uc.suggest.Execute(ctx, restaurantID, mode, now); err != nil {
+			return err
+		}
+		for _, p := range plan.Proposals {
+			if !p.MeetsMinimum {
+				continue
+			}
+			po, err := p.Draft(restaurantID, createdBy, now)
+			if err != nil {
+				return err
+			}
+			if err := uc.repo.Create(ctx, po); err != nil {
+				return fmt.Errorf("failed to create purchase order: %w", err)
+			}
+			drafts = append(drafts, po)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, drafts, nil
+}
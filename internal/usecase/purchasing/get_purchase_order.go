@@ -0,0 +1,27 @@
+package purchasing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+)
+
+type GetPurchaseOrderUseCase struct {
+	repo purchasing.Repository
+}
+
+func NewGetPurchaseOrderUseCase(repo purchasing.Repository) *GetPurchaseOrderUseCase {
+	return &GetPurchaseOrderUseCase{repo: repo}
+}
+
+func (uc *GetPurchaseOrderUseCase) Execute(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrder, error) {
+	po, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if po == nil {
+		return nil, purchasing.ErrPurchaseOrderNotFound
+	}
+	return po, nil
+}
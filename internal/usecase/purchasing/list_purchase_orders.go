@@ -0,0 +1,22 @@
+package purchasing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+)
+
+type ListPurchaseOrdersUseCase struct {
+	repo purchasing.Repository
+}
+
+func NewListPurchaseOrdersUseCase(repo purchasing.Repository) *ListPurchaseOrdersUseCase {
+	return &ListPurchaseOrdersUseCase{repo: repo}
+}
+
+func (uc *ListPurchaseOrdersUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter purchasing.Filter,
+) ([]*purchasing.PurchaseOrder, error) {
+	return uc.repo.ListByRestaurant(ctx, restaurantID, filter)
+}
@@ -0,0 +1,139 @@
+package purchasing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// ReceivePurchaseOrderUseCase books a delivery against an ordered purchase
+// order. Each received line posts a purchase transaction, opens a batch for
+// the lot, updates the item's last purchase cost and moving average cost and
+// records the price paid in the supplier's price history; the whole receipt
+// is one transaction.
+type ReceivePurchaseOrderUseCase struct {
+	repo       purchasing.Repository
+	items      inventory.Repository
+	prices     supplier.PriceRepository
+	transactor tx.Transactor
+}
+
+func NewReceivePurchaseOrderUseCase(
+	repo purchasing.Repository,
+	items inventory.Repository,
+	prices supplier.PriceRepository,
+	transactor tx.Transactor,
+) *ReceivePurchaseOrderUseCase {
+	return &ReceivePurchaseOrderUseCase{
+		repo:       repo,
+		items:      items,
+		prices:     prices,
+		transactor: transactor,
+	}
+}
+
+func (uc *ReceivePurchaseOrderUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.ReceivePurchaseOrderRequest,
+) (*purchasing.PurchaseOrder, []*inventory.Transaction, error) {
+	var (
+		po      *purchasing.PurchaseOrder
+		entries []*inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if po, err = lockPurchaseOrder(ctx, uc.repo, id); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, in := range input.Lines {
+			lineID, err := uuid.Parse(in.LineID)
+			if err != nil {
+				return purchasing.ErrLineNotFound
+			}
+			line, err := po.Receive(lineID, in.Quantity, userID, now)
+			if err != nil {
+				return err
+			}
+
+			item, err := uc.items.LockByID(ctx, line.InventoryItemID)
+			if err != nil {
+				return err
+			}
+			if item == nil {
+				return fmt.Errorf("%w: %s", inventory.ErrItemNotFound, line.InventoryItemID)
+			}
+			unitCost := line.UnitPrice
+			if in.UnitCost != nil {
+				unitCost = *in.UnitCost
+			}
+			entry, err := item.Receive(in.Quantity, unitCost, now)
+			if err != nil {
+				return err
+			}
+			entry.ReferenceType = "purchase_order"
+			entry.ReferenceID = &po.ID
+			entry.Reason = "Received on " + po.PONumber
+			entry.PerformedBy = &userID
+			entry.Notes = input.Notes
+			batchNumber := in.BatchNumber
+			if batchNumber == "" {
+				batchNumber = po.PONumber
+			}
+			batch := item.NewBatch(entry, batchNumber, parseDate(in.ExpiryDate), now)
+
+			if err := uc.items.Update(ctx, item); err != nil {
+				return fmt.Errorf("failed to update stock: %w", err)
+			}
+			if err := uc.items.CreateBatch(ctx, batch); err != nil {
+				return err
+			}
+			if err := uc.items.CreateTransaction(ctx, entry); err != nil {
+				return err
+			}
+			if err := uc.recordPaid(ctx, po, item.ID, unitCost, userID, now); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return uc.repo.Update(ctx, po)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return po, entries, nil
+}
+
+// recordPaid adds what the supplier was paid for the item to its price
+// history, listing the item with the supplier at that price if it isn't yet
+func (uc *ReceivePurchaseOrderUseCase) recordPaid(
+	ctx context.Context, po *purchasing.PurchaseOrder, itemID uuid.UUID, unitCost float64, userID uuid.UUID, at time.Time,
+) error {
+	price, err := uc.prices.GetPrice(ctx, po.SupplierID, itemID)
+	if err != nil {
+		return err
+	}
+	create := price == nil
+	if create {
+		if price, _, err = supplier.NewPrice(po.SupplierID, itemID, unitCost, userID); err != nil {
+			return err
+		}
+	}
+	change := price.Paid(unitCost, po.ID, userID, at)
+	if create {
+		err = uc.prices.CreatePrice(ctx, price)
+	} else {
+		err = uc.prices.UpdatePrice(ctx, price)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save supplier price: %w", err)
+	}
+	return uc.prices.CreatePriceChange(ctx, change)
+}
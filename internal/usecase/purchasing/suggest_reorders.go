@@ -0,0 +1,107 @@
+package purchasing
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// ReorderParams configures reorder suggestions.
+type ReorderParams struct {
+	// Mode is used when a request doesn't name one
+	Mode purchasing.ReorderMode
+	// ForecastDays is how many trailing days of usage forecasts average over
+	ForecastDays int
+}
+
+// SuggestReordersUseCase proposes purchase orders, one per supplier, for
+// the items of a restaurant that have fallen to their reorder point.
+type SuggestReordersUseCase struct {
+	repo      purchasing.Repository
+	items     inventory.Repository
+	suppliers supplier.Repository
+	params    ReorderParams
+}
+
+func NewSuggestReordersUseCase(
+	repo purchasing.Repository,
+	items inventory.Repository,
+	suppliers supplier.Repository,
+	params ReorderParams,
+) *SuggestReordersUseCase {
+	if !params.Mode.Valid() {
+		params.Mode = purchasing.ReorderStandard
+	}
+	if params.ForecastDays <= 0 {
+		params.ForecastDays = 28
+	}
+	return &SuggestReordersUseCase{
+		repo:      repo,
+		items:     items,
+		suppliers: suppliers,
+		params:    params,
+	}
+}
+
+func (uc *SuggestReordersUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, mode purchasing.ReorderMode, now time.Time,
+) (*purchasing.ReorderPlan, error) {
+	if mode == "" {
+		mode = uc.params.Mode
+	}
+	if !mode.Valid() {
+		return nil, purchasing.ErrInvalidReorderMode
+	}
+
+	items, err := uc.items.ListBelowReorderPoint(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	onOrder, err := uc.repo.OnOrder(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemIDs := make([]uuid.UUID, 0, len(items))
+	var supplierIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+		if item.SupplierID != nil && !seen[*item.SupplierID] {
+			seen[*item.SupplierID] = true
+			supplierIDs = append(supplierIDs, *item.SupplierID)
+		}
+	}
+
+	var usage map[uuid.UUID]float64
+	if mode == purchasing.ReorderForecast {
+		to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		from := to.AddDate(0, 0, -uc.params.ForecastDays)
+		if usage, err = uc.items.UsageTotals(ctx, itemIDs, from, to); err != nil {
+			return nil, err
+		}
+	}
+
+	list, err := uc.suppliers.ListByIDs(ctx, supplierIDs)
+	if err != nil {
+		return nil, err
+	}
+	suppliers := make(map[uuid.UUID]*supplier.Supplier, len(list))
+	for _, s := range list {
+		suppliers[s.ID] = s
+	}
+
+	candidates := make([]purchasing.ReorderCandidate, 0, len(items))
+	for _, item := range items {
+		candidates = append(candidates, purchasing.ReorderCandidate{
+			Item:       item,
+			OnOrder:    onOrder[item.ID],
+			DailyUsage: usage[item.ID] / float64(uc.params.ForecastDays),
+		})
+	}
+	return purchasing.PlanReorders(mode, candidates, suppliers, now), nil
+}
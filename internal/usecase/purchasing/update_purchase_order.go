@@ -0,0 +1,83 @@
+package purchasing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// UpdatePurchaseOrderUseCase edits a draft, replacing its lines.
+type UpdatePurchaseOrderUseCase struct {
+	repo       purchasing.Repository
+	items      inventory.Repository
+	suppliers  supplier.Repository
+	transactor tx.Transactor
+}
+
+func NewUpdatePurchaseOrderUseCase(
+	repo purchasing.Repository,
+	items inventory.Repository,
+	suppliers supplier.Repository,
+	transactor tx.Transactor,
+) *UpdatePurchaseOrderUseCase {
+	return &UpdatePurchaseOrderUseCase{
+		repo:       repo,
+		items:      items,
+		suppliers:  suppliers,
+		transactor: transactor,
+	}
+}
+
+func (uc *UpdatePurchaseOrderUseCase) Execute(
+	ctx context.Context, id uuid.UUID, input dto.UpdatePurchaseOrderRequest,
+) (*purchasing.PurchaseOrder, error) {
+	var po *purchasing.PurchaseOrder
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if po, err = lockPurchaseOrder(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if po.Status != purchasing.StatusDraft {
+			return purchasing.ErrNotDraft
+		}
+		sup, err := findSupplier(ctx, uc.suppliers, input.SupplierID)
+		if err != nil {
+			return err
+		}
+		lines, err := buildLines(ctx, uc.items, po.RestaurantID, input.Lines)
+		if err != nil {
+			return err
+		}
+
+		po.SupplierID = sup.ID
+		po.ExpectedDeliveryDate = parseDate(input.ExpectedDeliveryDate)
+		po.Notes = input.Notes
+		po.DeliveryInstructions = input.DeliveryInstructions
+		if err := po.SetLines(lines, input.ShippingCost, input.Discount); err != nil {
+			return err
+		}
+		return uc.repo.ReplaceLines(ctx, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return po, nil
+}
+
+// lockPurchaseOrder loads a purchase order and locks it for the rest of the
+// transaction
+func lockPurchaseOrder(ctx context.Context, repo purchasing.Repository, id uuid.UUID) (*purchasing.PurchaseOrder, error) {
+	po, err := repo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if po == nil {
+		return nil, purchasing.ErrPurchaseOrderNotFound
+	}
+	return po, nil
+}
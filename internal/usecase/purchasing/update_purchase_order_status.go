@@ -0,0 +1,47 @@
+package purchasing
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// UpdatePurchaseOrderStatusUseCase submits, approves, sends back, marks as
+// ordered or cancels a purchase order. Receiving goes through
+// ReceivePurchaseOrderUseCase.
+type UpdatePurchaseOrderStatusUseCase struct {
+	repo       purchasing.Repository
+	transactor tx.Transactor
+}
+
+func NewUpdatePurchaseOrderStatusUseCase(
+	repo purchasing.Repository, transactor tx.Transactor,
+) *UpdatePurchaseOrderStatusUseCase {
+	return &UpdatePurchaseOrderStatusUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *UpdatePurchaseOrderStatusUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, status purchasing.Status,
+) (*purchasing.PurchaseOrder, error) {
+	var po *purchasing.PurchaseOrder
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if po, err = lockPurchaseOrder(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := po.TransitionTo(status, userID, time.Now()); err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return po, nil
+}
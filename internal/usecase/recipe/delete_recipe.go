@@ -0,0 +1,42 @@
+package recipe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+)
+
+// DeleteRecipeUseCase removes a menu item's recipe. From then on confirming
+// an order with that item deducts no stock for it, and running out of stock
+// no longer switches it off.
+type DeleteRecipeUseCase struct {
+	repo      recipe.Repository
+	menuItems menu.Repository
+}
+
+func NewDeleteRecipeUseCase(repo recipe.Repository, menuItems menu.Repository) *DeleteRecipeUseCase {
+	return &DeleteRecipeUseCase{
+		repo:      repo,
+		menuItems: menuItems,
+	}
+}
+
+func (uc *DeleteRecipeUseCase) Execute(ctx context.Context, menuItemID uuid.UUID) error {
+	rec, err := uc.repo.GetByMenuItem(ctx, menuItemID)
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		return recipe.ErrRecipeNotFound
+	}
+	if err := uc.repo.Delete(ctx, rec.ID); err != nil {
+		return err
+	}
+	if err := uc.menuItems.RefreshAvailability(ctx, menuItemID); err != nil {
+		return fmt.Errorf("failed to refresh menu item availability: %w", err)
+	}
+	return nil
+}
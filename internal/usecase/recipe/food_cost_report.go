@@ -0,0 +1,74 @@
+package recipe
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// FoodCostReportUseCase costs every recipe of a restaurant, worst food-cost
+// percentage first, so items above the target stand out.
+type FoodCostReportUseCase struct {
+	repo             recipe.Repository
+	restaurants      restaurant.Repository
+	defaultThreshold float64
+}
+
+func NewFoodCostReportUseCase(
+	repo recipe.Repository, restaurants restaurant.Repository, defaultThreshold float64,
+) *FoodCostReportUseCase {
+	return &FoodCostReportUseCase{
+		repo:             repo,
+		restaurants:      restaurants,
+		defaultThreshold: defaultThreshold,
+	}
+}
+
+// Execute returns the plate costs and the threshold they were judged
+// against. A nil threshold uses the configured default.
+func (uc *FoodCostReportUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, threshold *float64,
+) ([]*recipe.PlateCost, float64, error) {
+	limit := uc.defaultThreshold
+	if threshold != nil {
+		limit = *threshold
+	}
+
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, limit, err
+	}
+	if res == nil {
+		return nil, limit, restaurant.ErrRestaurantNotFound
+	}
+
+	recipes, err := uc.repo.ListByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, limit, err
+	}
+	costs := make([]*recipe.PlateCost, 0, len(recipes))
+	for _, rec := range recipes {
+		pc, err := rec.PlateCost()
+		if err != nil {
+			return nil, limit, err
+		}
+		costs = append(costs, pc)
+	}
+
+	// Free items with a cost sort first
+	percent := func(pc *recipe.PlateCost) float64 {
+		if pc.FoodCostPercent == nil {
+			if pc.Cost > 0 {
+				return math.Inf(1)
+			}
+			return 0
+		}
+		return *pc.FoodCostPercent
+	}
+	sort.SliceStable(costs, func(i, j int) bool { return percent(costs[i]) > percent(costs[j]) })
+	return costs, limit, nil
+}
@@ -0,0 +1,28 @@
+package recipe
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+)
+
+// GetRecipeUseCase returns a menu item's recipe with its plate cost
+type GetRecipeUseCase struct {
+	repo recipe.Repository
+}
+
+func NewGetRecipeUseCase(repo recipe.Repository) *GetRecipeUseCase {
+	return &GetRecipeUseCase{repo: repo}
+}
+
+func (uc *GetRecipeUseCase) Execute(ctx context.Context, menuItemID uuid.UUID) (*recipe.PlateCost, error) {
+	rec, err := uc.repo.GetByMenuItem(ctx, menuItemID)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, recipe.ErrRecipeNotFound
+	}
+	return rec.PlateCost()
+}
@@ -0,0 +1,151 @@
+package recipe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// SaveRecipeUseCase defines a menu item's recipe, replacing the one it has.
+// Ingredients may be given in any unit convertible to the inventory item's
+// unit; the unit is kept so the recipe reads the way the chef wrote it. The
+// menu item is switched off if the new recipe can't be made from stock.
+type SaveRecipeUseCase struct {
+	repo      recipe.Repository
+	menuItems menu.Repository
+	items     inventory.Repository
+	units     inventory.UnitRepository
+}
+
+func NewSaveRecipeUseCase(
+	repo recipe.Repository,
+	menuItems menu.Repository,
+	items inventory.Repository,
+	units inventory.UnitRepository,
+) *SaveRecipeUseCase {
+	return &SaveRecipeUseCase{
+		repo:      repo,
+		menuItems: menuItems,
+		items:     items,
+		units:     units,
+	}
+}
+
+func (uc *SaveRecipeUseCase) Execute(
+	ctx context.Context, menuItemID uuid.UUID, input dto.SaveRecipeRequest,
+) (*recipe.PlateCost, error) {
+	mi, err := uc.menuItems.GetByID(ctx, menuItemID)
+	if err != nil {
+		return nil, err
+	}
+	if mi == nil {
+		return nil, menu.ErrMenuItemNotFound
+	}
+
+	rec, err := uc.repo.GetByMenuItem(ctx, mi.ID)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		rec = recipe.NewRecipe(mi.ID, mi.Name)
+	}
+	if input.Name != "" {
+		rec.Name = input.Name
+	}
+	rec.Description = input.Description
+	rec.Instructions = input.Instructions
+	rec.PreparationTime = input.PreparationTime
+	rec.CookingTime = input.CookingTime
+	rec.ServingSize = 1
+	if input.ServingSize > 0 {
+		rec.ServingSize = input.ServingSize
+	}
+	rec.YieldQuantity = input.YieldQuantity
+	rec.YieldUnit = input.YieldUnit
+	rec.Notes = input.Notes
+
+	ingredients := make([]*recipe.Ingredient, 0, len(input.Ingredients))
+	seen := make(map[uuid.UUID]bool, len(input.Ingredients))
+	for i, line := range input.Ingredients {
+		ing, err := uc.ingredient(ctx, mi, line)
+		if err != nil {
+			return nil, err
+		}
+		if seen[ing.InventoryItemID] {
+			return nil, fmt.Errorf("%w: %s", recipe.ErrDuplicateIngredient, ing.Item.Name)
+		}
+		seen[ing.InventoryItemID] = true
+		ing.RecipeID = rec.ID
+		ing.IngredientOrder = i
+		ingredients = append(ingredients, ing)
+	}
+	rec.Ingredients = ingredients
+
+	if err := uc.repo.Save(ctx, rec); err != nil {
+		return nil, err
+	}
+	if err := uc.menuItems.RefreshAvailability(ctx, mi.ID); err != nil {
+		return nil, fmt.Errorf("failed to refresh menu item availability: %w", err)
+	}
+	rec.MenuItem = mi
+	return rec.PlateCost()
+}
+
+func (uc *SaveRecipeUseCase) ingredient(
+	ctx context.Context, mi *menu.MenuItem, line dto.RecipeIngredientRequest,
+) (*recipe.Ingredient, error) {
+	itemID, err := uuid.Parse(line.InventoryItemID)
+	if err != nil {
+		return nil, inventory.ErrItemNotFound
+	}
+	item, err := uc.items.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", inventory.ErrItemNotFound, itemID)
+	}
+	if item.RestaurantID != mi.RestaurantID {
+		return nil, fmt.Errorf("%w: %s", recipe.ErrIngredientWrongVenue, item.Name)
+	}
+	if !item.IsActive {
+		return nil, fmt.Errorf("%w: %s", inventory.ErrItemInactive, item.Name)
+	}
+
+	unit := item.Unit
+	if line.Unit != "" {
+		if item.Unit == nil {
+			return nil, fmt.Errorf("%w: %s", inventory.ErrItemWithoutUnit, item.Name)
+		}
+		unit, err = uc.units.FindByCode(ctx, line.Unit)
+		if err != nil {
+			return nil, err
+		}
+		if unit == nil {
+			return nil, fmt.Errorf("%w: %s", inventory.ErrUnitNotFound, line.Unit)
+		}
+		if !unit.CompatibleWith(item.Unit) {
+			return nil, fmt.Errorf("%w: %s to %s for %s",
+				inventory.ErrIncompatibleUnits, unit.Abbreviation, item.Unit.Abbreviation, item.Name)
+		}
+	}
+
+	ing := &recipe.Ingredient{
+		ID:              uuid.New(),
+		InventoryItemID: item.ID,
+		Quantity:        inventory.RoundQty(line.Quantity),
+		IsOptional:      line.IsOptional,
+		Notes:           line.Notes,
+		Item:            item,
+		Unit:            unit,
+	}
+	if unit != nil {
+		ing.UnitOfMeasureID = &unit.ID
+	}
+	return ing, nil
+}
@@ -0,0 +1,34 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetCancellationsUseCase reports how many of a restaurant's orders were
+// cancelled, what they were worth and why.
+type GetCancellationsUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetCancellationsUseCase(repo report.Repository, restaurants restaurant.Repository) *GetCancellationsUseCase {
+	return &GetCancellationsUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetCancellationsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (*report.Cancellations, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.Cancellations(ctx, p)
+}
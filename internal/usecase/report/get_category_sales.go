@@ -0,0 +1,34 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetCategorySalesUseCase reports what each menu category sold.
+type GetCategorySalesUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetCategorySalesUseCase(repo report.Repository, restaurants restaurant.Repository) *GetCategorySalesUseCase {
+	return &GetCategorySalesUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetCategorySalesUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (report.Period, []*report.CategorySales, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return p, nil, err
+	}
+	rows, err := uc.repo.SalesByCategory(ctx, p)
+	return p, rows, err
+}
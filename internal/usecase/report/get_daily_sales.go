@@ -0,0 +1,34 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetDailySalesUseCase reports a restaurant's sales per day.
+type GetDailySalesUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetDailySalesUseCase(repo report.Repository, restaurants restaurant.Repository) *GetDailySalesUseCase {
+	return &GetDailySalesUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetDailySalesUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (report.Period, []*report.DailySales, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return p, nil, err
+	}
+	rows, err := uc.repo.SalesByDay(ctx, p)
+	return p, rows, err
+}
@@ -0,0 +1,35 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetHourlySalesUseCase reports a restaurant's sales per hour of the day,
+// showing when it is busiest.
+type GetHourlySalesUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetHourlySalesUseCase(repo report.Repository, restaurants restaurant.Repository) *GetHourlySalesUseCase {
+	return &GetHourlySalesUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetHourlySalesUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (report.Period, []*report.HourlySales, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return p, nil, err
+	}
+	rows, err := uc.repo.SalesByHour(ctx, p)
+	return p, rows, err
+}
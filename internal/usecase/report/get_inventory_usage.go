@@ -0,0 +1,35 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetInventoryUsageUseCase reports what the kitchen used of each item per
+// day.
+type GetInventoryUsageUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetInventoryUsageUseCase(repo report.Repository, restaurants restaurant.Repository) *GetInventoryUsageUseCase {
+	return &GetInventoryUsageUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetInventoryUsageUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (report.Period, []*report.Usage, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return p, nil, err
+	}
+	rows, err := uc.repo.Usage(ctx, p)
+	return p, rows, err
+}
@@ -0,0 +1,30 @@
+package report
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetInventoryValueUseCase reports what a restaurant's stock is worth now,
+// item by item, flagging items that are low.
+type GetInventoryValueUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetInventoryValueUseCase(repo report.Repository, restaurants restaurant.Repository) *GetInventoryValueUseCase {
+	return &GetInventoryValueUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetInventoryValueUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) ([]*report.StockValue, error) {
+	if err := checkRestaurant(ctx, uc.restaurants, restaurantID); err != nil {
+		return nil, err
+	}
+	return uc.repo.InventoryValue(ctx, restaurantID)
+}
@@ -0,0 +1,34 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetItemSalesUseCase reports what each menu item sold.
+type GetItemSalesUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetItemSalesUseCase(repo report.Repository, restaurants restaurant.Repository) *GetItemSalesUseCase {
+	return &GetItemSalesUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetItemSalesUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (report.Period, []*report.ItemSales, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return p, nil, err
+	}
+	rows, err := uc.repo.SalesByItem(ctx, p)
+	return p, rows, err
+}
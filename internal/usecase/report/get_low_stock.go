@@ -0,0 +1,30 @@
+package report
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetLowStockUseCase lists a restaurant's items at or below their minimum
+// stock with who to reorder from.
+type GetLowStockUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetLowStockUseCase(repo report.Repository, restaurants restaurant.Repository) *GetLowStockUseCase {
+	return &GetLowStockUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetLowStockUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) ([]*report.LowStockItem, error) {
+	if err := checkRestaurant(ctx, uc.restaurants, restaurantID); err != nil {
+		return nil, err
+	}
+	return uc.repo.LowStock(ctx, restaurantID)
+}
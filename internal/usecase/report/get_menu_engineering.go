@@ -0,0 +1,63 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/menu"
+	"github.com/james-wukong/orders-api/internal/domain/recipe"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetMenuEngineeringUseCase sorts a restaurant's menu into stars,
+// plowhorses, puzzles and dogs from what each item sold and what its recipe
+// costs to plate.
+type GetMenuEngineeringUseCase struct {
+	repo        report.Repository
+	menus       menu.Repository
+	recipes     recipe.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetMenuEngineeringUseCase(
+	repo report.Repository, menus menu.Repository, recipes recipe.Repository, restaurants restaurant.Repository,
+) *GetMenuEngineeringUseCase {
+	return &GetMenuEngineeringUseCase{
+		repo:        repo,
+		menus:       menus,
+		recipes:     recipes,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetMenuEngineeringUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (*report.MenuEngineering, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	items, err := uc.menus.ListByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	sales, err := uc.repo.SalesByItem(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	recipes, err := uc.recipes.ListByRestaurant(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	plateCosts := make(map[uuid.UUID]float64, len(recipes))
+	for _, rec := range recipes {
+		pc, err := rec.PlateCost()
+		if err != nil {
+			return nil, err
+		}
+		plateCosts[rec.MenuItemID] = pc.Cost
+	}
+	return report.EngineerMenu(p, items, sales, plateCosts), nil
+}
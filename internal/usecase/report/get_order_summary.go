@@ -0,0 +1,42 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// GetOrderSummaryUseCase reports a restaurant's average order value and its
+// mix of delivery, pickup and dine-in orders.
+type GetOrderSummaryUseCase struct {
+	repo        report.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetOrderSummaryUseCase(repo report.Repository, restaurants restaurant.Repository) *GetOrderSummaryUseCase {
+	return &GetOrderSummaryUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetOrderSummaryUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, from, to time.Time,
+) (*report.OrderSummary, error) {
+	p, err := resolvePeriod(ctx, uc.restaurants, restaurantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	types, err := uc.repo.SalesByOrderType(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	summary := &report.OrderSummary{Period: p, Types: types}
+	for _, t := range types {
+		summary.Totals.Add(t.Sales)
+	}
+	return summary, nil
+}
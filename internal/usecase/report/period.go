@@ -0,0 +1,68 @@
+// Package report contains the use cases behind the reporting endpoints:
+// each checks the restaurant and the period before reading its report.
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/report"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+)
+
+// maxPeriod bounds how much a single report scans
+const maxPeriod = 366 * 24 * time.Hour
+
+// resolvePeriod checks the restaurant exists and fills in [from, to): a
+// zero range defaults to the last 30 days. Dates are midnights on the
+// restaurant's clock, as its days are the ones reports are cut by.
+func resolvePeriod(
+	ctx context.Context, restaurants restaurant.Repository, restaurantID uuid.UUID, from, to time.Time,
+) (report.Period, error) {
+	res, err := getRestaurant(ctx, restaurants, restaurantID)
+	if err != nil {
+		return report.Period{}, err
+	}
+	loc := res.Location()
+	if to.IsZero() {
+		to = time.Now().In(loc)
+	} else {
+		to = inZone(to, loc)
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -30)
+	} else {
+		from = inZone(from, loc)
+	}
+	if !from.Before(to) {
+		return report.Period{}, report.ErrInvalidPeriod
+	}
+	if to.Sub(from) > maxPeriod {
+		return report.Period{}, report.ErrPeriodTooLong
+	}
+	return report.Period{RestaurantID: restaurantID, From: from, To: to}, nil
+}
+
+// inZone is the same wall-clock time as t, in loc
+func inZone(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+func checkRestaurant(ctx context.Context, restaurants restaurant.Repository, restaurantID uuid.UUID) error {
+	_, err := getRestaurant(ctx, restaurants, restaurantID)
+	return err
+}
+
+func getRestaurant(
+	ctx context.Context, restaurants restaurant.Repository, restaurantID uuid.UUID,
+) (*restaurant.Restaurant, error) {
+	res, err := restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	return res, nil
+}
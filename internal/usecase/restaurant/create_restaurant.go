@@ -64,6 +64,12 @@ func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, input dto.Create
 	if input.EstimatedDeliveryTime != nil {
 		res.EstimatedDeliveryTime = *input.EstimatedDeliveryTime
 	}
+	if input.PricesIncludeTax != nil {
+		res.PricesIncludeTax = *input.PricesIncludeTax
+	}
+	if input.ValuationMethod != nil {
+		res.ValuationMethod = *input.ValuationMethod
+	}
 
 	// 5. Save to Repository
 	if err := uc.repo.Create(ctx, res); err != nil {
@@ -0,0 +1,77 @@
+// Package review contains the use cases for reviewing delivered orders,
+// replying to reviews and moderating them. Every change to which reviews
+// are visible refreshes the restaurant's rating in the same transaction.
+package review
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// CreateReviewUseCase lets a customer review one of their delivered orders,
+// once.
+type CreateReviewUseCase struct {
+	repo       review.Repository
+	orders     order.Repository
+	transactor tx.Transactor
+}
+
+func NewCreateReviewUseCase(repo review.Repository, orders order.Repository, transactor tx.Transactor) *CreateReviewUseCase {
+	return &CreateReviewUseCase{
+		repo:       repo,
+		orders:     orders,
+		transactor: transactor,
+	}
+}
+
+func (uc *CreateReviewUseCase) Execute(
+	ctx context.Context, userID, orderID uuid.UUID, input dto.CreateReviewRequest,
+) (*review.Review, error) {
+	items := make([]review.ItemInput, 0, len(input.Items))
+	for _, in := range input.Items {
+		id, err := uuid.Parse(in.OrderItemID)
+		if err != nil {
+			return nil, review.ErrItemNotInOrder
+		}
+		items = append(items, review.ItemInput{OrderItemID: id, Rating: in.Rating, Comment: in.Comment})
+	}
+
+	var rv *review.Review
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The lock keeps two submissions of the same review apart
+		o, err := uc.orders.LockByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			return order.ErrOrderNotFound
+		}
+		if !o.PlacedBy(userID) {
+			return order.ErrNotOrderOwner
+		}
+		existing, err := uc.repo.GetByOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return review.ErrAlreadyReviewed
+		}
+
+		if rv, err = review.NewReview(o, input.Rating, input.Comment, items); err != nil {
+			return err
+		}
+		if err := uc.repo.Create(ctx, rv); err != nil {
+			return err
+		}
+		return uc.repo.RefreshRestaurantRating(ctx, rv.RestaurantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
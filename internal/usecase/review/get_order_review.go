@@ -0,0 +1,47 @@
+package review
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+	"github.com/james-wukong/orders-api/internal/domain/user"
+)
+
+// GetOrderReviewUseCase returns an order's review to the customer who
+// placed it or to staff, hidden or not.
+type GetOrderReviewUseCase struct {
+	repo   review.Repository
+	orders order.Repository
+}
+
+func NewGetOrderReviewUseCase(repo review.Repository, orders order.Repository) *GetOrderReviewUseCase {
+	return &GetOrderReviewUseCase{
+		repo:   repo,
+		orders: orders,
+	}
+}
+
+func (uc *GetOrderReviewUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, role user.Role, orderID uuid.UUID,
+) (*review.Review, error) {
+	o, err := uc.orders.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, order.ErrOrderNotFound
+	}
+	if !role.Valid() || (!role.SeesAllOrders() && !o.PlacedBy(userID)) {
+		return nil, order.ErrNotOrderOwner
+	}
+	rv, err := uc.repo.GetByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if rv == nil {
+		return nil, review.ErrReviewNotFound
+	}
+	return rv, nil
+}
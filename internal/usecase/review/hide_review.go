@@ -0,0 +1,48 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// HideReviewUseCase lets an admin take a review down, out of listings and
+// the restaurant's rating.
+type HideReviewUseCase struct {
+	repo       review.Repository
+	transactor tx.Transactor
+}
+
+func NewHideReviewUseCase(repo review.Repository, transactor tx.Transactor) *HideReviewUseCase {
+	return &HideReviewUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *HideReviewUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.HideReviewRequest,
+) (*review.Review, error) {
+	var rv *review.Review
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if rv, err = lockReview(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := rv.Hide(input.Reason, userID, time.Now()); err != nil {
+			return err
+		}
+		if err := uc.repo.Update(ctx, rv); err != nil {
+			return err
+		}
+		return uc.repo.RefreshRestaurantRating(ctx, rv.RestaurantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
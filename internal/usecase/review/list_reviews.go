@@ -0,0 +1,51 @@
+package review
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+)
+
+// ListReviewsUseCase pages through a restaurant's reviews, newest first,
+// with a summary of its visible ones.
+type ListReviewsUseCase struct {
+	repo        review.Repository
+	restaurants restaurant.Repository
+}
+
+func NewListReviewsUseCase(repo review.Repository, restaurants restaurant.Repository) *ListReviewsUseCase {
+	return &ListReviewsUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *ListReviewsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter review.Filter,
+) (*review.Summary, []*review.Review, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res == nil {
+		return nil, nil, restaurant.ErrRestaurantNotFound
+	}
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 100
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	summary, err := uc.repo.Summarize(ctx, restaurantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	reviews, err := uc.repo.ListByRestaurant(ctx, restaurantID, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return summary, reviews, nil
+}
@@ -0,0 +1,56 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// ReplyReviewUseCase posts the restaurant's public reply to a review, or
+// edits the one it already has.
+type ReplyReviewUseCase struct {
+	repo       review.Repository
+	transactor tx.Transactor
+}
+
+func NewReplyReviewUseCase(repo review.Repository, transactor tx.Transactor) *ReplyReviewUseCase {
+	return &ReplyReviewUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *ReplyReviewUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.ReplyReviewRequest,
+) (*review.Review, error) {
+	var rv *review.Review
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if rv, err = lockReview(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := rv.Respond(input.Reply, userID, time.Now()); err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, rv)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+func lockReview(ctx context.Context, repo review.Repository, id uuid.UUID) (*review.Review, error) {
+	rv, err := repo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rv == nil {
+		return nil, review.ErrReviewNotFound
+	}
+	return rv, nil
+}
@@ -0,0 +1,45 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/review"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// RestoreReviewUseCase shows a hidden review again, counting it back into
+// the restaurant's rating.
+type RestoreReviewUseCase struct {
+	repo       review.Repository
+	transactor tx.Transactor
+}
+
+func NewRestoreReviewUseCase(repo review.Repository, transactor tx.Transactor) *RestoreReviewUseCase {
+	return &RestoreReviewUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *RestoreReviewUseCase) Execute(ctx context.Context, userID, id uuid.UUID) (*review.Review, error) {
+	var rv *review.Review
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if rv, err = lockReview(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := rv.Restore(userID, time.Now()); err != nil {
+			return err
+		}
+		if err := uc.repo.Update(ctx, rv); err != nil {
+			return err
+		}
+		return uc.repo.RefreshRestaurantRating(ctx, rv.RestaurantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
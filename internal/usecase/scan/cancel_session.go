@@ -0,0 +1,41 @@
+package scan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// CancelSessionUseCase drops an open session without booking anything.
+// Unknown barcodes it queued stay in the queue.
+type CancelSessionUseCase struct {
+	repo       scan.Repository
+	transactor tx.Transactor
+}
+
+func NewCancelSessionUseCase(repo scan.Repository, transactor tx.Transactor) *CancelSessionUseCase {
+	return &CancelSessionUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *CancelSessionUseCase) Execute(ctx context.Context, id uuid.UUID) (*scan.Session, error) {
+	var s *scan.Session
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if s, err = lockSession(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := s.Cancel(); err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
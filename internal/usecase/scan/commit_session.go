@@ -0,0 +1,143 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	purchasingUC "github.com/james-wukong/orders-api/internal/usecase/purchasing"
+	stocktakeUC "github.com/james-wukong/orders-api/internal/usecase/stocktake"
+)
+
+// CommitSessionUseCase books what a session scanned, in the same
+// transaction as closing it. A receiving session receives its lines against
+// the purchase order, batch and expiry included; a count session records
+// each item's scanned total as its count on the stocktake.
+type CommitSessionUseCase struct {
+	repo           scan.Repository
+	purchaseOrders purchasing.Repository
+	receiveUC      *purchasingUC.ReceivePurchaseOrderUseCase
+	recordCountsUC *stocktakeUC.RecordCountsUseCase
+	transactor     tx.Transactor
+}
+
+func NewCommitSessionUseCase(
+	repo scan.Repository,
+	purchaseOrders purchasing.Repository,
+	receiveUC *purchasingUC.ReceivePurchaseOrderUseCase,
+	recordCountsUC *stocktakeUC.RecordCountsUseCase,
+	transactor tx.Transactor,
+) *CommitSessionUseCase {
+	return &CommitSessionUseCase{
+		repo:           repo,
+		purchaseOrders: purchaseOrders,
+		receiveUC:      receiveUC,
+		recordCountsUC: recordCountsUC,
+		transactor:     transactor,
+	}
+}
+
+func (uc *CommitSessionUseCase) Execute(ctx context.Context, userID, id uuid.UUID) (*scan.Session, error) {
+	var s *scan.Session
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if s, err = lockSession(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := s.Commit(userID, time.Now()); err != nil {
+			return err
+		}
+
+		switch s.Type {
+		case scan.TypeReceiving:
+			err = uc.receive(ctx, userID, s)
+		case scan.TypeCount:
+			err = uc.count(ctx, userID, s)
+		}
+		if err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// receive spreads each scanned line over the purchase order's lines for
+// the item, in order, up to what each has outstanding
+func (uc *CommitSessionUseCase) receive(ctx context.Context, userID uuid.UUID, s *scan.Session) error {
+	po, err := uc.purchaseOrders.GetByID(ctx, *s.PurchaseOrderID)
+	if err != nil {
+		return err
+	}
+	if po == nil {
+		return purchasing.ErrPurchaseOrderNotFound
+	}
+	outstanding := make(map[uuid.UUID]float64, len(po.Lines))
+	for _, l := range po.Lines {
+		outstanding[l.ID] = l.Outstanding()
+	}
+
+	req := dto.ReceivePurchaseOrderRequest{Notes: s.Notes}
+	for _, sl := range s.Lines {
+		left := sl.Quantity
+		for _, l := range po.Lines {
+			if left <= 0 {
+				break
+			}
+			if l.InventoryItemID != sl.InventoryItemID || outstanding[l.ID] <= 0 {
+				continue
+			}
+			qty := min(left, outstanding[l.ID])
+			outstanding[l.ID] = inventory.RoundQty(outstanding[l.ID] - qty)
+			left = inventory.RoundQty(left - qty)
+
+			rl := dto.ReceiptLineRequest{LineID: l.ID.String(), Quantity: qty, BatchNumber: sl.BatchNumber}
+			if sl.ExpiryDate != nil {
+				rl.ExpiryDate = sl.ExpiryDate.Format(time.DateOnly)
+			}
+			req.Lines = append(req.Lines, rl)
+		}
+		if left > 0 {
+			return fmt.Errorf("%w: %s", purchasing.ErrOverReceipt, itemName(sl))
+		}
+	}
+	_, _, err = uc.receiveUC.Execute(ctx, userID, po.ID, req)
+	return err
+}
+
+// count records each item's total over batches as its count
+func (uc *CommitSessionUseCase) count(ctx context.Context, userID uuid.UUID, s *scan.Session) error {
+	totals := s.Totals()
+	req := dto.RecordCountsRequest{}
+	for _, sl := range s.Lines {
+		// Items whose scans were all taken back weren't counted
+		qty, ok := totals[sl.InventoryItemID]
+		if !ok || qty <= 0 {
+			continue
+		}
+		delete(totals, sl.InventoryItemID)
+		req.Counts = append(req.Counts, dto.StockCountRequest{
+			InventoryItemID: sl.InventoryItemID.String(),
+			Quantity:        &qty,
+			Notes:           "Scanned",
+		})
+	}
+	_, err := uc.recordCountsUC.Execute(ctx, userID, *s.StocktakeID, req)
+	return err
+}
+
+func itemName(l *scan.Line) string {
+	if l.Item != nil {
+		return l.Item.Name
+	}
+	return l.InventoryItemID.String()
+}
@@ -0,0 +1,33 @@
+package scan
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+)
+
+// DismissBarcodeUseCase drops a queued scan that wasn't stock, such as a
+// delivery note's barcode, so it no longer holds up its session.
+type DismissBarcodeUseCase struct {
+	repo scan.Repository
+}
+
+func NewDismissBarcodeUseCase(repo scan.Repository) *DismissBarcodeUseCase {
+	return &DismissBarcodeUseCase{repo: repo}
+}
+
+func (uc *DismissBarcodeUseCase) Execute(ctx context.Context, userID, id uuid.UUID) (*scan.UnknownBarcode, error) {
+	u, err := getUnknown(ctx, uc.repo, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Dismiss(userID, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateUnknown(ctx, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
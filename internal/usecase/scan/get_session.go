@@ -0,0 +1,40 @@
+package scan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+)
+
+type GetSessionUseCase struct {
+	repo scan.Repository
+}
+
+func NewGetSessionUseCase(repo scan.Repository) *GetSessionUseCase {
+	return &GetSessionUseCase{repo: repo}
+}
+
+func (uc *GetSessionUseCase) Execute(ctx context.Context, id uuid.UUID) (*scan.Session, error) {
+	s, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, scan.ErrSessionNotFound
+	}
+	return s, nil
+}
+
+// lockSession loads a scan session and locks it for the rest of the
+// transaction
+func lockSession(ctx context.Context, repo scan.Repository, id uuid.UUID) (*scan.Session, error) {
+	s, err := repo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, scan.ErrSessionNotFound
+	}
+	return s, nil
+}
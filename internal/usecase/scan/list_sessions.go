@@ -0,0 +1,22 @@
+package scan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+)
+
+type ListSessionsUseCase struct {
+	repo scan.Repository
+}
+
+func NewListSessionsUseCase(repo scan.Repository) *ListSessionsUseCase {
+	return &ListSessionsUseCase{repo: repo}
+}
+
+func (uc *ListSessionsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter scan.Filter,
+) ([]*scan.Session, error) {
+	return uc.repo.ListByRestaurant(ctx, restaurantID, filter)
+}
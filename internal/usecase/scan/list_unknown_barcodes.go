@@ -0,0 +1,36 @@
+package scan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+)
+
+// ListUnknownBarcodesUseCase returns the restaurant's queue of barcodes
+// waiting to be mapped to an item, or those in another status.
+type ListUnknownBarcodesUseCase struct {
+	repo scan.Repository
+}
+
+func NewListUnknownBarcodesUseCase(repo scan.Repository) *ListUnknownBarcodesUseCase {
+	return &ListUnknownBarcodesUseCase{repo: repo}
+}
+
+func (uc *ListUnknownBarcodesUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, status scan.UnknownStatus,
+) ([]*scan.UnknownBarcode, error) {
+	return uc.repo.ListUnknown(ctx, restaurantID, status)
+}
+
+// getUnknown loads a queued barcode
+func getUnknown(ctx context.Context, repo scan.Repository, id uuid.UUID) (*scan.UnknownBarcode, error) {
+	u, err := repo.GetUnknown(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, scan.ErrUnknownNotFound
+	}
+	return u, nil
+}
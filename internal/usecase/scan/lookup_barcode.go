@@ -0,0 +1,86 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+// LookupBarcodeUseCase finds the restaurant's item a scanned barcode
+// belongs to, reading batch, expiry and quantity from GS1-128 codes.
+type LookupBarcodeUseCase struct {
+	items       inventory.Repository
+	restaurants restaurant.Repository
+}
+
+func NewLookupBarcodeUseCase(items inventory.Repository, restaurants restaurant.Repository) *LookupBarcodeUseCase {
+	return &LookupBarcodeUseCase{
+		items:       items,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *LookupBarcodeUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, raw string,
+) (*inventory.Item, gs1.Code, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, gs1.Code{}, err
+	}
+	if res == nil {
+		return nil, gs1.Code{}, restaurant.ErrRestaurantNotFound
+	}
+	code, item, err := findByBarcode(ctx, uc.items, restaurantID, raw)
+	if err != nil {
+		return nil, code, err
+	}
+	if item == nil {
+		return nil, code, scan.ErrBarcodeNotFound
+	}
+	return item, code, nil
+}
+
+// findByBarcode parses a scan and finds the restaurant's item with the
+// barcode; the item is nil when none has it
+func findByBarcode(
+	ctx context.Context, items inventory.Repository, restaurantID uuid.UUID, raw string,
+) (gs1.Code, *inventory.Item, error) {
+	code, err := gs1.Parse(raw)
+	if err != nil {
+		return code, nil, err
+	}
+	item, err := items.GetByBarcode(ctx, restaurantID, code.Candidates())
+	if err != nil {
+		return code, nil, err
+	}
+	return code, item, nil
+}
+
+// scanQuantity is how much of item, in its unit, one scan of code is: the
+// quantity given, else the pack's net weight for items kept by weight, else
+// the pack's count, else one
+func scanQuantity(
+	ctx context.Context, units inventory.UnitRepository, item *inventory.Item, code gs1.Code, given *float64,
+) (float64, error) {
+	switch {
+	case given != nil:
+		return *given, nil
+	case code.NetWeight != nil && item.Unit != nil && item.Unit.Type == inventory.UnitWeight:
+		kg, err := units.FindByCode(ctx, "kg")
+		if err != nil {
+			return 0, err
+		}
+		if kg == nil {
+			return 0, fmt.Errorf("%w: kg", inventory.ErrUnitNotFound)
+		}
+		return inventory.Convert(*code.NetWeight, kg, item.Unit)
+	case code.Count != nil:
+		return *code.Count, nil
+	}
+	return 1, nil
+}
@@ -0,0 +1,182 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+// MapBarcodeUseCase gives an unknown barcode to an item. Every pending scan
+// of the barcode at the restaurant is mapped with it, and those made in
+// sessions still open are added to them as if the item had been scanned.
+type MapBarcodeUseCase struct {
+	repo       scan.Repository
+	scanner    scanner
+	transactor tx.Transactor
+}
+
+func NewMapBarcodeUseCase(
+	repo scan.Repository,
+	items inventory.Repository,
+	units inventory.UnitRepository,
+	purchaseOrders purchasing.Repository,
+	stocktakes stocktake.Repository,
+	transactor tx.Transactor,
+) *MapBarcodeUseCase {
+	return &MapBarcodeUseCase{
+		repo:       repo,
+		scanner:    scanner{items: items, units: units, purchaseOrders: purchaseOrders, stocktakes: stocktakes},
+		transactor: transactor,
+	}
+}
+
+// Execute returns the item and the session lines the queued scans were
+// added to. Scans a session can't take, such as an item not on its purchase
+// order, are mapped without being added.
+func (uc *MapBarcodeUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.MapBarcodeRequest,
+) (*inventory.Item, []*scan.Line, error) {
+	var (
+		item  *inventory.Item
+		lines []*scan.Line
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		u, err := getUnknown(ctx, uc.repo, id)
+		if err != nil {
+			return err
+		}
+		if u.Status != scan.UnknownPending {
+			return scan.ErrUnknownResolved
+		}
+		if item, err = uc.claim(ctx, u, input); err != nil {
+			return err
+		}
+
+		pending, err := uc.repo.LockPendingUnknown(ctx, u.RestaurantID, u.Barcode)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		sessions := map[uuid.UUID]*scan.Session{}
+		for _, p := range pending {
+			if err := p.Map(item.ID, userID, now); err != nil {
+				return err
+			}
+			if err := uc.repo.UpdateUnknown(ctx, p); err != nil {
+				return err
+			}
+			if p.ScanSessionID == nil {
+				continue
+			}
+			s, ok := sessions[*p.ScanSessionID]
+			if !ok {
+				if s, err = lockSession(ctx, uc.repo, *p.ScanSessionID); err != nil {
+					return err
+				}
+				sessions[s.ID] = s
+			}
+			if s.Status != scan.StatusOpen {
+				continue
+			}
+			line, err := uc.replay(ctx, s, item, p, userID, now)
+			if err != nil {
+				return err
+			}
+			if line != nil && !slices.Contains(lines, line) {
+				lines = append(lines, line)
+			}
+		}
+		for _, s := range sessions {
+			if s.Status == scan.StatusOpen {
+				if err := uc.repo.Update(ctx, s); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, lines, nil
+}
+
+// claim gives the barcode to the item, unless another item has it or the
+// item has a barcode of its own that isn't to be replaced
+func (uc *MapBarcodeUseCase) claim(
+	ctx context.Context, u *scan.UnknownBarcode, input dto.MapBarcodeRequest,
+) (*inventory.Item, error) {
+	itemID, err := uuid.Parse(input.InventoryItemID)
+	if err != nil {
+		return nil, inventory.ErrItemNotFound
+	}
+	item, err := uc.scanner.items.LockByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, inventory.ErrItemNotFound
+	}
+	if item.RestaurantID != u.RestaurantID {
+		return nil, scan.ErrItemWrongVenue
+	}
+	if item.Barcode == u.Barcode {
+		return item, nil
+	}
+	if item.Barcode != "" && !input.Replace {
+		return nil, scan.ErrItemHasBarcode
+	}
+
+	code, err := gs1.Parse(u.Barcode)
+	if err != nil {
+		return nil, err
+	}
+	candidates := code.Candidates()
+	other, err := uc.scanner.items.GetByBarcode(ctx, u.RestaurantID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	if other != nil && other.ID != item.ID && slices.Contains(candidates, other.Barcode) {
+		return nil, inventory.ErrDuplicateBarcode
+	}
+	item.Barcode = u.Barcode
+	if err := uc.scanner.items.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update item: %w", err)
+	}
+	return item, nil
+}
+
+// replay adds a queued scan to its session, returning nil if the session
+// can't take it
+func (uc *MapBarcodeUseCase) replay(
+	ctx context.Context, s *scan.Session, item *inventory.Item, u *scan.UnknownBarcode, userID uuid.UUID, at time.Time,
+) (*scan.Line, error) {
+	// The raw code parsed when it was scanned
+	code, _ := gs1.Parse(u.RawCode)
+	by := userID
+	if u.ScannedBy != nil {
+		by = *u.ScannedBy
+	}
+	line, err := uc.scanner.add(ctx, s, item, code, u.Quantity, by, at)
+	switch {
+	case errors.Is(err, scan.ErrItemNotExpected),
+		errors.Is(err, scan.ErrBelowZero),
+		errors.Is(err, inventory.ErrItemInactive),
+		errors.Is(err, inventory.ErrIncompatibleUnits):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return line, nil
+}
@@ -0,0 +1,130 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/pkg/gs1"
+)
+
+// RecordScanUseCase adds a scan to an open session. A barcode no item of
+// the restaurant carries is queued for mapping instead, and booked into the
+// session once it is mapped.
+type RecordScanUseCase struct {
+	repo       scan.Repository
+	scanner    scanner
+	transactor tx.Transactor
+}
+
+func NewRecordScanUseCase(
+	repo scan.Repository,
+	items inventory.Repository,
+	units inventory.UnitRepository,
+	purchaseOrders purchasing.Repository,
+	stocktakes stocktake.Repository,
+	transactor tx.Transactor,
+) *RecordScanUseCase {
+	return &RecordScanUseCase{
+		repo:       repo,
+		scanner:    scanner{items: items, units: units, purchaseOrders: purchaseOrders, stocktakes: stocktakes},
+		transactor: transactor,
+	}
+}
+
+func (uc *RecordScanUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.RecordScanRequest,
+) (*scan.Outcome, error) {
+	var outcome *scan.Outcome
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		s, err := lockSession(ctx, uc.repo, id)
+		if err != nil {
+			return err
+		}
+		if s.Status != scan.StatusOpen {
+			return scan.ErrNotOpen
+		}
+
+		code, item, err := findByBarcode(ctx, uc.scanner.items, s.RestaurantID, input.Code)
+		if err != nil {
+			return err
+		}
+		outcome = &scan.Outcome{Code: code}
+		if item == nil {
+			outcome.Unknown = scan.NewUnknownBarcode(s.RestaurantID, &s.ID, code, input.Quantity, userID)
+			return uc.repo.CreateUnknown(ctx, outcome.Unknown)
+		}
+
+		if outcome.Line, err = uc.scanner.add(ctx, s, item, code, input.Quantity, userID, time.Now()); err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// scanner adds scans of known items to sessions
+type scanner struct {
+	items          inventory.Repository
+	units          inventory.UnitRepository
+	purchaseOrders purchasing.Repository
+	stocktakes     stocktake.Repository
+}
+
+// add scans item into s, which must be waiting for it: on the purchase
+// order being received or in the stocktake being counted
+func (sc scanner) add(
+	ctx context.Context, s *scan.Session, item *inventory.Item, code gs1.Code, given *float64, by uuid.UUID, at time.Time,
+) (*scan.Line, error) {
+	if !item.IsActive {
+		return nil, fmt.Errorf("%w: %s", inventory.ErrItemInactive, item.Name)
+	}
+	expected, err := sc.expects(ctx, s, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !expected {
+		return nil, fmt.Errorf("%w: %s", scan.ErrItemNotExpected, item.Name)
+	}
+	qty, err := scanQuantity(ctx, sc.units, item, code, given)
+	if err != nil {
+		return nil, err
+	}
+	return s.Scan(item, qty, code.Batch, code.Expiry, by, at)
+}
+
+func (sc scanner) expects(ctx context.Context, s *scan.Session, itemID uuid.UUID) (bool, error) {
+	switch s.Type {
+	case scan.TypeReceiving:
+		po, err := sc.purchaseOrders.GetByID(ctx, *s.PurchaseOrderID)
+		if err != nil || po == nil {
+			return false, err
+		}
+		for _, l := range po.Lines {
+			if l.InventoryItemID == itemID {
+				return true, nil
+			}
+		}
+	case scan.TypeCount:
+		st, err := sc.stocktakes.GetByID(ctx, *s.StocktakeID)
+		if err != nil || st == nil {
+			return false, err
+		}
+		for _, l := range st.Lines {
+			if l.InventoryItemID == itemID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
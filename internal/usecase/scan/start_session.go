@@ -0,0 +1,85 @@
+// Package scan contains the use cases for barcode scanning: looking items
+// up, scanning deliveries and shelves into sessions and mapping unknown
+// barcodes.
+package scan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/purchasing"
+	"github.com/james-wukong/orders-api/internal/domain/scan"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// StartSessionUseCase opens a scan session against an ordered purchase
+// order or an open stocktake, at that one's restaurant.
+type StartSessionUseCase struct {
+	repo           scan.Repository
+	purchaseOrders purchasing.Repository
+	stocktakes     stocktake.Repository
+}
+
+func NewStartSessionUseCase(
+	repo scan.Repository, purchaseOrders purchasing.Repository, stocktakes stocktake.Repository,
+) *StartSessionUseCase {
+	return &StartSessionUseCase{
+		repo:           repo,
+		purchaseOrders: purchaseOrders,
+		stocktakes:     stocktakes,
+	}
+}
+
+func (uc *StartSessionUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.StartScanSessionRequest,
+) (*scan.Session, error) {
+	var (
+		restaurantID uuid.UUID
+		referenceID  uuid.UUID
+		err          error
+	)
+	switch scan.Type(input.Type) {
+	case scan.TypeReceiving:
+		if referenceID, err = uuid.Parse(input.PurchaseOrderID); err != nil {
+			return nil, scan.ErrMissingReference
+		}
+		po, err := uc.purchaseOrders.GetByID(ctx, referenceID)
+		if err != nil {
+			return nil, err
+		}
+		if po == nil {
+			return nil, purchasing.ErrPurchaseOrderNotFound
+		}
+		if po.Status != purchasing.StatusOrdered {
+			return nil, purchasing.ErrNotOrdered
+		}
+		restaurantID = po.RestaurantID
+	case scan.TypeCount:
+		if referenceID, err = uuid.Parse(input.StocktakeID); err != nil {
+			return nil, scan.ErrMissingReference
+		}
+		s, err := uc.stocktakes.GetByID(ctx, referenceID)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, stocktake.ErrStocktakeNotFound
+		}
+		if s.Status != stocktake.StatusOpen {
+			return nil, stocktake.ErrNotOpen
+		}
+		restaurantID = s.RestaurantID
+	default:
+		return nil, scan.ErrInvalidType
+	}
+
+	session, err := scan.NewSession(restaurantID, scan.Type(input.Type), referenceID, input.Notes, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
@@ -0,0 +1,121 @@
+package stocktake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// ApproveStocktakeUseCase accepts a submitted count and posts its variances:
+// one inventory adjustment and one adjustment ledger entry per item that
+// counted differently, all in one transaction.
+type ApproveStocktakeUseCase struct {
+	repo       stocktake.Repository
+	items      inventory.Repository
+	transactor tx.Transactor
+}
+
+func NewApproveStocktakeUseCase(
+	repo stocktake.Repository,
+	items inventory.Repository,
+	transactor tx.Transactor,
+) *ApproveStocktakeUseCase {
+	return &ApproveStocktakeUseCase{
+		repo:       repo,
+		items:      items,
+		transactor: transactor,
+	}
+}
+
+func (uc *ApproveStocktakeUseCase) Execute(ctx context.Context, userID, id uuid.UUID) (*stocktake.Stocktake, error) {
+	var s *stocktake.Stocktake
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if s, err = lockStocktake(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := s.Approve(userID, time.Now()); err != nil {
+			return err
+		}
+
+		// Lock items in a fixed order so concurrent postings can't deadlock
+		lines := s.CountedLines()
+		sort.Slice(lines, func(a, b int) bool {
+			return lines[a].InventoryItemID.String() < lines[b].InventoryItemID.String()
+		})
+		for _, line := range lines {
+			if line.Variance() == 0 {
+				continue
+			}
+			if err := uc.post(ctx, s, line, userID); err != nil {
+				return err
+			}
+		}
+		return uc.repo.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// post adjusts the line's item by its variance and links the adjustment to
+// the line
+func (uc *ApproveStocktakeUseCase) post(
+	ctx context.Context, s *stocktake.Stocktake, line *stocktake.Line, approver uuid.UUID,
+) error {
+	item, err := uc.items.LockByID(ctx, line.InventoryItemID)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return inventory.ErrItemNotFound
+	}
+	delta := line.Variance()
+	adj, entry, err := item.Adjust(delta, line.Reason)
+	if err != nil {
+		return fmt.Errorf("%s: %w", item.Name, err)
+	}
+	if delta < 0 {
+		// Missing stock comes out of the first-expiring batches
+		batches, err := uc.items.LockBatches(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+		for _, d := range inventory.TakeFEFO(batches, -delta) {
+			if err := uc.items.UpdateBatch(ctx, d.Batch); err != nil {
+				return fmt.Errorf("failed to update batch: %w", err)
+			}
+		}
+	}
+
+	label := s.Label()
+	adj.AdjustedBy = line.CountedBy
+	adj.ApprovedBy = &approver
+	adj.ReasonDetails = joinNotes(label, line.Notes)
+	if err := uc.items.CreateAdjustment(ctx, adj); err != nil {
+		return fmt.Errorf("failed to save adjustment: %w", err)
+	}
+
+	entry.PerformedBy = &approver
+	entry.Reason = string(line.Reason)
+	entry.ReferenceType = "stocktake"
+	entry.ReferenceID = &s.ID
+	entry.Notes = label
+
+	if err := uc.items.Update(ctx, item); err != nil {
+		return fmt.Errorf("failed to update stock: %w", err)
+	}
+	if err := uc.items.CreateTransaction(ctx, entry); err != nil {
+		return err
+	}
+	line.AdjustmentID = &adj.ID
+	line.Item = item
+	return nil
+}
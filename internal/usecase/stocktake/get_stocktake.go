@@ -0,0 +1,39 @@
+package stocktake
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+)
+
+type GetStocktakeUseCase struct {
+	repo stocktake.Repository
+}
+
+func NewGetStocktakeUseCase(repo stocktake.Repository) *GetStocktakeUseCase {
+	return &GetStocktakeUseCase{repo: repo}
+}
+
+func (uc *GetStocktakeUseCase) Execute(ctx context.Context, id uuid.UUID) (*stocktake.Stocktake, error) {
+	s, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, stocktake.ErrStocktakeNotFound
+	}
+	return s, nil
+}
+
+// lockStocktake loads a stocktake and locks it for the rest of the transaction
+func lockStocktake(ctx context.Context, repo stocktake.Repository, id uuid.UUID) (*stocktake.Stocktake, error) {
+	s, err := repo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, stocktake.ErrStocktakeNotFound
+	}
+	return s, nil
+}
@@ -0,0 +1,23 @@
+package stocktake
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+)
+
+// ListStocktakesUseCase returns a restaurant's stocktakes, newest first.
+type ListStocktakesUseCase struct {
+	repo stocktake.Repository
+}
+
+func NewListStocktakesUseCase(repo stocktake.Repository) *ListStocktakesUseCase {
+	return &ListStocktakesUseCase{repo: repo}
+}
+
+func (uc *ListStocktakesUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter stocktake.Filter,
+) ([]*stocktake.Stocktake, error) {
+	return uc.repo.ListByRestaurant(ctx, restaurantID, filter)
+}
@@ -0,0 +1,70 @@
+// Package stocktake contains the use cases for physical stock counts and
+// posting their variances.
+package stocktake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// OpenStocktakeUseCase starts a count of a restaurant's active items,
+// optionally limited to one storage location or ingredient category.
+type OpenStocktakeUseCase struct {
+	repo        stocktake.Repository
+	items       inventory.Repository
+	restaurants restaurant.Repository
+}
+
+func NewOpenStocktakeUseCase(
+	repo stocktake.Repository,
+	items inventory.Repository,
+	restaurants restaurant.Repository,
+) *OpenStocktakeUseCase {
+	return &OpenStocktakeUseCase{
+		repo:        repo,
+		items:       items,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *OpenStocktakeUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.OpenStocktakeRequest,
+) (*stocktake.Stocktake, error) {
+	restaurantID, err := uuid.Parse(input.RestaurantID)
+	if err != nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+
+	filter := inventory.ItemFilter{StorageLocation: input.StorageLocation}
+	if input.CategoryID != "" {
+		categoryID := uuid.MustParse(input.CategoryID) // validated by binding
+		filter.CategoryID = &categoryID
+	}
+	items, err := uc.items.ListByRestaurant(ctx, restaurantID, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, stocktake.ErrNothingToCount
+	}
+
+	s := stocktake.NewStocktake(restaurantID, input.StorageLocation, filter.CategoryID, userID, items)
+	s.Notes = input.Notes
+	if err := uc.repo.Create(ctx, s); err != nil {
+		return nil, fmt.Errorf("failed to open stocktake: %w", err)
+	}
+	return s, nil
+}
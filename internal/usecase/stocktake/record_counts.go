@@ -0,0 +1,114 @@
+package stocktake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// RecordCountsUseCase records counted quantities on an open stocktake.
+// Each count takes the item's stock at that moment as the expected quantity.
+type RecordCountsUseCase struct {
+	repo       stocktake.Repository
+	items      inventory.Repository
+	units      inventory.UnitRepository
+	transactor tx.Transactor
+}
+
+func NewRecordCountsUseCase(
+	repo stocktake.Repository,
+	items inventory.Repository,
+	units inventory.UnitRepository,
+	transactor tx.Transactor,
+) *RecordCountsUseCase {
+	return &RecordCountsUseCase{
+		repo:       repo,
+		items:      items,
+		units:      units,
+		transactor: transactor,
+	}
+}
+
+func (uc *RecordCountsUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.RecordCountsRequest,
+) (*stocktake.Stocktake, error) {
+	var s *stocktake.Stocktake
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if s, err = lockStocktake(ctx, uc.repo, id); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, in := range input.Counts {
+			itemID, err := uuid.Parse(in.InventoryItemID)
+			if err != nil {
+				return stocktake.ErrItemNotInCount
+			}
+			item, err := uc.items.GetByID(ctx, itemID)
+			if err != nil {
+				return err
+			}
+			if item == nil || item.RestaurantID != s.RestaurantID {
+				return stocktake.ErrItemNotInCount
+			}
+			qty, err := uc.toItemUnit(ctx, item, *in.Quantity, in.Unit)
+			if err != nil {
+				return err
+			}
+			notes := in.Notes
+			if in.Unit != "" {
+				notes = joinNotes(notes, fmt.Sprintf("counted as %g %s", *in.Quantity, in.Unit))
+			}
+			_, err = s.Count(item, qty, inventory.AdjustmentReason(in.Reason), notes, userID, now)
+			if err != nil {
+				return err
+			}
+		}
+		return uc.repo.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// toItemUnit converts qty, given in the unit named by code, into the item's
+// own unit. An empty code means the quantity is already in the item's unit.
+func (uc *RecordCountsUseCase) toItemUnit(ctx context.Context, item *inventory.Item, qty float64, code string) (float64, error) {
+	if code == "" {
+		return qty, nil
+	}
+	if item.Unit == nil {
+		return 0, inventory.ErrItemWithoutUnit
+	}
+	from, err := uc.units.FindByCode(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	if from == nil {
+		return 0, fmt.Errorf("%w: %s", inventory.ErrUnitNotFound, code)
+	}
+	converted, err := inventory.Convert(qty, from, item.Unit)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s to %s", err, from.Abbreviation, item.Unit.Abbreviation)
+	}
+	return converted, nil
+}
+
+// joinNotes joins non-empty notes with "; "
+func joinNotes(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	}
+	return a + "; " + b
+}
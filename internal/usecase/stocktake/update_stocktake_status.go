@@ -0,0 +1,44 @@
+package stocktake
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/stocktake"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// UpdateStocktakeStatusUseCase submits, reopens for a recount or cancels a
+// stocktake. Approval goes through ApproveStocktakeUseCase.
+type UpdateStocktakeStatusUseCase struct {
+	repo       stocktake.Repository
+	transactor tx.Transactor
+}
+
+func NewUpdateStocktakeStatusUseCase(repo stocktake.Repository, transactor tx.Transactor) *UpdateStocktakeStatusUseCase {
+	return &UpdateStocktakeStatusUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *UpdateStocktakeStatusUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, status stocktake.Status,
+) (*stocktake.Stocktake, error) {
+	var s *stocktake.Stocktake
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if s, err = lockStocktake(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := s.TransitionTo(status, userID, time.Now()); err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,45 @@
+// Package supplier contains the use cases for managing suppliers, their
+// price lists and choosing who to buy from.
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type CreateSupplierUseCase struct {
+	repo supplier.Repository
+}
+
+func NewCreateSupplierUseCase(repo supplier.Repository) *CreateSupplierUseCase {
+	return &CreateSupplierUseCase{repo: repo}
+}
+
+func (uc *CreateSupplierUseCase) Execute(ctx context.Context, input dto.CreateSupplierRequest) (*supplier.Supplier, error) {
+	s := supplier.NewSupplier(input.Name)
+	s.ContactPerson = input.ContactPerson
+	s.Email = input.Email
+	s.Phone = input.Phone
+	s.Address = input.Address
+	s.City = input.City
+	s.State = input.State
+	s.PostalCode = input.PostalCode
+	if input.Country != "" {
+		s.Country = input.Country
+	}
+	s.PaymentTerms = input.PaymentTerms
+	s.DeliveryDays = input.DeliveryDays
+	if input.MinimumOrderAmount != nil && *input.MinimumOrderAmount > 0 {
+		s.MinimumOrderAmount = input.MinimumOrderAmount
+	}
+	s.Rating = input.Rating
+	s.Notes = input.Notes
+
+	if err := uc.repo.Create(ctx, s); err != nil {
+		return nil, fmt.Errorf("failed to save supplier: %w", err)
+	}
+	return s, nil
+}
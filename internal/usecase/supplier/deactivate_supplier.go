@@ -0,0 +1,35 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// DeactivateSupplierUseCase retires a supplier instead of deleting it: its
+// purchase orders and price history keep referring to it. Inactive
+// suppliers can't be ordered from and are left out of recommendations.
+type DeactivateSupplierUseCase struct {
+	repo supplier.Repository
+}
+
+func NewDeactivateSupplierUseCase(repo supplier.Repository) *DeactivateSupplierUseCase {
+	return &DeactivateSupplierUseCase{repo: repo}
+}
+
+func (uc *DeactivateSupplierUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	s, err := NewGetSupplierUseCase(uc.repo).Execute(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !s.IsActive {
+		return nil
+	}
+	s.IsActive = false
+	if err := uc.repo.Update(ctx, s); err != nil {
+		return fmt.Errorf("failed to deactivate supplier: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// DeletePriceUseCase takes an item off a supplier's price list. Its price
+// history is kept.
+type DeletePriceUseCase struct {
+	prices supplier.PriceRepository
+}
+
+func NewDeletePriceUseCase(prices supplier.PriceRepository) *DeletePriceUseCase {
+	return &DeletePriceUseCase{prices: prices}
+}
+
+func (uc *DeletePriceUseCase) Execute(ctx context.Context, supplierID, itemID uuid.UUID) error {
+	price, err := uc.prices.GetPrice(ctx, supplierID, itemID)
+	if err != nil {
+		return err
+	}
+	if price == nil {
+		return supplier.ErrPriceNotFound
+	}
+	return uc.prices.DeletePrice(ctx, price)
+}
@@ -0,0 +1,27 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+type GetSupplierUseCase struct {
+	repo supplier.Repository
+}
+
+func NewGetSupplierUseCase(repo supplier.Repository) *GetSupplierUseCase {
+	return &GetSupplierUseCase{repo: repo}
+}
+
+func (uc *GetSupplierUseCase) Execute(ctx context.Context, id uuid.UUID) (*supplier.Supplier, error) {
+	s, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, supplier.ErrSupplierNotFound
+	}
+	return s, nil
+}
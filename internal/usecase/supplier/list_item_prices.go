@@ -0,0 +1,42 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// ListItemPricesUseCase returns what every active supplier charges for an
+// item, cheapest first.
+type ListItemPricesUseCase struct {
+	prices supplier.PriceRepository
+	items  inventory.Repository
+}
+
+func NewListItemPricesUseCase(prices supplier.PriceRepository, items inventory.Repository) *ListItemPricesUseCase {
+	return &ListItemPricesUseCase{
+		prices: prices,
+		items:  items,
+	}
+}
+
+func (uc *ListItemPricesUseCase) Execute(ctx context.Context, itemID uuid.UUID) ([]*supplier.Price, error) {
+	if err := findItem(ctx, uc.items, itemID); err != nil {
+		return nil, err
+	}
+	return uc.prices.ListItemPrices(ctx, []uuid.UUID{itemID})
+}
+
+// findItem checks the inventory item exists
+func findItem(ctx context.Context, items inventory.Repository, id uuid.UUID) error {
+	item, err := items.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return inventory.ErrItemNotFound
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// ListPriceHistoryUseCase returns the prices suppliers quoted and were
+// paid for an item, newest first.
+type ListPriceHistoryUseCase struct {
+	prices supplier.PriceRepository
+	items  inventory.Repository
+}
+
+func NewListPriceHistoryUseCase(prices supplier.PriceRepository, items inventory.Repository) *ListPriceHistoryUseCase {
+	return &ListPriceHistoryUseCase{
+		prices: prices,
+		items:  items,
+	}
+}
+
+func (uc *ListPriceHistoryUseCase) Execute(
+	ctx context.Context, itemID uuid.UUID, supplierID *uuid.UUID, limit int,
+) ([]*supplier.PriceChange, error) {
+	if err := findItem(ctx, uc.items, itemID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return uc.prices.ListPriceHistory(ctx, itemID, supplierID, limit)
+}
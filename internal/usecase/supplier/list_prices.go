@@ -0,0 +1,28 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+// ListPricesUseCase returns a supplier's price list.
+type ListPricesUseCase struct {
+	repo   supplier.Repository
+	prices supplier.PriceRepository
+}
+
+func NewListPricesUseCase(repo supplier.Repository, prices supplier.PriceRepository) *ListPricesUseCase {
+	return &ListPricesUseCase{
+		repo:   repo,
+		prices: prices,
+	}
+}
+
+func (uc *ListPricesUseCase) Execute(ctx context.Context, supplierID uuid.UUID) ([]*supplier.Price, error) {
+	if _, err := NewGetSupplierUseCase(uc.repo).Execute(ctx, supplierID); err != nil {
+		return nil, err
+	}
+	return uc.prices.ListPrices(ctx, supplierID)
+}
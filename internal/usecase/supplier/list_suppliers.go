@@ -0,0 +1,19 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+)
+
+type ListSuppliersUseCase struct {
+	repo supplier.Repository
+}
+
+func NewListSuppliersUseCase(repo supplier.Repository) *ListSuppliersUseCase {
+	return &ListSuppliersUseCase{repo: repo}
+}
+
+func (uc *ListSuppliersUseCase) Execute(ctx context.Context, filter supplier.Filter) ([]*supplier.Supplier, error) {
+	return uc.repo.List(ctx, filter)
+}
@@ -0,0 +1,41 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// RecommendSupplierUseCase quotes a basket from every active supplier
+// pricing any of its items and picks the cheapest one that can fill it.
+type RecommendSupplierUseCase struct {
+	prices supplier.PriceRepository
+}
+
+func NewRecommendSupplierUseCase(prices supplier.PriceRepository) *RecommendSupplierUseCase {
+	return &RecommendSupplierUseCase{prices: prices}
+}
+
+func (uc *RecommendSupplierUseCase) Execute(
+	ctx context.Context, input dto.RecommendSupplierRequest,
+) (*supplier.Recommendation, error) {
+	basket := make([]supplier.BasketLine, 0, len(input.Items))
+	itemIDs := make([]uuid.UUID, 0, len(input.Items))
+	for _, in := range input.Items {
+		id, err := uuid.Parse(in.InventoryItemID)
+		if err != nil {
+			return nil, inventory.ErrItemNotFound
+		}
+		basket = append(basket, supplier.BasketLine{InventoryItemID: id, Quantity: in.Quantity})
+		itemIDs = append(itemIDs, id)
+	}
+
+	prices, err := uc.prices.ListItemPrices(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	return supplier.Recommend(basket, prices)
+}
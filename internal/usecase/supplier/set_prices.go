@@ -0,0 +1,106 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// SetPricesUseCase adds items to a supplier's price list or changes their
+// price. Every new or changed price is recorded in the price history.
+type SetPricesUseCase struct {
+	repo       supplier.Repository
+	prices     supplier.PriceRepository
+	items      inventory.Repository
+	transactor tx.Transactor
+}
+
+func NewSetPricesUseCase(
+	repo supplier.Repository,
+	prices supplier.PriceRepository,
+	items inventory.Repository,
+	transactor tx.Transactor,
+) *SetPricesUseCase {
+	return &SetPricesUseCase{
+		repo:       repo,
+		prices:     prices,
+		items:      items,
+		transactor: transactor,
+	}
+}
+
+func (uc *SetPricesUseCase) Execute(
+	ctx context.Context, userID, supplierID uuid.UUID, input dto.SetSupplierPricesRequest,
+) ([]*supplier.Price, error) {
+	s, err := NewGetSupplierUseCase(uc.repo).Execute(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, in := range input.Prices {
+			itemID, err := uuid.Parse(in.InventoryItemID)
+			if err != nil {
+				return inventory.ErrItemNotFound
+			}
+			if err := uc.setPrice(ctx, userID, s.ID, itemID, in); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.prices.ListPrices(ctx, s.ID)
+}
+
+func (uc *SetPricesUseCase) setPrice(
+	ctx context.Context, userID, supplierID, itemID uuid.UUID, in dto.SupplierPriceRequest,
+) error {
+	price, err := uc.prices.GetPrice(ctx, supplierID, itemID)
+	if err != nil {
+		return err
+	}
+
+	var change *supplier.PriceChange
+	create := price == nil
+	if create {
+		item, err := uc.items.GetByID(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return fmt.Errorf("%w: %s", inventory.ErrItemNotFound, itemID)
+		}
+		if price, change, err = supplier.NewPrice(supplierID, itemID, in.UnitPrice, userID); err != nil {
+			return err
+		}
+	} else if change, err = price.SetPrice(in.UnitPrice, userID); err != nil {
+		return err
+	}
+	if in.SupplierSKU != nil {
+		price.SupplierSKU = *in.SupplierSKU
+	}
+	if in.MinimumQuantity != nil {
+		price.MinimumQuantity = inventory.RoundQty(*in.MinimumQuantity)
+	}
+
+	if create {
+		err = uc.prices.CreatePrice(ctx, price)
+	} else {
+		err = uc.prices.UpdatePrice(ctx, price)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save supplier price: %w", err)
+	}
+	if change == nil {
+		return nil
+	}
+	return uc.prices.CreatePriceChange(ctx, change)
+}
@@ -0,0 +1,63 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/supplier"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type UpdateSupplierUseCase struct {
+	repo supplier.Repository
+}
+
+func NewUpdateSupplierUseCase(repo supplier.Repository) *UpdateSupplierUseCase {
+	return &UpdateSupplierUseCase{repo: repo}
+}
+
+func (uc *UpdateSupplierUseCase) Execute(
+	ctx context.Context, id uuid.UUID, input dto.UpdateSupplierRequest,
+) (*supplier.Supplier, error) {
+	s, err := NewGetSupplierUseCase(uc.repo).Execute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	assign := func(dst *string, src *string) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	assign(&s.Name, input.Name)
+	assign(&s.ContactPerson, input.ContactPerson)
+	assign(&s.Email, input.Email)
+	assign(&s.Phone, input.Phone)
+	assign(&s.Address, input.Address)
+	assign(&s.City, input.City)
+	assign(&s.State, input.State)
+	assign(&s.PostalCode, input.PostalCode)
+	assign(&s.Country, input.Country)
+	assign(&s.PaymentTerms, input.PaymentTerms)
+	assign(&s.DeliveryDays, input.DeliveryDays)
+	assign(&s.Notes, input.Notes)
+	if input.MinimumOrderAmount != nil {
+		if *input.MinimumOrderAmount == 0 {
+			s.MinimumOrderAmount = nil
+		} else {
+			s.MinimumOrderAmount = input.MinimumOrderAmount
+		}
+	}
+	if input.Rating != nil {
+		s.Rating = *input.Rating
+	}
+	if input.IsActive != nil {
+		s.IsActive = *input.IsActive
+	}
+
+	if err := uc.repo.Update(ctx, s); err != nil {
+		return nil, fmt.Errorf("failed to update supplier: %w", err)
+	}
+	return s, nil
+}
@@ -0,0 +1,73 @@
+package table
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// CloseTabUseCase settles a tab once everything on it has been served or
+// cancelled, marking the unpaid orders paid, and frees the table. Guests
+// can no longer order with the session token.
+type CloseTabUseCase struct {
+	sessions   table.SessionRepository
+	orders     order.Repository
+	transactor tx.Transactor
+}
+
+func NewCloseTabUseCase(
+	sessions table.SessionRepository,
+	orders order.Repository,
+	transactor tx.Transactor,
+) *CloseTabUseCase {
+	return &CloseTabUseCase{
+		sessions:   sessions,
+		orders:     orders,
+		transactor: transactor,
+	}
+}
+
+func (uc *CloseTabUseCase) Execute(
+	ctx context.Context, sessionID uuid.UUID, method order.PaymentMethod, closedBy uuid.UUID,
+) (*table.Tab, error) {
+	var tab *table.Tab
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Locked so no order is added while the tab is settled
+		s, err := uc.sessions.LockByID(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			return table.ErrSessionNotFound
+		}
+		orders, err := uc.orders.ListByTableSession(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Close(orders, method, closedBy, time.Now()); err != nil {
+			return err
+		}
+		for _, o := range orders {
+			if o.Status == order.StatusCancelled {
+				continue
+			}
+			if err := uc.orders.Update(ctx, o); err != nil {
+				return err
+			}
+		}
+		if err := uc.sessions.Update(ctx, s); err != nil {
+			return err
+		}
+		tab = table.NewTab(s, orders)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tab, nil
+}
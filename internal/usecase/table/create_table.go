@@ -0,0 +1,52 @@
+// Package table contains the use cases for dine-in tables, their QR codes
+// and the shared tabs guests order on.
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+type CreateTableUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreateTableUseCase(repo table.Repository, restaurants restaurant.Repository) *CreateTableUseCase {
+	return &CreateTableUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateTableUseCase) Execute(ctx context.Context, restaurantID uuid.UUID, input dto.CreateTableRequest) (*table.Table, error) {
+	res, err := uc.restaurants.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, restaurant.ErrRestaurantNotFound
+	}
+
+	t, err := table.NewTable(res.ID, input.Number, input.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := uc.repo.GetByNumber(ctx, res.ID, t.Number)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, table.ErrTableNumberTaken
+	}
+
+	if err := uc.repo.Create(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to save table: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,38 @@
+package table
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+)
+
+// GetTabUseCase returns a table session with its orders. Guests reach it
+// through their session token, staff by ID.
+type GetTabUseCase struct {
+	sessions table.SessionRepository
+	orders   order.Repository
+}
+
+func NewGetTabUseCase(sessions table.SessionRepository, orders order.Repository) *GetTabUseCase {
+	return &GetTabUseCase{
+		sessions: sessions,
+		orders:   orders,
+	}
+}
+
+func (uc *GetTabUseCase) Execute(ctx context.Context, sessionID uuid.UUID) (*table.Tab, error) {
+	s, err := uc.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, table.ErrSessionNotFound
+	}
+	orders, err := uc.orders.ListByTableSession(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	return table.NewTab(s, orders), nil
+}
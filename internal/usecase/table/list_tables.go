@@ -0,0 +1,21 @@
+package table
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+)
+
+// ListTablesUseCase lists a restaurant's tables in number order.
+type ListTablesUseCase struct {
+	repo table.Repository
+}
+
+func NewListTablesUseCase(repo table.Repository) *ListTablesUseCase {
+	return &ListTablesUseCase{repo: repo}
+}
+
+func (uc *ListTablesUseCase) Execute(ctx context.Context, restaurantID uuid.UUID) ([]*table.Table, error) {
+	return uc.repo.ListByRestaurant(ctx, restaurantID)
+}
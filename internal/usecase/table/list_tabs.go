@@ -0,0 +1,30 @@
+package table
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+)
+
+// ListTabsUseCase lists a restaurant's table sessions, newest first,
+// optionally only open or closed ones.
+type ListTabsUseCase struct {
+	sessions table.SessionRepository
+}
+
+func NewListTabsUseCase(sessions table.SessionRepository) *ListTabsUseCase {
+	return &ListTabsUseCase{sessions: sessions}
+}
+
+func (uc *ListTabsUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, status table.SessionStatus, limit, offset int,
+) ([]*table.Session, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.sessions.ListByRestaurant(ctx, restaurantID, status, limit, offset)
+}
@@ -0,0 +1,84 @@
+package table
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// MoveTabUseCase moves guests and their open tab to another table. Orders
+// still in the kitchen get the new table number on their ticket; guests
+// keep their session token.
+type MoveTabUseCase struct {
+	repo       table.Repository
+	sessions   table.SessionRepository
+	orders     order.Repository
+	transactor tx.Transactor
+}
+
+func NewMoveTabUseCase(
+	repo table.Repository,
+	sessions table.SessionRepository,
+	orders order.Repository,
+	transactor tx.Transactor,
+) *MoveTabUseCase {
+	return &MoveTabUseCase{
+		repo:       repo,
+		sessions:   sessions,
+		orders:     orders,
+		transactor: transactor,
+	}
+}
+
+func (uc *MoveTabUseCase) Execute(ctx context.Context, sessionID, tableID uuid.UUID) (*table.Tab, error) {
+	var s *table.Session
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		s, err = uc.sessions.LockByID(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			return table.ErrSessionNotFound
+		}
+		if s.TableID == tableID {
+			return nil
+		}
+
+		// Locked so no one opens a tab on the table while this one moves there
+		t, err := uc.repo.LockByID(ctx, tableID)
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			return table.ErrTableNotFound
+		}
+		occupied, err := uc.sessions.GetOpenByTable(ctx, t.ID)
+		if err != nil {
+			return err
+		}
+		if occupied != nil {
+			return table.ErrTableOccupied
+		}
+
+		if err := s.MoveTo(t); err != nil {
+			return err
+		}
+		if err := uc.sessions.Update(ctx, s); err != nil {
+			return err
+		}
+		return uc.orders.SetTableNumber(ctx, s.ID, t.Number)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := uc.orders.ListByTableSession(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	return table.NewTab(s, orders), nil
+}
@@ -0,0 +1,93 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/james-wukong/orders-api/internal/domain/order"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// ScanTableUseCase opens the tab of the table whose QR code a guest
+// scanned, or joins the one already open, and returns it with the session
+// token the guest orders with. No account is needed.
+type ScanTableUseCase struct {
+	repo       table.Repository
+	sessions   table.SessionRepository
+	orders     order.Repository
+	transactor tx.Transactor
+	signer     *table.Signer
+}
+
+func NewScanTableUseCase(
+	repo table.Repository,
+	sessions table.SessionRepository,
+	orders order.Repository,
+	transactor tx.Transactor,
+	signer *table.Signer,
+) *ScanTableUseCase {
+	return &ScanTableUseCase{
+		repo:       repo,
+		sessions:   sessions,
+		orders:     orders,
+		transactor: transactor,
+		signer:     signer,
+	}
+}
+
+// Execute seats guests at the table when it is greater than zero.
+func (uc *ScanTableUseCase) Execute(ctx context.Context, token string, guests int) (*table.Tab, string, error) {
+	tableID, version, err := uc.signer.ParseTableToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var s *table.Session
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Locked so two guests scanning at once end up on the same tab
+		t, err := uc.repo.LockByID(ctx, tableID)
+		if err != nil {
+			return err
+		}
+		if t == nil || t.TokenVersion != version {
+			return table.ErrInvalidToken
+		}
+
+		s, err = uc.sessions.GetOpenByTable(ctx, t.ID)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			if !t.IsActive {
+				return table.ErrTableInactive
+			}
+			s = table.OpenSession(t)
+			if err := s.Seat(guests); err != nil {
+				return err
+			}
+			if err := uc.sessions.Create(ctx, s); err != nil {
+				return fmt.Errorf("failed to open table session: %w", err)
+			}
+			return nil
+		}
+
+		s.Table = t
+		if guests <= s.GuestCount {
+			return nil
+		}
+		if err := s.Seat(guests); err != nil {
+			return err
+		}
+		return uc.sessions.Update(ctx, s)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders, err := uc.orders.ListByTableSession(ctx, s.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	return table.NewTab(s, orders), uc.signer.SessionToken(s), nil
+}
@@ -0,0 +1,92 @@
+package table
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+)
+
+// QR is what goes on a table's QR code.
+type QR struct {
+	Table *table.Table
+	Token string
+	// URL is the order page with the token as its "table" parameter, or
+	// empty when no order page is configured
+	URL string
+}
+
+// GetTableQRUseCase returns the table's current QR code.
+type GetTableQRUseCase struct {
+	repo     table.Repository
+	signer   *table.Signer
+	orderURL string
+}
+
+func NewGetTableQRUseCase(repo table.Repository, signer *table.Signer, orderURL string) *GetTableQRUseCase {
+	return &GetTableQRUseCase{
+		repo:     repo,
+		signer:   signer,
+		orderURL: orderURL,
+	}
+}
+
+func (uc *GetTableQRUseCase) Execute(ctx context.Context, id uuid.UUID) (*QR, error) {
+	t, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, table.ErrTableNotFound
+	}
+	return newQR(t, uc.signer, uc.orderURL)
+}
+
+// RotateTableTokenUseCase retires a table's QR code, for example when a
+// printed code has been copied, and returns the new one. A tab already
+// open on the table stays open.
+type RotateTableTokenUseCase struct {
+	repo     table.Repository
+	signer   *table.Signer
+	orderURL string
+}
+
+func NewRotateTableTokenUseCase(repo table.Repository, signer *table.Signer, orderURL string) *RotateTableTokenUseCase {
+	return &RotateTableTokenUseCase{
+		repo:     repo,
+		signer:   signer,
+		orderURL: orderURL,
+	}
+}
+
+func (uc *RotateTableTokenUseCase) Execute(ctx context.Context, id uuid.UUID) (*QR, error) {
+	t, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, table.ErrTableNotFound
+	}
+	t.RotateToken()
+	if err := uc.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return newQR(t, uc.signer, uc.orderURL)
+}
+
+func newQR(t *table.Table, signer *table.Signer, orderURL string) (*QR, error) {
+	qr := &QR{Table: t, Token: signer.TableToken(t)}
+	if orderURL == "" {
+		return qr, nil
+	}
+	u, err := url.Parse(orderURL)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("table", qr.Token)
+	u.RawQuery = q.Encode()
+	qr.URL = u.String()
+	return qr, nil
+}
@@ -0,0 +1,62 @@
+package table
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/table"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// UpdateTableUseCase renumbers or resizes a table, or takes it out of use.
+// An inactive table's QR code no longer opens a tab; a tab already open on
+// it stays open until staff move or close it.
+type UpdateTableUseCase struct {
+	repo table.Repository
+}
+
+func NewUpdateTableUseCase(repo table.Repository) *UpdateTableUseCase {
+	return &UpdateTableUseCase{repo: repo}
+}
+
+func (uc *UpdateTableUseCase) Execute(ctx context.Context, id uuid.UUID, input dto.UpdateTableRequest) (*table.Table, error) {
+	t, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, table.ErrTableNotFound
+	}
+
+	if input.Number != nil {
+		number := strings.TrimSpace(*input.Number)
+		if number == "" {
+			return nil, table.ErrInvalidTable
+		}
+		if number != t.Number {
+			existing, err := uc.repo.GetByNumber(ctx, t.RestaurantID, number)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				return nil, table.ErrTableNumberTaken
+			}
+			t.Number = number
+		}
+	}
+	if input.Capacity != nil {
+		if *input.Capacity <= 0 {
+			return nil, table.ErrInvalidTable
+		}
+		t.Capacity = *input.Capacity
+	}
+	if input.IsActive != nil {
+		t.IsActive = *input.IsActive
+	}
+
+	if err := uc.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
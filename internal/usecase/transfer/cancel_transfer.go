@@ -0,0 +1,40 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+)
+
+// CancelTransferUseCase withdraws a transfer before it ships.
+type CancelTransferUseCase struct {
+	repo       transfer.Repository
+	transactor tx.Transactor
+}
+
+func NewCancelTransferUseCase(repo transfer.Repository, transactor tx.Transactor) *CancelTransferUseCase {
+	return &CancelTransferUseCase{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (uc *CancelTransferUseCase) Execute(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	var t *transfer.Transfer
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if t, err = lockTransfer(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := t.Cancel(); err != nil {
+			return err
+		}
+		return uc.repo.Update(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,39 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+)
+
+type GetTransferUseCase struct {
+	repo transfer.Repository
+}
+
+func NewGetTransferUseCase(repo transfer.Repository) *GetTransferUseCase {
+	return &GetTransferUseCase{repo: repo}
+}
+
+func (uc *GetTransferUseCase) Execute(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	t, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, transfer.ErrTransferNotFound
+	}
+	return t, nil
+}
+
+// lockTransfer loads a transfer and locks it for the rest of the transaction
+func lockTransfer(ctx context.Context, repo transfer.Repository, id uuid.UUID) (*transfer.Transfer, error) {
+	t, err := repo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, transfer.ErrTransferNotFound
+	}
+	return t, nil
+}
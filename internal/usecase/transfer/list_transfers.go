@@ -0,0 +1,24 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+)
+
+// ListTransfersUseCase returns the transfers a restaurant sends or
+// receives, newest first.
+type ListTransfersUseCase struct {
+	repo transfer.Repository
+}
+
+func NewListTransfersUseCase(repo transfer.Repository) *ListTransfersUseCase {
+	return &ListTransfersUseCase{repo: repo}
+}
+
+func (uc *ListTransfersUseCase) Execute(
+	ctx context.Context, restaurantID uuid.UUID, filter transfer.Filter,
+) ([]*transfer.Transfer, error) {
+	return uc.repo.List(ctx, restaurantID, filter)
+}
@@ -0,0 +1,151 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// ReceiveTransferUseCase books a shipped transfer in at the destination.
+// Each line credits the destination item with the same SKU at the cost it
+// left the source, so value moves between restaurants without changing;
+// anything short is recorded on the line as a discrepancy. The whole
+// receipt is one transaction.
+type ReceiveTransferUseCase struct {
+	repo       transfer.Repository
+	items      inventory.Repository
+	transactor tx.Transactor
+}
+
+func NewReceiveTransferUseCase(
+	repo transfer.Repository,
+	items inventory.Repository,
+	transactor tx.Transactor,
+) *ReceiveTransferUseCase {
+	return &ReceiveTransferUseCase{
+		repo:       repo,
+		items:      items,
+		transactor: transactor,
+	}
+}
+
+func (uc *ReceiveTransferUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.ReceiveTransferRequest,
+) (*transfer.Transfer, []*inventory.Transaction, error) {
+	receipts := make(map[uuid.UUID]transfer.Receipt, len(input.Lines))
+	for _, in := range input.Lines {
+		receipts[uuid.MustParse(in.LineID)] = transfer.Receipt{ // validated by binding
+			Quantity: in.Quantity,
+			Reason:   inventory.AdjustmentReason(in.Reason),
+			Notes:    in.Notes,
+		}
+	}
+
+	var (
+		t       *transfer.Transfer
+		entries []*inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if t, err = lockTransfer(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		now := time.Now()
+		if err := t.Receive(receipts, userID, now); err != nil {
+			return err
+		}
+
+		// Match every line first, then lock in a fixed order so concurrent
+		// movements can't deadlock
+		matched := make(map[uuid.UUID]uuid.UUID, len(t.Lines))
+		for _, line := range t.Lines {
+			if *line.QuantityReceived <= 0 {
+				continue
+			}
+			item, err := matchItem(ctx, uc.items, t.DestinationRestaurantID, line.SKU)
+			if err != nil {
+				return err
+			}
+			matched[line.ID] = item.ID
+			line.DestinationItemID = &item.ID
+		}
+		lines := append([]*transfer.Line(nil), t.Lines...)
+		sort.Slice(lines, func(a, b int) bool {
+			return matched[lines[a].ID].String() < matched[lines[b].ID].String()
+		})
+		for _, line := range lines {
+			if _, ok := matched[line.ID]; !ok {
+				continue
+			}
+			entry, err := uc.receive(ctx, t, line, userID, now)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return uc.repo.Update(ctx, t)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, entries, nil
+}
+
+// receive credits the line's destination item and opens a batch for it
+func (uc *ReceiveTransferUseCase) receive(
+	ctx context.Context, t *transfer.Transfer, line *transfer.Line, userID uuid.UUID, now time.Time,
+) (*inventory.Transaction, error) {
+	item, err := uc.items.LockByID(ctx, *line.DestinationItemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", inventory.ErrItemNotFound, *line.DestinationItemID)
+	}
+
+	// Line quantities are in the source item's unit; keep the total value
+	// when converting
+	qty, cost := *line.QuantityReceived, 0.0
+	if line.UnitCost != nil {
+		cost = *line.UnitCost
+	}
+	if src := line.SourceItem; src != nil && src.Unit != nil && item.Unit != nil && src.Unit.ID != item.Unit.ID {
+		converted, err := inventory.Convert(qty, src.Unit, item.Unit)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s to %s for %s", err, src.Unit.Abbreviation, item.Unit.Abbreviation, item.Name)
+		}
+		cost = money.Round(qty * cost / converted)
+		qty = converted
+	}
+
+	entry, err := item.TransferIn(qty, cost)
+	if err != nil {
+		return nil, err
+	}
+	entry.ReferenceType = "transfer"
+	entry.ReferenceID = &t.ID
+	entry.Reason = "Received on " + t.TransferNumber
+	entry.PerformedBy = &userID
+	entry.Notes = line.Notes
+
+	batch := item.NewBatch(entry, t.TransferNumber, line.ExpiryDate, now)
+	if err := uc.items.CreateBatch(ctx, batch); err != nil {
+		return nil, fmt.Errorf("failed to save batch: %w", err)
+	}
+	if err := uc.items.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update stock: %w", err)
+	}
+	if err := uc.items.CreateTransaction(ctx, entry); err != nil {
+		return nil, err
+	}
+	line.DestinationItem = item
+	return entry, nil
+}
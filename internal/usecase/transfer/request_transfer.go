@@ -0,0 +1,96 @@
+// Package transfer contains the use cases for moving stock between
+// restaurants.
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/restaurant"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// RequestTransferUseCase raises a transfer. Every line must have a match,
+// by SKU, among the destination's active items.
+type RequestTransferUseCase struct {
+	repo        transfer.Repository
+	items       inventory.Repository
+	restaurants restaurant.Repository
+}
+
+func NewRequestTransferUseCase(
+	repo transfer.Repository,
+	items inventory.Repository,
+	restaurants restaurant.Repository,
+) *RequestTransferUseCase {
+	return &RequestTransferUseCase{
+		repo:        repo,
+		items:       items,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *RequestTransferUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.RequestTransferRequest,
+) (*transfer.Transfer, error) {
+	// Both ids are validated by binding
+	sourceID := uuid.MustParse(input.SourceRestaurantID)
+	destinationID := uuid.MustParse(input.DestinationRestaurantID)
+	for _, id := range []uuid.UUID{sourceID, destinationID} {
+		res, err := uc.restaurants.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, fmt.Errorf("%w: %s", restaurant.ErrRestaurantNotFound, id)
+		}
+	}
+
+	t, err := transfer.NewTransfer(sourceID, destinationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	t.Notes = input.Notes
+	for _, in := range input.Lines {
+		item, err := uc.items.GetByID(ctx, uuid.MustParse(in.InventoryItemID))
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			return nil, fmt.Errorf("%w: %s", inventory.ErrItemNotFound, in.InventoryItemID)
+		}
+		if !item.IsActive {
+			return nil, fmt.Errorf("%w: %s", inventory.ErrItemInactive, item.Name)
+		}
+		line, err := t.AddLine(item, in.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := matchItem(ctx, uc.items, destinationID, line.SKU); err != nil {
+			return nil, err
+		}
+	}
+	if len(t.Lines) == 0 {
+		return nil, transfer.ErrEmptyTransfer
+	}
+
+	if err := uc.repo.Create(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to create stock transfer: %w", err)
+	}
+	return t, nil
+}
+
+// matchItem finds the restaurant's active item with the SKU
+func matchItem(ctx context.Context, items inventory.Repository, restaurantID uuid.UUID, sku string) (*inventory.Item, error) {
+	item, err := items.GetBySKU(ctx, restaurantID, sku)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil || !item.IsActive {
+		return nil, fmt.Errorf("%w: %s", transfer.ErrNoMatchingItem, sku)
+	}
+	return item, nil
+}
@@ -0,0 +1,121 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/inventory"
+	"github.com/james-wukong/orders-api/internal/domain/transfer"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// ShipTransferUseCase sends a requested transfer. Shipped stock leaves the
+// source at its average cost, first-expiring batches first, and stays in
+// transit until received; the whole shipment is one transaction.
+type ShipTransferUseCase struct {
+	repo       transfer.Repository
+	items      inventory.Repository
+	transactor tx.Transactor
+}
+
+func NewShipTransferUseCase(
+	repo transfer.Repository,
+	items inventory.Repository,
+	transactor tx.Transactor,
+) *ShipTransferUseCase {
+	return &ShipTransferUseCase{
+		repo:       repo,
+		items:      items,
+		transactor: transactor,
+	}
+}
+
+func (uc *ShipTransferUseCase) Execute(
+	ctx context.Context, userID, id uuid.UUID, input dto.ShipTransferRequest,
+) (*transfer.Transfer, []*inventory.Transaction, error) {
+	quantities := make(map[uuid.UUID]float64, len(input.Lines))
+	for _, in := range input.Lines {
+		quantities[uuid.MustParse(in.LineID)] = *in.Quantity // validated by binding
+	}
+
+	var (
+		t       *transfer.Transfer
+		entries []*inventory.Transaction
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if t, err = lockTransfer(ctx, uc.repo, id); err != nil {
+			return err
+		}
+		if err := t.Ship(quantities, userID, time.Now()); err != nil {
+			return err
+		}
+
+		// Lock items in a fixed order so concurrent movements can't deadlock
+		lines := append([]*transfer.Line(nil), t.Lines...)
+		sort.Slice(lines, func(a, b int) bool {
+			return lines[a].SourceItemID.String() < lines[b].SourceItemID.String()
+		})
+		for _, line := range lines {
+			if line.Shipped() <= 0 {
+				continue
+			}
+			shipped, err := uc.ship(ctx, t, line, userID)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, shipped...)
+		}
+		return uc.repo.Update(ctx, t)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, entries, nil
+}
+
+// ship takes the line's stock out of the source item
+func (uc *ShipTransferUseCase) ship(
+	ctx context.Context, t *transfer.Transfer, line *transfer.Line, userID uuid.UUID,
+) ([]*inventory.Transaction, error) {
+	item, err := uc.items.LockByID(ctx, line.SourceItemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", inventory.ErrItemNotFound, line.SourceItemID)
+	}
+	batches, err := uc.items.LockBatches(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	entries, draws, err := item.TransferOutFEFO(line.Shipped(), batches)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", item.Name, err)
+	}
+	for _, d := range draws {
+		if err := uc.items.UpdateBatch(ctx, d.Batch); err != nil {
+			return nil, fmt.Errorf("failed to update batch: %w", err)
+		}
+	}
+	line.RecordShipment(item.AverageCost, draws)
+	line.SourceItem = item
+
+	if err := uc.items.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update stock: %w", err)
+	}
+	for _, entry := range entries {
+		entry.ReferenceType = "transfer"
+		entry.ReferenceID = &t.ID
+		entry.Reason = "Shipped on " + t.TransferNumber
+		entry.PerformedBy = &userID
+		if err := uc.items.CreateTransaction(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
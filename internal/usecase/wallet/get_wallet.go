@@ -0,0 +1,31 @@
+// Package wallet contains the use cases for customers' wallet balances and
+// statements, card top-ups and buying and redeeming gift cards.
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+)
+
+// GetWalletUseCase returns the customer's wallet; customers who never had
+// money in it have an empty one.
+type GetWalletUseCase struct {
+	repo wallet.Repository
+}
+
+func NewGetWalletUseCase(repo wallet.Repository) *GetWalletUseCase {
+	return &GetWalletUseCase{repo: repo}
+}
+
+func (uc *GetWalletUseCase) Execute(ctx context.Context, userID uuid.UUID) (*wallet.Account, error) {
+	acc, err := uc.repo.GetWallet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		acc = &wallet.Account{Type: wallet.AccountUserWallet, UserID: &userID}
+	}
+	return acc, nil
+}
@@ -0,0 +1,30 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+)
+
+// ListGiftCardsUseCase pages through the gift cards the customer bought,
+// newest first.
+type ListGiftCardsUseCase struct {
+	giftCards wallet.GiftCardRepository
+}
+
+func NewListGiftCardsUseCase(giftCards wallet.GiftCardRepository) *ListGiftCardsUseCase {
+	return &ListGiftCardsUseCase{giftCards: giftCards}
+}
+
+func (uc *ListGiftCardsUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]*wallet.GiftCard, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.giftCards.ListByPurchaser(ctx, userID, limit, offset)
+}
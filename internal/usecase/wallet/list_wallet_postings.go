@@ -0,0 +1,35 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+)
+
+// ListWalletPostingsUseCase pages through the customer's wallet statement:
+// every posting to their wallet with the transaction it belongs to, newest
+// first.
+type ListWalletPostingsUseCase struct {
+	repo wallet.Repository
+}
+
+func NewListWalletPostingsUseCase(repo wallet.Repository) *ListWalletPostingsUseCase {
+	return &ListWalletPostingsUseCase{repo: repo}
+}
+
+func (uc *ListWalletPostingsUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]*wallet.Posting, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	acc, err := uc.repo.GetWallet(ctx, userID)
+	if err != nil || acc == nil {
+		return nil, err
+	}
+	return uc.repo.ListPostings(ctx, acc.ID, limit, offset)
+}
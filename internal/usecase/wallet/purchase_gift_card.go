@@ -0,0 +1,117 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/notification"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+)
+
+// PurchaseGiftCardUseCase sells a gift card, paid by card or from the
+// buyer's wallet, and emails its code to the recipient when one is given.
+// The code is returned once, to the buyer; only its hash is kept.
+type PurchaseGiftCardUseCase struct {
+	giftCards  wallet.GiftCardRepository
+	ledger     *wallet.Ledger
+	provider   payment.Provider
+	email      notification.Sender
+	transactor tx.Transactor
+}
+
+func NewPurchaseGiftCardUseCase(
+	giftCards wallet.GiftCardRepository,
+	ledger *wallet.Ledger,
+	provider payment.Provider,
+	email notification.Sender,
+	transactor tx.Transactor,
+) *PurchaseGiftCardUseCase {
+	return &PurchaseGiftCardUseCase{
+		giftCards:  giftCards,
+		ledger:     ledger,
+		provider:   provider,
+		email:      email,
+		transactor: transactor,
+	}
+}
+
+func (uc *PurchaseGiftCardUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.PurchaseGiftCardRequest,
+) (*wallet.GiftCard, string, error) {
+	card, code, err := wallet.NewGiftCard(userID, input.Amount, input.RecipientName, input.RecipientEmail, input.Message)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Cards bought from the wallet are paid for inside the transaction
+	var charge *payment.Charge
+	if input.PayWith != "wallet" {
+		charge, err = uc.provider.Charge(ctx, payment.ChargeRequest{
+			Amount:         card.Amount,
+			Token:          input.PaymentToken,
+			Description:    "Gift card ending " + card.CodeLast4,
+			IdempotencyKey: card.ID.String(),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.giftCards.Create(ctx, card); err != nil {
+			return fmt.Errorf("failed to save gift card: %w", err)
+		}
+		chargeID := ""
+		if charge != nil {
+			chargeID = charge.ID
+		}
+		_, err := uc.ledger.PurchaseGiftCard(ctx, card, chargeID)
+		return err
+	})
+	if err != nil {
+		if charge != nil {
+			if _, refundErr := uc.provider.Refund(ctx, payment.RefundRequest{
+				ChargeID:       charge.ID,
+				Amount:         charge.Amount,
+				IdempotencyKey: "void-" + charge.ID,
+			}); refundErr != nil {
+				return nil, "", fmt.Errorf("%w (and refunding card charge %s failed: %v)", err, charge.ID, refundErr)
+			}
+		}
+		return nil, "", err
+	}
+
+	// The card is paid for either way; if the email fails the buyer still
+	// has the code to pass on, and SentAt stays empty
+	if card.RecipientEmail != "" {
+		if err := uc.email.Send(ctx, card.RecipientEmail, giftCardMessage(card, code)); err == nil {
+			now := time.Now()
+			card.SentAt = &now
+			_ = uc.giftCards.Update(ctx, card)
+		}
+	}
+	return card, code, nil
+}
+
+func giftCardMessage(card *wallet.GiftCard, code string) notification.Message {
+	var b strings.Builder
+	if card.RecipientName != "" {
+		fmt.Fprintf(&b, "Hi %s,\n\n", card.RecipientName)
+	}
+	fmt.Fprintf(&b, "You've been sent a gift card worth %.2f.\n", card.Amount)
+	if card.Message != "" {
+		fmt.Fprintf(&b, "\n%s\n", card.Message)
+	}
+	fmt.Fprintf(&b, "\nRedeem code %s into your wallet to spend it on your next order.\n", code)
+	return notification.Message{
+		Subject: "You've received a gift card",
+		Body:    b.String(),
+		Data:    map[string]string{"gift_card_id": card.ID.String()},
+	}
+}
@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+)
+
+// RedeemGiftCardUseCase adds a gift card's value to the customer's wallet.
+// The card is locked while it is redeemed, so a code works only once.
+type RedeemGiftCardUseCase struct {
+	giftCards  wallet.GiftCardRepository
+	ledger     *wallet.Ledger
+	transactor tx.Transactor
+}
+
+func NewRedeemGiftCardUseCase(
+	giftCards wallet.GiftCardRepository, ledger *wallet.Ledger, transactor tx.Transactor,
+) *RedeemGiftCardUseCase {
+	return &RedeemGiftCardUseCase{
+		giftCards:  giftCards,
+		ledger:     ledger,
+		transactor: transactor,
+	}
+}
+
+// Execute returns the redeemed card and the wallet balance after it.
+func (uc *RedeemGiftCardUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, code string,
+) (*wallet.GiftCard, float64, error) {
+	var card *wallet.GiftCard
+	var balance float64
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		card, err = uc.giftCards.LockByCode(ctx, wallet.HashCode(code))
+		if err != nil {
+			return err
+		}
+		if card == nil {
+			return wallet.ErrGiftCardNotFound
+		}
+		if err := card.Redeem(userID, time.Now()); err != nil {
+			return err
+		}
+		if err := uc.giftCards.Update(ctx, card); err != nil {
+			return fmt.Errorf("failed to redeem gift card: %w", err)
+		}
+		t, err := uc.ledger.RedeemGiftCard(ctx, card, userID)
+		if err != nil {
+			return err
+		}
+		// The wallet's is the only posting that adds money
+		for _, p := range t.Postings {
+			if p.Amount > 0 {
+				balance = p.BalanceAfter
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return card, balance, nil
+}
@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/james-wukong/orders-api/internal/domain/payment"
+	"github.com/james-wukong/orders-api/internal/domain/tx"
+	"github.com/james-wukong/orders-api/internal/domain/wallet"
+	"github.com/james-wukong/orders-api/internal/interfaces/http/dto"
+	"github.com/james-wukong/orders-api/internal/pkg/money"
+)
+
+// TopUpWalletUseCase charges the customer's card and adds the amount to
+// their wallet. If the ledger can't record it the charge is refunded.
+type TopUpWalletUseCase struct {
+	ledger     *wallet.Ledger
+	provider   payment.Provider
+	transactor tx.Transactor
+}
+
+func NewTopUpWalletUseCase(ledger *wallet.Ledger, provider payment.Provider, transactor tx.Transactor) *TopUpWalletUseCase {
+	return &TopUpWalletUseCase{
+		ledger:     ledger,
+		provider:   provider,
+		transactor: transactor,
+	}
+}
+
+func (uc *TopUpWalletUseCase) Execute(
+	ctx context.Context, userID uuid.UUID, input dto.TopUpWalletRequest,
+) (*wallet.Transaction, error) {
+	amount := money.Round(input.Amount)
+	if amount <= 0 {
+		return nil, wallet.ErrInvalidAmount
+	}
+	charge, err := uc.provider.Charge(ctx, payment.ChargeRequest{
+		Amount:         amount,
+		Token:          input.PaymentToken,
+		Description:    "Wallet top-up",
+		IdempotencyKey: uuid.NewString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var t *wallet.Transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		t, err = uc.ledger.TopUp(ctx, userID, charge.Amount, charge.ID)
+		return err
+	})
+	if err != nil {
+		if _, refundErr := uc.provider.Refund(ctx, payment.RefundRequest{
+			ChargeID:       charge.ID,
+			Amount:         charge.Amount,
+			IdempotencyKey: "void-" + charge.ID,
+		}); refundErr != nil {
+			return nil, fmt.Errorf("%w (and refunding card charge %s failed: %v)", err, charge.ID, refundErr)
+		}
+		return nil, err
+	}
+	return t, nil
+}
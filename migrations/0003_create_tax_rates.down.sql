@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE orders
+DROP COLUMN IF EXISTS tax_breakdown;
+
+ALTER TABLE menu_items
+DROP COLUMN IF EXISTS tax_category;
+
+ALTER TABLE restaurants
+DROP COLUMN IF EXISTS prices_include_tax;
+
+DROP TABLE IF EXISTS tax_rates CASCADE;
+DROP TYPE IF EXISTS tax_category_enum CASCADE;
+
+COMMIT;
@@ -0,0 +1,45 @@
+BEGIN;
+
+CREATE TYPE tax_category_enum AS ENUM ('food', 'alcohol', 'delivery');
+
+-- Tax rates per jurisdiction. Empty state/postal_code act as wildcards and
+-- all matching rates stack (e.g. state sales tax + city district tax).
+CREATE TABLE tax_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    country VARCHAR(100) NOT NULL,
+    state VARCHAR(100) NOT NULL DEFAULT '',
+    postal_code VARCHAR(20) NOT NULL DEFAULT '',
+    category tax_category_enum NOT NULL,
+    rate DECIMAL(7, 5) NOT NULL CHECK (rate >= 0),
+    rounding_mode VARCHAR(20) NOT NULL DEFAULT 'half_up'
+        CHECK (rounding_mode IN ('half_up', 'half_even', 'up', 'down')),
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(country, state, postal_code, category, name)
+);
+
+CREATE INDEX idx_tax_rates_country ON tax_rates(country);
+
+CREATE TRIGGER update_tax_rates_updated_at BEFORE UPDATE ON tax_rates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE restaurants
+ADD COLUMN prices_include_tax BOOLEAN DEFAULT false;
+
+ALTER TABLE menu_items
+ADD COLUMN tax_category tax_category_enum DEFAULT 'food';
+
+-- Snapshot of the rates applied at checkout, shown on receipts
+ALTER TABLE orders
+ADD COLUMN tax_breakdown JSONB DEFAULT '[]';
+
+-- Sample rates for the seeded San Francisco restaurant
+INSERT INTO tax_rates (name, country, state, postal_code, category, rate) VALUES
+('California State Sales Tax', 'USA', 'CA', '', 'food', 0.07250),
+('California State Sales Tax', 'USA', 'CA', '', 'alcohol', 0.07250),
+('San Francisco District Tax', 'USA', 'CA', '94102', 'food', 0.01375),
+('San Francisco District Tax', 'USA', 'CA', '94102', 'alcohol', 0.01375);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE orders
+DROP COLUMN IF EXISTS delivery_distance_km,
+DROP COLUMN IF EXISTS delivery_zone_id;
+
+DROP TABLE IF EXISTS delivery_zones CASCADE;
+
+COMMIT;
@@ -0,0 +1,35 @@
+BEGIN;
+
+-- Delivery zones: either a ring around the restaurant (radius) or a GeoJSON
+-- polygon. When zones overlap the lowest priority wins.
+CREATE TABLE delivery_zones (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    zone_type VARCHAR(20) NOT NULL CHECK (zone_type IN ('radius', 'polygon')),
+    min_radius_km DECIMAL(8, 3) DEFAULT 0,
+    max_radius_km DECIMAL(8, 3) DEFAULT 0,
+    polygon JSONB,
+    delivery_fee DECIMAL(10, 2) DEFAULT 0.00,
+    minimum_order DECIMAL(10, 2) DEFAULT 0.00,
+    extra_eta_minutes INTEGER DEFAULT 0,
+    priority INTEGER DEFAULT 0,
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT zone_shape CHECK (
+        (zone_type = 'radius' AND max_radius_km > min_radius_km)
+        OR (zone_type = 'polygon' AND polygon IS NOT NULL)
+    )
+);
+
+CREATE INDEX idx_delivery_zones_restaurant_id ON delivery_zones(restaurant_id);
+
+CREATE TRIGGER update_delivery_zones_updated_at BEFORE UPDATE ON delivery_zones
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE orders
+ADD COLUMN delivery_zone_id UUID REFERENCES delivery_zones(id) ON DELETE SET NULL,
+ADD COLUMN delivery_distance_km DECIMAL(8, 3);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS update_delivery_addresses_updated_at ON delivery_addresses;
+DROP INDEX IF EXISTS idx_delivery_addresses_user_id;
+DROP INDEX IF EXISTS uniq_delivery_addresses_default;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- Keep only the most recently updated default per user before adding the index
+UPDATE delivery_addresses da
+SET is_default = false
+WHERE is_default
+AND EXISTS (
+    SELECT 1 FROM delivery_addresses other
+    WHERE other.user_id = da.user_id
+    AND other.is_default
+    AND (other.updated_at, other.id) > (da.updated_at, da.id)
+);
+
+-- At most one default address per user
+CREATE UNIQUE INDEX uniq_delivery_addresses_default
+    ON delivery_addresses(user_id) WHERE is_default;
+
+CREATE INDEX idx_delivery_addresses_user_id ON delivery_addresses(user_id);
+
+CREATE TRIGGER update_delivery_addresses_updated_at BEFORE UPDATE ON delivery_addresses
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP TABLE IF EXISTS order_slots CASCADE;
+
+DROP INDEX IF EXISTS idx_orders_release_at;
+
+ALTER TABLE orders
+DROP COLUMN IF EXISTS release_at;
+
+ALTER TABLE restaurants
+DROP COLUMN IF EXISTS preorder_max_days,
+DROP COLUMN IF EXISTS preorder_slot_capacity,
+DROP COLUMN IF EXISTS preorder_slot_minutes,
+DROP COLUMN IF EXISTS timezone;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Pre-order settings. Opening hours and slots are interpreted in the
+-- restaurant's own time zone.
+ALTER TABLE restaurants
+ADD COLUMN timezone VARCHAR(64) DEFAULT 'UTC',
+ADD COLUMN preorder_slot_minutes INTEGER DEFAULT 15 CHECK (preorder_slot_minutes > 0),
+ADD COLUMN preorder_slot_capacity INTEGER DEFAULT 10 CHECK (preorder_slot_capacity >= 0),
+ADD COLUMN preorder_max_days INTEGER DEFAULT 7 CHECK (preorder_max_days >= 0);
+
+UPDATE restaurants SET timezone = 'America/Los_Angeles' WHERE slug = 'gourmet-kitchen';
+
+-- release_at is scheduled_for minus prep time; the scheduler confirms the
+-- order once it has passed.
+ALTER TABLE orders
+ADD COLUMN release_at TIMESTAMP;
+
+CREATE INDEX idx_orders_release_at ON orders(status, release_at) WHERE release_at IS NOT NULL;
+
+-- Reserved capacity per pre-order slot. Shared by all replicas and only ever
+-- changed with conditional single-row updates.
+CREATE TABLE order_slots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    slot_start TIMESTAMP NOT NULL,
+    reserved INTEGER NOT NULL DEFAULT 0 CHECK (reserved >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(restaurant_id, slot_start)
+);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP VIEW IF EXISTS order_eta_accuracy;
+
+DROP INDEX IF EXISTS idx_orders_in_kitchen;
+
+ALTER TABLE orders
+DROP COLUMN IF EXISTS promised_delivery_time,
+DROP COLUMN IF EXISTS travel_minutes,
+DROP COLUMN IF EXISTS prep_minutes;
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- Inputs of the per-order ETA, fixed at checkout, and the ETA promised to
+-- the customer. estimated_delivery_time keeps moving with each status change.
+ALTER TABLE orders
+ADD COLUMN prep_minutes INTEGER DEFAULT 0,
+ADD COLUMN travel_minutes INTEGER DEFAULT 0,
+ADD COLUMN promised_delivery_time TIMESTAMP;
+
+-- Kitchen load lookups
+CREATE INDEX idx_orders_in_kitchen ON orders(restaurant_id)
+    WHERE status IN ('confirmed', 'preparing');
+
+-- How far delivered orders landed from their promised ETA, in minutes.
+-- Positive values are late deliveries.
+CREATE OR REPLACE VIEW order_eta_accuracy AS
+SELECT
+    o.id AS order_id,
+    o.restaurant_id,
+    o.order_type,
+    o.promised_delivery_time,
+    o.estimated_delivery_time,
+    o.delivered_at,
+    EXTRACT(EPOCH FROM (o.delivered_at - o.promised_delivery_time)) / 60 AS error_minutes
+FROM orders o
+WHERE o.status = 'delivered'
+  AND o.delivered_at IS NOT NULL
+  AND o.promised_delivery_time IS NOT NULL;
+
+COMMIT;
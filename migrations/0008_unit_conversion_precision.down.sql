@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE units_of_measure
+DROP CONSTRAINT IF EXISTS units_of_measure_positive_factor;
+
+ALTER TABLE units_of_measure
+ALTER COLUMN conversion_factor TYPE DECIMAL(10, 4);
+
+COMMIT;
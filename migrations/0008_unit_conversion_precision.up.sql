@@ -0,0 +1,18 @@
+BEGIN;
+
+-- DECIMAL(10, 4) truncated small factors (teaspoon 0.00492892 -> 0.0049),
+-- which skews every conversion through the base unit.
+ALTER TABLE units_of_measure
+ALTER COLUMN conversion_factor TYPE DECIMAL(18, 9);
+
+UPDATE units_of_measure SET conversion_factor = 0.453592 WHERE name = 'Pound';
+UPDATE units_of_measure SET conversion_factor = 0.0283495 WHERE name = 'Ounce';
+UPDATE units_of_measure SET conversion_factor = 3.78541 WHERE name = 'Gallon';
+UPDATE units_of_measure SET conversion_factor = 0.236588 WHERE name = 'Cup';
+UPDATE units_of_measure SET conversion_factor = 0.0147868 WHERE name = 'Tablespoon';
+UPDATE units_of_measure SET conversion_factor = 0.00492892 WHERE name = 'Teaspoon';
+
+ALTER TABLE units_of_measure
+ADD CONSTRAINT units_of_measure_positive_factor CHECK (conversion_factor > 0);
+
+COMMIT;
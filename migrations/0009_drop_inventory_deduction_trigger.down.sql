@@ -0,0 +1,61 @@
+BEGIN;
+
+-- Deduct inventory when order is confirmed
+CREATE OR REPLACE FUNCTION deduct_inventory_on_order()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_recipe_id UUID;
+    v_ingredient RECORD;
+BEGIN
+    -- Only process when status changes to 'confirmed'
+    IF NEW.status = 'confirmed' AND OLD.status != 'confirmed' THEN
+        -- Loop through each order item
+        FOR v_recipe_id IN
+            SELECT r.id
+            FROM order_items oi
+            JOIN recipes r ON r.menu_item_id = oi.menu_item_id
+            WHERE oi.order_id = NEW.id
+        LOOP
+            -- Deduct ingredients for each recipe
+            FOR v_ingredient IN
+                SELECT ri.inventory_item_id, ri.quantity, ri.unit_of_measure_id, oi.quantity as order_qty
+                FROM recipe_ingredients ri
+                JOIN order_items oi ON oi.order_id = NEW.id
+                JOIN recipes r ON r.id = ri.recipe_id AND r.menu_item_id = oi.menu_item_id
+                WHERE ri.recipe_id = v_recipe_id
+            LOOP
+                -- Update inventory
+                UPDATE inventory_items
+                SET current_stock = current_stock - (v_ingredient.quantity * v_ingredient.order_qty)
+                WHERE id = v_ingredient.inventory_item_id;
+
+                -- Log transaction
+                INSERT INTO inventory_transactions (
+                    inventory_item_id, transaction_type, quantity,
+                    unit_of_measure_id, quantity_before, quantity_after,
+                    reference_type, reference_id, reason
+                )
+                SELECT
+                    v_ingredient.inventory_item_id,
+                    'usage',
+                    v_ingredient.quantity * v_ingredient.order_qty,
+                    v_ingredient.unit_of_measure_id,
+                    current_stock + (v_ingredient.quantity * v_ingredient.order_qty),
+                    current_stock,
+                    'order',
+                    NEW.id,
+                    'Used for order ' || NEW.order_number
+                FROM inventory_items
+                WHERE id = v_ingredient.inventory_item_id;
+            END LOOP;
+        END LOOP;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER deduct_inventory_trigger AFTER UPDATE ON orders
+    FOR EACH ROW EXECUTE FUNCTION deduct_inventory_on_order();
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Stock is now deducted by the application when an order is confirmed, with
+-- unit conversion, optional ingredients and a negative-stock policy.
+DROP TRIGGER IF EXISTS deduct_inventory_trigger ON orders;
+DROP FUNCTION IF EXISTS deduct_inventory_on_order();
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+-- A menu item has at most one recipe. Keep the most recently updated one,
+-- which is the one stock deduction already used.
+DELETE FROM recipes r
+USING recipes newer
+WHERE newer.menu_item_id = r.menu_item_id
+  AND (COALESCE(newer.updated_at, newer.created_at), newer.id)
+    > (COALESCE(r.updated_at, r.created_at), r.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_recipes_menu_item ON recipes(menu_item_id);
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- A replaced view can't drop columns, so recreate it
+DROP VIEW IF EXISTS daily_inventory_usage;
+
+CREATE VIEW daily_inventory_usage AS
+SELECT
+    DATE(it.created_at) AS usage_date,
+    ii.name AS ingredient_name,
+    SUM(it.quantity) AS total_used,
+    uom.abbreviation AS unit,
+    SUM(it.total_cost) AS total_cost
+FROM inventory_transactions it
+JOIN inventory_items ii ON it.inventory_item_id = ii.id
+LEFT JOIN units_of_measure uom ON it.unit_of_measure_id = uom.id
+WHERE it.transaction_type = 'usage'
+GROUP BY DATE(it.created_at), ii.name, uom.abbreviation;
+
+COMMIT;
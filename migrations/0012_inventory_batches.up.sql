@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Stock per received lot. inventory_items.current_stock stays the total;
+-- batches say which lots it is made of and when each expires, so usage can
+-- take the first-expiring lot first.
+CREATE TABLE inventory_batches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    batch_number VARCHAR(100),
+    expiry_date DATE,
+    quantity_received DECIMAL(12, 3) NOT NULL,
+    quantity_remaining DECIMAL(12, 3) NOT NULL CHECK (quantity_remaining >= 0),
+    unit_cost DECIMAL(10, 2),
+    received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reference_type VARCHAR(50),
+    reference_id UUID,
+    -- Last expiry alert raised for the lot, so each is raised once
+    expiry_alert alert_type_enum,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_inventory_batches_open ON inventory_batches(inventory_item_id, expiry_date)
+    WHERE quantity_remaining > 0;
+
+-- Stock on hand before batches were tracked becomes one undated lot
+INSERT INTO inventory_batches (inventory_item_id, batch_number, quantity_received, quantity_remaining, unit_cost)
+SELECT id, 'OPENING', current_stock, current_stock, average_cost
+FROM inventory_items
+WHERE current_stock > 0;
+
+COMMIT;
@@ -0,0 +1,39 @@
+BEGIN;
+
+DROP TABLE IF EXISTS stock_alert_subscriptions CASCADE;
+
+CREATE OR REPLACE FUNCTION check_low_stock()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- Check if stock fell below minimum
+    IF NEW.current_stock <= NEW.minimum_stock THEN
+        INSERT INTO stock_alerts (inventory_item_id, alert_type, current_stock, threshold_value)
+        VALUES (NEW.id, 'low_stock', NEW.current_stock, NEW.minimum_stock)
+        ON CONFLICT DO NOTHING;
+    END IF;
+
+    -- Check if out of stock
+    IF NEW.current_stock <= 0 THEN
+        INSERT INTO stock_alerts (inventory_item_id, alert_type, current_stock, threshold_value)
+        VALUES (NEW.id, 'out_of_stock', NEW.current_stock, 0)
+        ON CONFLICT DO NOTHING;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+DROP INDEX IF EXISTS idx_stock_alerts_unnotified;
+
+ALTER TABLE stock_alerts
+DROP COLUMN IF EXISTS notify_claimed_until,
+DROP COLUMN IF EXISTS notify_attempts,
+DROP COLUMN IF EXISTS notified_at,
+DROP COLUMN IF EXISTS acknowledged_by,
+DROP COLUMN IF EXISTS acknowledged_at;
+
+DROP INDEX IF EXISTS idx_stock_alerts_open;
+
+ALTER TABLE stock_alerts ALTER COLUMN is_resolved DROP NOT NULL;
+
+COMMIT;
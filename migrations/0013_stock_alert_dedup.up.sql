@@ -0,0 +1,81 @@
+BEGIN;
+
+-- check_low_stock's ON CONFLICT DO NOTHING never matched anything, so every
+-- update of a low item added another alert. Keep the oldest open alert per
+-- item, type and expiry date and make that unique.
+UPDATE stock_alerts SET is_resolved = false WHERE is_resolved IS NULL;
+ALTER TABLE stock_alerts ALTER COLUMN is_resolved SET NOT NULL;
+
+DELETE FROM stock_alerts
+WHERE id IN (
+    SELECT id FROM (
+        SELECT id, ROW_NUMBER() OVER (
+            PARTITION BY inventory_item_id, alert_type, COALESCE(expiry_date, DATE 'infinity')
+            ORDER BY created_at, id
+        ) AS n
+        FROM stock_alerts
+        WHERE NOT is_resolved
+    ) ranked
+    WHERE n > 1
+);
+
+CREATE UNIQUE INDEX idx_stock_alerts_open
+    ON stock_alerts(inventory_item_id, alert_type, COALESCE(expiry_date, DATE 'infinity'))
+    WHERE NOT is_resolved;
+
+ALTER TABLE stock_alerts
+ADD COLUMN acknowledged_at TIMESTAMP,
+ADD COLUMN acknowledged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+ADD COLUMN notified_at TIMESTAMP,
+ADD COLUMN notify_attempts INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN notify_claimed_until TIMESTAMP;
+
+-- Alerts raised so far are not news; only new ones are sent out
+UPDATE stock_alerts SET notified_at = CURRENT_TIMESTAMP;
+
+CREATE INDEX idx_stock_alerts_unnotified ON stock_alerts(created_at) WHERE notified_at IS NULL;
+
+-- Resolve stock alerts once stock recovers, so the next shortage raises a
+-- fresh one
+CREATE OR REPLACE FUNCTION check_low_stock()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.current_stock <= NEW.minimum_stock THEN
+        INSERT INTO stock_alerts (inventory_item_id, alert_type, current_stock, threshold_value)
+        VALUES (NEW.id, 'low_stock', NEW.current_stock, NEW.minimum_stock)
+        ON CONFLICT DO NOTHING;
+    ELSE
+        UPDATE stock_alerts SET is_resolved = true, resolved_at = CURRENT_TIMESTAMP
+        WHERE inventory_item_id = NEW.id AND alert_type = 'low_stock' AND NOT is_resolved;
+    END IF;
+
+    IF NEW.current_stock <= 0 THEN
+        INSERT INTO stock_alerts (inventory_item_id, alert_type, current_stock, threshold_value)
+        VALUES (NEW.id, 'out_of_stock', NEW.current_stock, 0)
+        ON CONFLICT DO NOTHING;
+    ELSE
+        UPDATE stock_alerts SET is_resolved = true, resolved_at = CURRENT_TIMESTAMP
+        WHERE inventory_item_id = NEW.id AND alert_type = 'out_of_stock' AND NOT is_resolved;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- Where new alerts for a restaurant are sent: an email address or a webhook
+-- URL, registered by one of its inventory managers
+CREATE TABLE stock_alert_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('email', 'webhook')),
+    target TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(restaurant_id, channel, target)
+);
+
+CREATE INDEX idx_stock_alert_subscriptions_user_id ON stock_alert_subscriptions(user_id);
+
+COMMIT;
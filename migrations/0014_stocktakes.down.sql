@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS stocktake_lines CASCADE;
+DROP TABLE IF EXISTS stocktakes CASCADE;
+DROP TYPE IF EXISTS stocktake_status_enum;
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+CREATE TYPE stocktake_status_enum AS ENUM ('open', 'submitted', 'approved', 'cancelled');
+
+-- A physical count of a restaurant's stock, optionally limited to one
+-- storage location or ingredient category
+CREATE TABLE stocktakes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    storage_location VARCHAR(255),
+    category_id UUID REFERENCES ingredient_categories(id) ON DELETE SET NULL,
+    status stocktake_status_enum NOT NULL DEFAULT 'open',
+    notes TEXT,
+    opened_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    submitted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    submitted_at TIMESTAMP,
+    approved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    approved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One line per item in scope. expected_quantity and unit_cost are the
+-- system's stock and average cost when the item was last counted.
+CREATE TABLE stocktake_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    stocktake_id UUID NOT NULL REFERENCES stocktakes(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE RESTRICT,
+    expected_quantity DECIMAL(12, 3) NOT NULL,
+    counted_quantity DECIMAL(12, 3) CHECK (counted_quantity >= 0),
+    unit_cost DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    reason adjustment_reason_enum NOT NULL DEFAULT 'miscounted',
+    counted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    counted_at TIMESTAMP,
+    adjustment_id UUID REFERENCES inventory_adjustments(id) ON DELETE SET NULL,
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(stocktake_id, inventory_item_id)
+);
+
+CREATE INDEX idx_stocktakes_restaurant_id ON stocktakes(restaurant_id, created_at);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_inventory_transactions_waste;
+DROP TABLE IF EXISTS waste_entries CASCADE;
+
+COMMIT;
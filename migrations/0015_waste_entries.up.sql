@@ -0,0 +1,27 @@
+BEGIN;
+
+-- What a cook threw away: either an inventory item or portions of a menu
+-- item, which expand through its recipe. Each entry writes one or more
+-- 'waste' inventory_transactions referencing it.
+CREATE TABLE waste_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    inventory_item_id UUID REFERENCES inventory_items(id) ON DELETE RESTRICT,
+    menu_item_id UUID REFERENCES menu_items(id) ON DELETE RESTRICT,
+    quantity DECIMAL(12, 3) NOT NULL CHECK (quantity > 0),
+    unit_of_measure_id UUID REFERENCES units_of_measure(id),
+    reason adjustment_reason_enum NOT NULL,
+    total_cost DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    notes TEXT,
+    logged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((inventory_item_id IS NULL) <> (menu_item_id IS NULL))
+);
+
+CREATE INDEX idx_waste_entries_restaurant_id ON waste_entries(restaurant_id, created_at DESC);
+
+-- The waste report scans waste entries by day
+CREATE INDEX idx_inventory_transactions_waste ON inventory_transactions(created_at)
+    WHERE transaction_type = 'waste';
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP TABLE IF EXISTS stock_transfer_lines CASCADE;
+DROP TABLE IF EXISTS stock_transfers CASCADE;
+DROP FUNCTION IF EXISTS generate_transfer_number();
+DROP SEQUENCE IF EXISTS transfer_number_seq;
+DROP TYPE IF EXISTS transfer_status_enum;
+
+-- Fails if two restaurants now share a SKU
+ALTER TABLE inventory_items DROP CONSTRAINT IF EXISTS inventory_items_restaurant_sku_key;
+ALTER TABLE inventory_items ADD CONSTRAINT inventory_items_sku_key UNIQUE (sku);
+
+COMMIT;
@@ -0,0 +1,65 @@
+BEGIN;
+
+-- Transfers match items across restaurants by SKU, so a SKU only has to be
+-- unique within a restaurant
+ALTER TABLE inventory_items DROP CONSTRAINT IF EXISTS inventory_items_sku_key;
+ALTER TABLE inventory_items ADD CONSTRAINT inventory_items_restaurant_sku_key UNIQUE (restaurant_id, sku);
+
+CREATE TYPE transfer_status_enum AS ENUM ('requested', 'shipped', 'received', 'cancelled');
+
+-- Stock moved from one restaurant to another. Shipped stock has left the
+-- source and is in transit until the destination receives it.
+CREATE TABLE stock_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transfer_number VARCHAR(50) UNIQUE NOT NULL,
+    source_restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE RESTRICT,
+    destination_restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE RESTRICT,
+    status transfer_status_enum NOT NULL DEFAULT 'requested',
+    notes TEXT,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    shipped_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    shipped_at TIMESTAMP,
+    received_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    received_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (source_restaurant_id <> destination_restaurant_id)
+);
+
+-- Quantities are in the source item's unit. unit_cost is the source's
+-- average cost when the line shipped; expiry_date the earliest expiry of
+-- the batches it shipped from.
+CREATE TABLE stock_transfer_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transfer_id UUID NOT NULL REFERENCES stock_transfers(id) ON DELETE CASCADE,
+    source_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE RESTRICT,
+    destination_item_id UUID REFERENCES inventory_items(id) ON DELETE RESTRICT,
+    sku VARCHAR(100) NOT NULL,
+    quantity_requested DECIMAL(12, 3) NOT NULL CHECK (quantity_requested > 0),
+    quantity_shipped DECIMAL(12, 3) CHECK (quantity_shipped >= 0),
+    quantity_received DECIMAL(12, 3) CHECK (quantity_received >= 0),
+    unit_cost DECIMAL(10, 2),
+    expiry_date DATE,
+    discrepancy_reason adjustment_reason_enum,
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(transfer_id, source_item_id)
+);
+
+CREATE INDEX idx_stock_transfers_source ON stock_transfers(source_restaurant_id, created_at DESC);
+CREATE INDEX idx_stock_transfers_destination ON stock_transfers(destination_restaurant_id, created_at DESC);
+
+CREATE OR REPLACE FUNCTION generate_transfer_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.transfer_number := 'TR-' || TO_CHAR(CURRENT_DATE, 'YYYYMMDD') || '-' ||
+                           LPAD(NEXTVAL('transfer_number_seq')::TEXT, 4, '0');
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE SEQUENCE transfer_number_seq;
+CREATE TRIGGER set_transfer_number BEFORE INSERT ON stock_transfers
+    FOR EACH ROW EXECUTE FUNCTION generate_transfer_number();
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS supplier_price_history CASCADE;
+DROP TABLE IF EXISTS supplier_prices CASCADE;
+
+COMMIT;
@@ -0,0 +1,51 @@
+BEGIN;
+
+-- What each supplier charges for an inventory item, in the item's unit. An
+-- item may have several suppliers; inventory_items.supplier_id stays the
+-- preferred one.
+CREATE TABLE supplier_prices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supplier_id UUID NOT NULL REFERENCES suppliers(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    supplier_sku VARCHAR(100),
+    unit_price DECIMAL(10, 2) NOT NULL CHECK (unit_price >= 0),
+    minimum_quantity DECIMAL(12, 3) NOT NULL DEFAULT 0 CHECK (minimum_quantity >= 0),
+    last_paid_price DECIMAL(10, 2),
+    last_paid_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(supplier_id, inventory_item_id)
+);
+
+CREATE INDEX idx_supplier_prices_item ON supplier_prices(inventory_item_id);
+
+-- Every price a supplier quoted (price_list) or was paid (purchase_order)
+CREATE TABLE supplier_price_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supplier_id UUID NOT NULL REFERENCES suppliers(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    unit_price DECIMAL(10, 2) NOT NULL,
+    source VARCHAR(50) NOT NULL,
+    reference_id UUID,
+    recorded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_supplier_price_history_item ON supplier_price_history(inventory_item_id, created_at DESC);
+
+-- Seed history from purchase orders already received, at the cost booked
+INSERT INTO supplier_price_history (supplier_id, inventory_item_id, unit_price, source, reference_id, recorded_by, created_at)
+SELECT po.supplier_id, it.inventory_item_id, it.unit_cost, 'purchase_order', po.id, it.performed_by, it.created_at
+FROM inventory_transactions it
+JOIN purchase_orders po ON po.id = it.reference_id
+WHERE it.transaction_type = 'purchase'
+  AND it.reference_type = 'purchase_order'
+  AND it.unit_cost IS NOT NULL;
+
+-- and price lists from each item's supplier at its last purchase cost
+INSERT INTO supplier_prices (supplier_id, inventory_item_id, unit_price, last_paid_price, last_paid_at)
+SELECT supplier_id, id, COALESCE(last_purchase_cost, unit_cost, 0), last_purchase_cost, last_purchase_date
+FROM inventory_items
+WHERE supplier_id IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_inventory_transactions_item_created;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS valuation_method;
+DROP TYPE IF EXISTS valuation_method_enum;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+-- How each restaurant costs its stock: FIFO layers built from receipts, or
+-- the moving weighted average kept on inventory_items.average_cost.
+CREATE TYPE valuation_method_enum AS ENUM ('fifo', 'weighted_average');
+
+ALTER TABLE restaurants
+    ADD COLUMN valuation_method valuation_method_enum NOT NULL DEFAULT 'weighted_average';
+
+-- Valuation replays each item's ledger in order
+CREATE INDEX idx_inventory_transactions_item_created ON inventory_transactions(inventory_item_id, created_at);
+
+COMMIT;
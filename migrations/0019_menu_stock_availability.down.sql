@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS check_menu_availability_trigger ON inventory_items;
+DROP FUNCTION IF EXISTS check_menu_availability();
+DROP FUNCTION IF EXISTS refresh_menu_item_availability(UUID);
+DROP FUNCTION IF EXISTS menu_item_short_ingredient(UUID);
+DROP TABLE IF EXISTS menu_item_availability_log CASCADE;
+
+-- Items stock switched off go back on rather than staying off for good
+UPDATE menu_items SET is_available = true WHERE stock_disabled_at IS NOT NULL;
+
+ALTER TABLE menu_items
+DROP COLUMN IF EXISTS stock_disabled_at,
+DROP COLUMN IF EXISTS unavailable_reason;
+
+COMMIT;
@@ -0,0 +1,117 @@
+BEGIN;
+
+-- Menu items whose recipe can't be made from stock on hand are switched off
+-- automatically. stock_disabled_at marks those, so restocking only turns
+-- back on what stock turned off, never an item a manager took off the menu.
+ALTER TABLE menu_items
+ADD COLUMN unavailable_reason TEXT,
+ADD COLUMN stock_disabled_at TIMESTAMP;
+
+-- Why a menu item was switched off or back on
+CREATE TABLE menu_item_availability_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_item_id UUID NOT NULL REFERENCES menu_items(id) ON DELETE CASCADE,
+    is_available BOOLEAN NOT NULL,
+    reason VARCHAR(50) NOT NULL,
+    inventory_item_id UUID REFERENCES inventory_items(id) ON DELETE SET NULL,
+    details TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_menu_item_availability_log_item ON menu_item_availability_log(menu_item_id, created_at DESC);
+
+-- The first required ingredient of a menu item's recipe with less stock than
+-- one portion takes, with what it takes in the item's unit. Units convert the
+-- way the Go side does (same type and base unit); an ingredient whose recipe
+-- unit can't be converted to the item's unit counts as short, with a NULL
+-- requirement, rather than being compared as if the units matched.
+CREATE OR REPLACE FUNCTION menu_item_short_ingredient(p_menu_item_id UUID)
+RETURNS TABLE (inventory_item_id UUID, name VARCHAR, required DECIMAL, current_stock DECIMAL, unit VARCHAR) AS $$
+    SELECT ii.id, ii.name, needed.qty, ii.current_stock, su.abbreviation
+    FROM recipes r
+    JOIN recipe_ingredients ri ON ri.recipe_id = r.id AND NOT COALESCE(ri.is_optional, false)
+    JOIN inventory_items ii ON ii.id = ri.inventory_item_id
+    LEFT JOIN units_of_measure ru ON ru.id = ri.unit_of_measure_id
+    LEFT JOIN units_of_measure su ON su.id = ii.unit_of_measure_id
+    CROSS JOIN LATERAL (
+        SELECT ROUND(ri.quantity / GREATEST(COALESCE(r.serving_size, 1), 1)
+            * CASE
+                WHEN ru.id IS NULL OR ru.id = su.id THEN 1
+                WHEN su.id IS NULL OR su.conversion_factor = 0
+                    OR ru.type <> su.type OR ru.base_unit IS DISTINCT FROM su.base_unit THEN NULL
+                ELSE ru.conversion_factor / su.conversion_factor
+              END, 3) AS qty
+    ) needed
+    WHERE r.menu_item_id = p_menu_item_id
+      AND (needed.qty IS NULL OR ii.current_stock < needed.qty)
+    ORDER BY ri.ingredient_order, ii.name
+    LIMIT 1;
+$$ LANGUAGE sql STABLE;
+
+-- Switch a menu item off when an ingredient runs short, and back on once
+-- every ingredient is in stock again if stock was what switched it off
+CREATE OR REPLACE FUNCTION refresh_menu_item_availability(p_menu_item_id UUID)
+RETURNS VOID AS $$
+DECLARE
+    shortage RECORD;
+    reason_text TEXT;
+BEGIN
+    SELECT * INTO shortage FROM menu_item_short_ingredient(p_menu_item_id);
+
+    IF FOUND THEN
+        IF shortage.required IS NULL THEN
+            reason_text := 'Recipe unit for ' || shortage.name || ' does not match its stock unit';
+        ELSE
+            reason_text := 'Out of ' || shortage.name;
+        END IF;
+        UPDATE menu_items
+        SET is_available = false, unavailable_reason = reason_text, stock_disabled_at = CURRENT_TIMESTAMP
+        WHERE id = p_menu_item_id AND is_available;
+        IF FOUND THEN
+            INSERT INTO menu_item_availability_log (menu_item_id, is_available, reason, inventory_item_id, details)
+            VALUES (p_menu_item_id, false,
+                    CASE WHEN shortage.required IS NULL THEN 'incompatible_units' ELSE 'out_of_stock' END,
+                    shortage.inventory_item_id,
+                    CASE WHEN shortage.required IS NULL THEN reason_text
+                    ELSE format('%s: %s %s in stock, a portion takes %s %s', shortage.name,
+                                shortage.current_stock, COALESCE(shortage.unit, ''), shortage.required, COALESCE(shortage.unit, ''))
+                    END);
+        END IF;
+    ELSE
+        UPDATE menu_items
+        SET is_available = true, unavailable_reason = NULL, stock_disabled_at = NULL
+        WHERE id = p_menu_item_id AND NOT is_available AND stock_disabled_at IS NOT NULL;
+        IF FOUND THEN
+            INSERT INTO menu_item_availability_log (menu_item_id, is_available, reason, details)
+            VALUES (p_menu_item_id, true, 'restocked', 'Every ingredient is back in stock');
+        END IF;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION check_menu_availability()
+RETURNS TRIGGER AS $$
+DECLARE
+    mi_id UUID;
+BEGIN
+    FOR mi_id IN
+        SELECT DISTINCT r.menu_item_id
+        FROM recipe_ingredients ri
+        JOIN recipes r ON r.id = ri.recipe_id
+        WHERE ri.inventory_item_id = NEW.id
+        ORDER BY r.menu_item_id
+    LOOP
+        PERFORM refresh_menu_item_availability(mi_id);
+    END LOOP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER check_menu_availability_trigger AFTER UPDATE OF current_stock ON inventory_items
+    FOR EACH ROW WHEN (OLD.current_stock IS DISTINCT FROM NEW.current_stock)
+    EXECUTE FUNCTION check_menu_availability();
+
+-- Bring every menu item with a recipe in line with today's stock
+SELECT refresh_menu_item_availability(menu_item_id) FROM recipes;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS unknown_barcodes CASCADE;
+DROP TABLE IF EXISTS scan_session_lines CASCADE;
+DROP TABLE IF EXISTS scan_sessions CASCADE;
+DROP TYPE IF EXISTS unknown_barcode_status_enum;
+DROP TYPE IF EXISTS scan_session_status_enum;
+DROP TYPE IF EXISTS scan_session_type_enum;
+DROP INDEX IF EXISTS idx_inventory_items_barcode;
+
+COMMIT;
@@ -0,0 +1,68 @@
+BEGIN;
+
+-- Scanners look items up by barcode at one restaurant
+CREATE INDEX idx_inventory_items_barcode ON inventory_items(restaurant_id, barcode)
+WHERE barcode IS NOT NULL AND barcode <> '';
+
+CREATE TYPE scan_session_type_enum AS ENUM ('receiving', 'count');
+CREATE TYPE scan_session_status_enum AS ENUM ('open', 'committed', 'cancelled');
+CREATE TYPE unknown_barcode_status_enum AS ENUM ('pending', 'mapped', 'dismissed');
+
+-- A run of scans against a purchase order being received or a stocktake
+-- being counted. Nothing touches stock until the session is committed.
+CREATE TABLE scan_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    type scan_session_type_enum NOT NULL,
+    purchase_order_id UUID REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    stocktake_id UUID REFERENCES stocktakes(id) ON DELETE CASCADE,
+    status scan_session_status_enum NOT NULL DEFAULT 'open',
+    notes TEXT,
+    started_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    committed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    committed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (
+        (type = 'receiving' AND purchase_order_id IS NOT NULL AND stocktake_id IS NULL) OR
+        (type = 'count' AND stocktake_id IS NOT NULL AND purchase_order_id IS NULL)
+    )
+);
+
+-- What has been scanned of an item, per batch and expiry date
+CREATE TABLE scan_session_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    scan_session_id UUID NOT NULL REFERENCES scan_sessions(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE RESTRICT,
+    batch_number VARCHAR(100),
+    expiry_date DATE,
+    quantity DECIMAL(12, 3) NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    scans INTEGER NOT NULL DEFAULT 0,
+    last_scanned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    last_scanned_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Barcodes no item carries yet, waiting for someone to say what they are
+CREATE TABLE unknown_barcodes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    scan_session_id UUID REFERENCES scan_sessions(id) ON DELETE SET NULL,
+    barcode VARCHAR(100) NOT NULL,
+    raw_code TEXT NOT NULL,
+    batch_number VARCHAR(100),
+    expiry_date DATE,
+    quantity DECIMAL(12, 3),
+    status unknown_barcode_status_enum NOT NULL DEFAULT 'pending',
+    inventory_item_id UUID REFERENCES inventory_items(id) ON DELETE SET NULL,
+    scanned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_scan_sessions_restaurant_id ON scan_sessions(restaurant_id, created_at);
+CREATE INDEX idx_scan_session_lines_session_id ON scan_session_lines(scan_session_id);
+CREATE INDEX idx_unknown_barcodes_restaurant_status ON unknown_barcodes(restaurant_id, status, barcode);
+
+COMMIT;
@@ -0,0 +1,74 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_orders_restaurant_created;
+
+-- A replaced view can't drop columns, so recreate them
+DROP VIEW IF EXISTS order_summary;
+DROP VIEW IF EXISTS daily_inventory_usage;
+DROP VIEW IF EXISTS low_stock_items;
+DROP VIEW IF EXISTS inventory_value;
+
+CREATE VIEW inventory_value AS
+SELECT
+    ii.id,
+    ii.name,
+    ii.current_stock,
+    ii.average_cost,
+    ii.current_stock * ii.average_cost AS total_value,
+    uom.abbreviation AS unit,
+    ic.name AS category_name,
+    s.name AS supplier_name
+FROM inventory_items ii
+LEFT JOIN units_of_measure uom ON ii.unit_of_measure_id = uom.id
+LEFT JOIN ingredient_categories ic ON ii.category_id = ic.id
+LEFT JOIN suppliers s ON ii.supplier_id = s.id
+WHERE ii.is_active = true;
+
+CREATE VIEW low_stock_items AS
+SELECT
+    ii.id,
+    ii.name,
+    ii.current_stock,
+    ii.minimum_stock,
+    ii.reorder_point,
+    ii.reorder_quantity,
+    uom.abbreviation AS unit,
+    s.name AS supplier_name,
+    s.phone AS supplier_phone
+FROM inventory_items ii
+LEFT JOIN units_of_measure uom ON ii.unit_of_measure_id = uom.id
+LEFT JOIN suppliers s ON ii.supplier_id = s.id
+WHERE ii.current_stock <= ii.minimum_stock
+AND ii.is_active = true;
+
+CREATE VIEW daily_inventory_usage AS
+SELECT
+    DATE(it.created_at) AS usage_date,
+    ii.name AS ingredient_name,
+    SUM(it.quantity) AS total_used,
+    uom.abbreviation AS unit,
+    SUM(it.total_cost) AS total_cost,
+    ii.id AS inventory_item_id
+FROM inventory_transactions it
+JOIN inventory_items ii ON it.inventory_item_id = ii.id
+LEFT JOIN units_of_measure uom ON it.unit_of_measure_id = uom.id
+WHERE it.transaction_type = 'usage'
+GROUP BY DATE(it.created_at), ii.id, ii.name, uom.abbreviation;
+
+CREATE VIEW order_summary AS
+SELECT
+    o.id,
+    o.order_number,
+    o.status,
+    o.total,
+    o.created_at,
+    u.first_name || ' ' || u.last_name AS customer_name,
+    r.name AS restaurant_name,
+    COUNT(oi.id) AS item_count
+FROM orders o
+JOIN users u ON o.user_id = u.id
+JOIN restaurants r ON o.restaurant_id = r.id
+LEFT JOIN order_items oi ON o.id = oi.order_id
+GROUP BY o.id, u.first_name, u.last_name, r.name;
+
+COMMIT;
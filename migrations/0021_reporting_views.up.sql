@@ -0,0 +1,81 @@
+BEGIN;
+
+-- Reports are per restaurant, so the views need restaurant_id; order
+-- reports also need the type and the cancellation. New columns can only be
+-- appended to a replaced view.
+CREATE OR REPLACE VIEW inventory_value AS
+SELECT
+    ii.id,
+    ii.name,
+    ii.current_stock,
+    ii.average_cost,
+    ii.current_stock * ii.average_cost AS total_value,
+    uom.abbreviation AS unit,
+    ic.name AS category_name,
+    s.name AS supplier_name,
+    ii.restaurant_id
+FROM inventory_items ii
+LEFT JOIN units_of_measure uom ON ii.unit_of_measure_id = uom.id
+LEFT JOIN ingredient_categories ic ON ii.category_id = ic.id
+LEFT JOIN suppliers s ON ii.supplier_id = s.id
+WHERE ii.is_active = true;
+
+CREATE OR REPLACE VIEW low_stock_items AS
+SELECT
+    ii.id,
+    ii.name,
+    ii.current_stock,
+    ii.minimum_stock,
+    ii.reorder_point,
+    ii.reorder_quantity,
+    uom.abbreviation AS unit,
+    s.name AS supplier_name,
+    s.phone AS supplier_phone,
+    ii.restaurant_id
+FROM inventory_items ii
+LEFT JOIN units_of_measure uom ON ii.unit_of_measure_id = uom.id
+LEFT JOIN suppliers s ON ii.supplier_id = s.id
+WHERE ii.current_stock <= ii.minimum_stock
+AND ii.is_active = true;
+
+CREATE OR REPLACE VIEW daily_inventory_usage AS
+SELECT
+    DATE(it.created_at) AS usage_date,
+    ii.name AS ingredient_name,
+    SUM(it.quantity) AS total_used,
+    uom.abbreviation AS unit,
+    SUM(it.total_cost) AS total_cost,
+    ii.id AS inventory_item_id,
+    ii.restaurant_id
+FROM inventory_transactions it
+JOIN inventory_items ii ON it.inventory_item_id = ii.id
+LEFT JOIN units_of_measure uom ON it.unit_of_measure_id = uom.id
+WHERE it.transaction_type = 'usage'
+GROUP BY DATE(it.created_at), ii.id, ii.name, uom.abbreviation;
+
+CREATE OR REPLACE VIEW order_summary AS
+SELECT
+    o.id,
+    o.order_number,
+    o.status,
+    o.total,
+    o.created_at,
+    u.first_name || ' ' || u.last_name AS customer_name,
+    r.name AS restaurant_name,
+    COUNT(oi.id) AS item_count,
+    o.restaurant_id,
+    o.order_type,
+    o.subtotal,
+    o.discount,
+    COALESCE(SUM(oi.quantity), 0) AS item_quantity,
+    o.cancelled_at,
+    o.cancellation_reason
+FROM orders o
+JOIN users u ON o.user_id = u.id
+JOIN restaurants r ON o.restaurant_id = r.id
+LEFT JOIN order_items oi ON o.id = oi.order_id
+GROUP BY o.id, u.first_name, u.last_name, r.name;
+
+CREATE INDEX IF NOT EXISTS idx_orders_restaurant_created ON orders(restaurant_id, created_at);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS review_item_ratings CASCADE;
+DROP TABLE IF EXISTS reviews CASCADE;
+DROP TYPE IF EXISTS review_status_enum;
+
+COMMIT;
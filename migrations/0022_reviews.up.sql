@@ -0,0 +1,46 @@
+BEGIN;
+
+CREATE TYPE review_status_enum AS ENUM ('visible', 'hidden');
+
+-- One review per delivered order. Only visible reviews count towards
+-- restaurants.rating and total_reviews, which are recomputed from this
+-- table in the transaction that changes it.
+CREATE TABLE reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    order_id UUID NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT,
+    status review_status_enum NOT NULL DEFAULT 'visible',
+    reply TEXT,
+    replied_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    replied_at TIMESTAMP,
+    moderation_reason TEXT,
+    moderated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reviews_restaurant ON reviews(restaurant_id, status, created_at DESC);
+CREATE INDEX idx_reviews_user ON reviews(user_id);
+
+-- Optional ratings of the dishes on the order
+CREATE TABLE review_item_ratings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_items(id) ON DELETE CASCADE,
+    menu_item_id UUID NOT NULL REFERENCES menu_items(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (review_id, order_item_id)
+);
+
+CREATE INDEX idx_review_item_ratings_menu_item ON review_item_ratings(menu_item_id);
+
+-- Nothing maintained these before, so start from what the table says
+UPDATE restaurants SET rating = 0, total_reviews = 0;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS refund_reason,
+    DROP COLUMN IF EXISTS refunded_at,
+    DROP COLUMN IF EXISTS points_redeemed;
+
+DROP TABLE IF EXISTS loyalty_ledger CASCADE;
+DROP FUNCTION IF EXISTS reject_loyalty_ledger_update();
+DROP TABLE IF EXISTS loyalty_accounts CASCADE;
+DROP TABLE IF EXISTS loyalty_rules CASCADE;
+DROP TYPE IF EXISTS loyalty_entry_type_enum;
+
+COMMIT;
@@ -0,0 +1,64 @@
+BEGIN;
+
+CREATE TYPE loyalty_entry_type_enum AS ENUM (
+    'earn', 'redeem', 'earn_reversal', 'redeem_reversal', 'expiry'
+);
+
+-- How each restaurant's delivered orders earn points and how many points
+-- may pay for an order there. Restaurants without a rule earn nothing and
+-- accept no points.
+CREATE TABLE loyalty_rules (
+    restaurant_id UUID PRIMARY KEY REFERENCES restaurants(id) ON DELETE CASCADE,
+    points_per_unit DECIMAL(10, 4) NOT NULL DEFAULT 1 CHECK (points_per_unit >= 0),
+    minimum_subtotal DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (minimum_subtotal >= 0),
+    max_redeem_percent DECIMAL(5, 2) NOT NULL DEFAULT 0 CHECK (max_redeem_percent BETWEEN 0 AND 100),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- balance is the sum of the user's ledger entries. The row is locked by
+-- every ledger write, which serialises concurrent checkouts.
+CREATE TABLE loyalty_accounts (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    balance INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Append-only: corrections are new entries. Credits (earn, redeem_reversal)
+-- carry the time they expire; debits are negative.
+CREATE TABLE loyalty_ledger (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    order_id UUID REFERENCES orders(id) ON DELETE RESTRICT,
+    restaurant_id UUID REFERENCES restaurants(id) ON DELETE RESTRICT,
+    type loyalty_entry_type_enum NOT NULL,
+    points INTEGER NOT NULL CHECK (points <> 0),
+    balance_after INTEGER NOT NULL,
+    expires_at TIMESTAMP,
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_loyalty_ledger_user ON loyalty_ledger(user_id, created_at DESC);
+CREATE INDEX idx_loyalty_ledger_expires ON loyalty_ledger(expires_at) WHERE expires_at IS NOT NULL;
+-- An order earns, redeems and is reversed at most once
+CREATE UNIQUE INDEX idx_loyalty_ledger_order_type ON loyalty_ledger(order_id, type) WHERE order_id IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION reject_loyalty_ledger_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'loyalty_ledger is append-only';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER loyalty_ledger_append_only BEFORE UPDATE ON loyalty_ledger
+    FOR EACH ROW EXECUTE FUNCTION reject_loyalty_ledger_update();
+
+ALTER TABLE orders
+    ADD COLUMN points_redeemed INTEGER NOT NULL DEFAULT 0 CHECK (points_redeemed >= 0),
+    ADD COLUMN refunded_at TIMESTAMP,
+    ADD COLUMN refund_reason TEXT;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS wallet_amount;
+
+DROP TABLE IF EXISTS wallet_postings CASCADE;
+DROP FUNCTION IF EXISTS check_wallet_transaction_balanced();
+DROP TABLE IF EXISTS wallet_transactions CASCADE;
+DROP TABLE IF EXISTS gift_cards CASCADE;
+DROP TABLE IF EXISTS wallet_accounts CASCADE;
+DROP TYPE IF EXISTS gift_card_status_enum;
+DROP TYPE IF EXISTS wallet_transaction_type_enum;
+DROP TYPE IF EXISTS wallet_account_type_enum;
+
+COMMIT;
@@ -0,0 +1,95 @@
+BEGIN;
+
+CREATE TYPE wallet_account_type_enum AS ENUM (
+    'user_wallet', 'provider_clearing', 'gift_card_liability', 'order_settlement'
+);
+CREATE TYPE wallet_transaction_type_enum AS ENUM (
+    'top_up', 'gift_card_purchase', 'gift_card_redemption', 'order_payment', 'order_refund'
+);
+CREATE TYPE gift_card_status_enum AS ENUM ('active', 'redeemed');
+
+-- Every customer has one user_wallet account; the other types are single
+-- system accounts. Money only moves between accounts, so the balances of
+-- all accounts always add up to zero.
+CREATE TABLE wallet_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    type wallet_account_type_enum NOT NULL,
+    user_id UUID UNIQUE REFERENCES users(id) ON DELETE RESTRICT,
+    balance DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((type = 'user_wallet') = (user_id IS NOT NULL)),
+    CHECK (type <> 'user_wallet' OR balance >= 0)
+);
+
+CREATE UNIQUE INDEX idx_wallet_accounts_system ON wallet_accounts(type) WHERE type <> 'user_wallet';
+
+INSERT INTO wallet_accounts (type) VALUES
+    ('provider_clearing'), ('gift_card_liability'), ('order_settlement');
+
+CREATE TABLE gift_cards (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    -- Only a hash of the code is kept; the code is shown once, at purchase
+    code_hash VARCHAR(64) NOT NULL UNIQUE,
+    code_last4 VARCHAR(4) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    status gift_card_status_enum NOT NULL DEFAULT 'active',
+    purchaser_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    recipient_name VARCHAR(255),
+    recipient_email VARCHAR(255),
+    message TEXT,
+    sent_at TIMESTAMP,
+    redeemed_by UUID REFERENCES users(id) ON DELETE RESTRICT,
+    redeemed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_gift_cards_purchaser ON gift_cards(purchaser_id, created_at DESC);
+
+-- A journal entry. Its postings add up to zero.
+CREATE TABLE wallet_transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    type wallet_transaction_type_enum NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE RESTRICT,
+    order_id UUID REFERENCES orders(id) ON DELETE RESTRICT,
+    gift_card_id UUID REFERENCES gift_cards(id) ON DELETE RESTRICT,
+    -- The payment provider's charge or refund id, when a card was involved
+    provider_reference VARCHAR(255),
+    description TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_wallet_transactions_order ON wallet_transactions(order_id) WHERE order_id IS NOT NULL;
+
+CREATE TABLE wallet_postings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES wallet_transactions(id) ON DELETE RESTRICT,
+    account_id UUID NOT NULL REFERENCES wallet_accounts(id) ON DELETE RESTRICT,
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount <> 0),
+    balance_after DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_wallet_postings_account ON wallet_postings(account_id, created_at DESC);
+CREATE INDEX idx_wallet_postings_transaction ON wallet_postings(transaction_id);
+
+-- Checked at commit, once all of a transaction's postings are in
+CREATE OR REPLACE FUNCTION check_wallet_transaction_balanced()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM wallet_postings WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'wallet transaction % does not balance', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE CONSTRAINT TRIGGER wallet_postings_balanced AFTER INSERT ON wallet_postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_wallet_transaction_balanced();
+
+-- What part of an order wallet funds paid for; the rest is paid by card
+ALTER TABLE orders ADD COLUMN wallet_amount DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (wallet_amount >= 0);
+
+COMMIT;
@@ -0,0 +1,71 @@
+BEGIN;
+
+CREATE TYPE table_session_status_enum AS ENUM ('open', 'closed');
+
+-- A table's QR code carries a token signed over its id and token_version;
+-- bumping the version retires every printed code for the table.
+CREATE TABLE restaurant_tables (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    number VARCHAR(20) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    token_version INTEGER NOT NULL DEFAULT 1,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (restaurant_id, number)
+);
+
+-- A session is a table's shared tab, from the first scan until staff close it
+CREATE TABLE table_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    table_id UUID NOT NULL REFERENCES restaurant_tables(id) ON DELETE RESTRICT,
+    status table_session_status_enum NOT NULL DEFAULT 'open',
+    guest_count INTEGER NOT NULL DEFAULT 0 CHECK (guest_count >= 0),
+    payment_method payment_method_enum,
+    closed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_table_sessions_open ON table_sessions(table_id) WHERE status = 'open';
+CREATE INDEX idx_table_sessions_restaurant ON table_sessions(restaurant_id, status, created_at DESC);
+
+-- Guests at a table order without an account. table_number is copied onto
+-- the order so the kitchen ticket shows where it goes.
+ALTER TABLE orders
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN table_session_id UUID REFERENCES table_sessions(id) ON DELETE RESTRICT,
+    ADD COLUMN table_number VARCHAR(20),
+    ADD CONSTRAINT orders_user_or_table CHECK (user_id IS NOT NULL OR table_session_id IS NOT NULL);
+
+CREATE INDEX idx_orders_table_session ON orders(table_session_id) WHERE table_session_id IS NOT NULL;
+
+-- Guest orders have no user; keep them in the reports, without a customer
+-- name
+CREATE OR REPLACE VIEW order_summary AS
+SELECT
+    o.id,
+    o.order_number,
+    o.status,
+    o.total,
+    o.created_at,
+    u.first_name || ' ' || u.last_name AS customer_name,
+    r.name AS restaurant_name,
+    COUNT(oi.id) AS item_count,
+    o.restaurant_id,
+    o.order_type,
+    o.subtotal,
+    o.discount,
+    COALESCE(SUM(oi.quantity), 0) AS item_quantity,
+    o.cancelled_at,
+    o.cancellation_reason
+FROM orders o
+LEFT JOIN users u ON o.user_id = u.id
+JOIN restaurants r ON o.restaurant_id = r.id
+LEFT JOIN order_items oi ON o.id = oi.order_id
+GROUP BY o.id, u.first_name, u.last_name, r.name;
+
+COMMIT;